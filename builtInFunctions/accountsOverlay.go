@@ -0,0 +1,411 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// StorageChange holds the old and the new value of a storage key changed by a built-in function
+type StorageChange struct {
+	Address  []byte
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
+
+// BalanceChange holds the old and the new balance of an account changed by a built-in function
+type BalanceChange struct {
+	Address    []byte
+	OldBalance *big.Int
+	NewBalance *big.Int
+}
+
+var _ vmcommon.AccountsAdapter = (*accountsOverlay)(nil)
+var _ vmcommon.AccountDataIterator = (*accountDataOverlay)(nil)
+
+// accountsOverlay is a copy-on-write accounts adapter: reads fall through to the wrapped adapter while every write
+// is buffered in memory, so the wrapped state is never altered
+type accountsOverlay struct {
+	mutAccounts sync.RWMutex
+	accounts    vmcommon.AccountsAdapter
+	loaded      map[string]*userAccountOverlay
+}
+
+func newAccountsOverlay(accounts vmcommon.AccountsAdapter) (*accountsOverlay, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	return &accountsOverlay{
+		accounts: accounts,
+		loaded:   make(map[string]*userAccountOverlay),
+	}, nil
+}
+
+// GetExistingAccount returns the buffered account or wraps the existing account from the underlying adapter
+func (ao *accountsOverlay) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return ao.getAccount(address, ao.accounts.GetExistingAccount)
+}
+
+// LoadAccount returns the buffered account or wraps the account loaded from the underlying adapter
+func (ao *accountsOverlay) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return ao.getAccount(address, ao.accounts.LoadAccount)
+}
+
+func (ao *accountsOverlay) getAccount(
+	address []byte,
+	getHandler func(address []byte) (vmcommon.AccountHandler, error),
+) (vmcommon.AccountHandler, error) {
+	ao.mutAccounts.Lock()
+	defer ao.mutAccounts.Unlock()
+
+	account, ok := ao.loaded[string(address)]
+	if ok {
+		return account, nil
+	}
+
+	baseAccount, err := getHandler(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := baseAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	account = newUserAccountOverlay(userAccount)
+	ao.loaded[string(address)] = account
+
+	return account, nil
+}
+
+// SaveAccount keeps the account in the overlay, the underlying adapter is not touched
+func (ao *accountsOverlay) SaveAccount(account vmcommon.AccountHandler) error {
+	accountOverlay, ok := account.(*userAccountOverlay)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	ao.mutAccounts.Lock()
+	ao.loaded[string(accountOverlay.AddressBytes())] = accountOverlay
+	ao.mutAccounts.Unlock()
+
+	return nil
+}
+
+// RemoveAccount is not permitted on the overlay
+func (ao *accountsOverlay) RemoveAccount(_ []byte) error {
+	return ErrOperationNotPermitted
+}
+
+// Commit is not permitted on the overlay
+func (ao *accountsOverlay) Commit() ([]byte, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// JournalLen returns 0 as the overlay does not journalize changes
+func (ao *accountsOverlay) JournalLen() int {
+	return 0
+}
+
+// RevertToSnapshot drops all the buffered changes
+func (ao *accountsOverlay) RevertToSnapshot(_ int) error {
+	ao.reset()
+	return nil
+}
+
+// GetCode returns the code from the underlying adapter
+func (ao *accountsOverlay) GetCode(codeHash []byte) []byte {
+	return ao.accounts.GetCode(codeHash)
+}
+
+// RootHash returns the root hash of the underlying adapter
+func (ao *accountsOverlay) RootHash() ([]byte, error) {
+	return ao.accounts.RootHash()
+}
+
+func (ao *accountsOverlay) reset() {
+	ao.mutAccounts.Lock()
+	ao.loaded = make(map[string]*userAccountOverlay)
+	ao.mutAccounts.Unlock()
+}
+
+// storageChanges returns the buffered storage changes sorted by address and key
+func (ao *accountsOverlay) storageChanges() []*StorageChange {
+	ao.mutAccounts.RLock()
+	defer ao.mutAccounts.RUnlock()
+
+	changes := make([]*StorageChange, 0)
+	for _, account := range ao.loaded {
+		changes = append(changes, account.dataOverlay.storageChanges(account.AddressBytes())...)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		compareAddress := bytes.Compare(changes[i].Address, changes[j].Address)
+		if compareAddress != 0 {
+			return compareAddress < 0
+		}
+		return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+
+	return changes
+}
+
+// balanceChanges returns the buffered balance changes sorted by address
+func (ao *accountsOverlay) balanceChanges() []*BalanceChange {
+	ao.mutAccounts.RLock()
+	defer ao.mutAccounts.RUnlock()
+
+	changes := make([]*BalanceChange, 0)
+	for _, account := range ao.loaded {
+		if account.balance.Cmp(account.initialBalance) == 0 {
+			continue
+		}
+
+		changes = append(changes, &BalanceChange{
+			Address:    account.AddressBytes(),
+			OldBalance: big.NewInt(0).Set(account.initialBalance),
+			NewBalance: big.NewInt(0).Set(account.balance),
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Address, changes[j].Address) < 0
+	})
+
+	return changes
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ao *accountsOverlay) IsInterfaceNil() bool {
+	return ao == nil
+}
+
+// userAccountOverlay buffers every change done on the wrapped user account
+type userAccountOverlay struct {
+	vmcommon.UserAccountHandler
+	dataOverlay     *accountDataOverlay
+	initialBalance  *big.Int
+	balance         *big.Int
+	developerReward *big.Int
+	ownerAddress    []byte
+	userName        []byte
+	nonce           uint64
+}
+
+func newUserAccountOverlay(account vmcommon.UserAccountHandler) *userAccountOverlay {
+	initialBalance := big.NewInt(0).Set(vmcommon.ZeroValueIfNil(account.GetBalance()))
+
+	return &userAccountOverlay{
+		UserAccountHandler: account,
+		dataOverlay:        newAccountDataOverlay(account.AccountDataHandler()),
+		initialBalance:     initialBalance,
+		balance:            big.NewInt(0).Set(initialBalance),
+		developerReward:    big.NewInt(0).Set(vmcommon.ZeroValueIfNil(account.GetDeveloperReward())),
+		ownerAddress:       account.GetOwnerAddress(),
+		userName:           account.GetUserName(),
+		nonce:              account.GetNonce(),
+	}
+}
+
+// AccountDataHandler returns the buffered data handler
+func (uao *userAccountOverlay) AccountDataHandler() vmcommon.AccountDataHandler {
+	return uao.dataOverlay
+}
+
+// AddToBalance changes the buffered balance
+func (uao *userAccountOverlay) AddToBalance(value *big.Int) error {
+	newBalance := big.NewInt(0).Add(uao.balance, value)
+	if newBalance.Cmp(zero) < 0 {
+		return ErrInsufficientFunds
+	}
+
+	uao.balance = newBalance
+	return nil
+}
+
+// GetBalance returns the buffered balance
+func (uao *userAccountOverlay) GetBalance() *big.Int {
+	return big.NewInt(0).Set(uao.balance)
+}
+
+// ClaimDeveloperRewards resets the buffered developer reward and returns its previous value
+func (uao *userAccountOverlay) ClaimDeveloperRewards(sndAddress []byte) (*big.Int, error) {
+	if !bytes.Equal(sndAddress, uao.ownerAddress) {
+		return nil, ErrOperationNotPermitted
+	}
+
+	oldValue := uao.developerReward
+	uao.developerReward = big.NewInt(0)
+
+	return oldValue, nil
+}
+
+// GetDeveloperReward returns the buffered developer reward
+func (uao *userAccountOverlay) GetDeveloperReward() *big.Int {
+	return big.NewInt(0).Set(uao.developerReward)
+}
+
+// ChangeOwnerAddress changes the buffered owner address if the sender is the current owner
+func (uao *userAccountOverlay) ChangeOwnerAddress(sndAddress []byte, newAddress []byte) error {
+	if !bytes.Equal(sndAddress, uao.ownerAddress) {
+		return ErrOperationNotPermitted
+	}
+	if len(newAddress) != len(uao.AddressBytes()) {
+		return ErrInvalidAddressLength
+	}
+
+	uao.ownerAddress = newAddress
+	return nil
+}
+
+// SetOwnerAddress sets the buffered owner address
+func (uao *userAccountOverlay) SetOwnerAddress(address []byte) {
+	uao.ownerAddress = address
+}
+
+// GetOwnerAddress returns the buffered owner address
+func (uao *userAccountOverlay) GetOwnerAddress() []byte {
+	return uao.ownerAddress
+}
+
+// SetUserName sets the buffered user name
+func (uao *userAccountOverlay) SetUserName(userName []byte) {
+	uao.userName = userName
+}
+
+// GetUserName returns the buffered user name
+func (uao *userAccountOverlay) GetUserName() []byte {
+	return uao.userName
+}
+
+// IncreaseNonce increases the buffered nonce
+func (uao *userAccountOverlay) IncreaseNonce(nonce uint64) {
+	uao.nonce += nonce
+}
+
+// GetNonce returns the buffered nonce
+func (uao *userAccountOverlay) GetNonce() uint64 {
+	return uao.nonce
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (uao *userAccountOverlay) IsInterfaceNil() bool {
+	return uao == nil
+}
+
+// accountDataOverlay buffers the key-value pairs written on the wrapped data handler
+type accountDataOverlay struct {
+	dataHandler vmcommon.AccountDataHandler
+	oldValues   map[string][]byte
+	newValues   map[string][]byte
+}
+
+func newAccountDataOverlay(dataHandler vmcommon.AccountDataHandler) *accountDataOverlay {
+	return &accountDataOverlay{
+		dataHandler: dataHandler,
+		oldValues:   make(map[string][]byte),
+		newValues:   make(map[string][]byte),
+	}
+}
+
+// RetrieveValue returns the buffered value or the value from the wrapped data handler
+func (ado *accountDataOverlay) RetrieveValue(key []byte) ([]byte, error) {
+	value, ok := ado.newValues[string(key)]
+	if ok {
+		return value, nil
+	}
+	if check.IfNil(ado.dataHandler) {
+		return nil, nil
+	}
+
+	return ado.dataHandler.RetrieveValue(key)
+}
+
+// SaveKeyValue buffers the value, remembering the value it replaces
+func (ado *accountDataOverlay) SaveKeyValue(key []byte, value []byte) error {
+	_, ok := ado.oldValues[string(key)]
+	if !ok {
+		oldValue, err := ado.RetrieveValue(key)
+		if err != nil {
+			return err
+		}
+		ado.oldValues[string(key)] = oldValue
+	}
+
+	ado.newValues[string(key)] = append([]byte{}, value...)
+
+	return nil
+}
+
+// IterateKeysWithPrefix merges the buffered values with the ones of the wrapped data handler and calls the handler
+// for every non-empty value, in key order, until it returns false
+func (ado *accountDataOverlay) IterateKeysWithPrefix(prefix []byte, handler func(key []byte, value []byte) bool) error {
+	values := make(map[string][]byte)
+	if !check.IfNil(ado.dataHandler) {
+		iterator, ok := ado.dataHandler.(vmcommon.AccountDataIterator)
+		if !ok {
+			return ErrAccountDataIterationNotSupported
+		}
+
+		err := iterator.IterateKeysWithPrefix(prefix, func(key []byte, value []byte) bool {
+			values[string(key)] = value
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, value := range ado.newValues {
+		if bytes.HasPrefix([]byte(key), prefix) {
+			values[key] = value
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key, value := range values {
+		if len(value) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !handler([]byte(key), values[key]) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (ado *accountDataOverlay) storageChanges(address []byte) []*StorageChange {
+	changes := make([]*StorageChange, 0, len(ado.newValues))
+	for key, newValue := range ado.newValues {
+		oldValue := ado.oldValues[key]
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+
+		changes = append(changes, &StorageChange{
+			Address:  address,
+			Key:      []byte(key),
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	return changes
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ado *accountDataOverlay) IsInterfaceNil() bool {
+	return ado == nil
+}
@@ -0,0 +1,171 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsStubWithAccounts(accounts ...*mock.Account) *mock.AccountsStub {
+	accountsMap := make(map[string]*mock.Account)
+	for _, account := range accounts {
+		accountsMap[string(account.Address)] = account
+	}
+
+	return &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, ok := accountsMap[string(address)]
+			if !ok {
+				account = mock.NewUserAccount(address)
+				accountsMap[string(address)] = account
+			}
+
+			return account, nil
+		},
+	}
+}
+
+func TestNewAccountsOverlay_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	overlay, err := newAccountsOverlay(nil)
+	assert.Nil(t, overlay)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+}
+
+func TestAccountsOverlay_WritesShouldNotReachTheUnderlyingAccount(t *testing.T) {
+	t.Parallel()
+
+	account := mock.NewUserAccount([]byte("address"))
+	account.Balance = big.NewInt(100)
+	account.OwnerAddress = []byte("owner")
+	_ = account.SaveKeyValue([]byte("key"), []byte("old"))
+
+	overlay, _ := newAccountsOverlay(createAccountsStubWithAccounts(account))
+
+	loaded, err := overlay.LoadAccount(account.Address)
+	require.Nil(t, err)
+	userAccount := loaded.(vmcommon.UserAccountHandler)
+
+	err = userAccount.AddToBalance(big.NewInt(-30))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(70), userAccount.GetBalance())
+	assert.Equal(t, ErrInsufficientFunds, userAccount.AddToBalance(big.NewInt(-71)))
+
+	err = userAccount.ChangeOwnerAddress([]byte("other"), []byte("address"))
+	assert.Equal(t, ErrOperationNotPermitted, err)
+	err = userAccount.ChangeOwnerAddress([]byte("owner"), []byte("newaddr"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("newaddr"), userAccount.GetOwnerAddress())
+
+	userAccount.SetUserName([]byte("name"))
+	userAccount.IncreaseNonce(2)
+	_ = userAccount.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("new"))
+	_ = userAccount.AccountDataHandler().SaveKeyValue([]byte("key2"), []byte("value"))
+	_ = userAccount.AccountDataHandler().SaveKeyValue([]byte("key3"), nil)
+	assert.Nil(t, overlay.SaveAccount(userAccount))
+
+	value, _ := userAccount.AccountDataHandler().RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("new"), value)
+
+	reloaded, _ := overlay.LoadAccount(account.Address)
+	assert.True(t, reloaded == loaded)
+
+	assert.Equal(t, big.NewInt(100), account.Balance)
+	assert.Equal(t, []byte("owner"), account.OwnerAddress)
+	assert.Equal(t, uint64(0), account.Nonce)
+	assert.Equal(t, []byte("old"), account.Storage["key"])
+	assert.Equal(t, 1, len(account.Storage))
+
+	expectedChanges := []*StorageChange{
+		{Address: account.Address, Key: []byte("key"), OldValue: []byte("old"), NewValue: []byte("new")},
+		{Address: account.Address, Key: []byte("key2"), OldValue: nil, NewValue: []byte("value")},
+	}
+	assert.Equal(t, expectedChanges, overlay.storageChanges())
+	expectedBalanceChanges := []*BalanceChange{
+		{Address: account.Address, OldBalance: big.NewInt(100), NewBalance: big.NewInt(70)},
+	}
+	assert.Equal(t, expectedBalanceChanges, overlay.balanceChanges())
+
+	overlay.reset()
+	assert.Equal(t, 0, len(overlay.storageChanges()))
+	assert.Equal(t, 0, len(overlay.balanceChanges()))
+	reloaded, _ = overlay.LoadAccount(account.Address)
+	assert.False(t, reloaded == loaded)
+}
+
+func TestAccountsOverlay_IterateKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	account := mock.NewUserAccount([]byte("address"))
+	_ = account.SaveKeyValue([]byte("prefix-a"), []byte("old-a"))
+	_ = account.SaveKeyValue([]byte("prefix-c"), []byte("old-c"))
+	_ = account.SaveKeyValue([]byte("prefix-d"), []byte("old-d"))
+	_ = account.SaveKeyValue([]byte("other"), []byte("other"))
+
+	overlay, _ := newAccountsOverlay(createAccountsStubWithAccounts(account))
+	loaded, _ := overlay.LoadAccount(account.Address)
+	dataHandler := loaded.(vmcommon.UserAccountHandler).AccountDataHandler()
+	_ = dataHandler.SaveKeyValue([]byte("prefix-a"), []byte("new-a"))
+	_ = dataHandler.SaveKeyValue([]byte("prefix-b"), []byte("new-b"))
+	_ = dataHandler.SaveKeyValue([]byte("prefix-d"), nil)
+
+	iterator, ok := dataHandler.(vmcommon.AccountDataIterator)
+	require.True(t, ok)
+
+	t.Run("should merge the buffered values in key order", func(t *testing.T) {
+		visited := make([]string, 0)
+		err := iterator.IterateKeysWithPrefix([]byte("prefix-"), func(key []byte, value []byte) bool {
+			visited = append(visited, string(key)+"="+string(value))
+			return true
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"prefix-a=new-a", "prefix-b=new-b", "prefix-c=old-c"}, visited)
+	})
+	t.Run("should stop when the handler returns false", func(t *testing.T) {
+		numVisited := 0
+		err := iterator.IterateKeysWithPrefix([]byte("prefix-"), func(_ []byte, _ []byte) bool {
+			numVisited++
+			return false
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numVisited)
+	})
+	t.Run("wrapped data handler not iterable should error", func(t *testing.T) {
+		notIterable := struct{ vmcommon.AccountDataHandler }{&mock.DataTrieTrackerStub{}}
+		dataOverlay := newAccountDataOverlay(notIterable)
+		err := dataOverlay.IterateKeysWithPrefix([]byte("prefix-"), func(_ []byte, _ []byte) bool {
+			return true
+		})
+		assert.Equal(t, ErrAccountDataIterationNotSupported, err)
+	})
+	t.Run("wrapped iteration error should be returned", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		dataOverlay := newAccountDataOverlay(&mock.DataTrieTrackerStub{
+			IterateKeysWithPrefixCalled: func(_ []byte, _ func(key []byte, value []byte) bool) error {
+				return expectedErr
+			},
+		})
+		err := dataOverlay.IterateKeysWithPrefix([]byte("prefix-"), func(_ []byte, _ []byte) bool {
+			return true
+		})
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestAccountsOverlay_NotPermittedOperations(t *testing.T) {
+	t.Parallel()
+
+	overlay, _ := newAccountsOverlay(&mock.AccountsStub{})
+
+	_, err := overlay.Commit()
+	assert.Equal(t, ErrOperationNotPermitted, err)
+	assert.Equal(t, ErrOperationNotPermitted, overlay.RemoveAccount([]byte("address")))
+	assert.Equal(t, ErrWrongTypeAssertion, overlay.SaveAccount(mock.NewUserAccount([]byte("address"))))
+	assert.Equal(t, 0, overlay.JournalLen())
+}
@@ -0,0 +1,42 @@
+package builtInFunctions
+
+import (
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+var _ vmcommon.BuiltInFunctionInterceptor = (*argumentsShapeInterceptor)(nil)
+
+// argumentsShapeInterceptor refuses, before any other interceptor or the built-in function itself is called, the calls
+// whose number of arguments does not fit the shape declared in the registry
+type argumentsShapeInterceptor struct {
+	shapes map[string]argumentsShape
+}
+
+func newArgumentsShapeInterceptor(registry []*builtInFunctionDefinition) *argumentsShapeInterceptor {
+	return &argumentsShapeInterceptor{
+		shapes: createArgumentsShapes(registry),
+	}
+}
+
+// BeforeProcess returns ErrInvalidArguments if the number of arguments does not fit the shape of the function
+func (asi *argumentsShapeInterceptor) BeforeProcess(functionName string, _, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) error {
+	if vmInput == nil {
+		return nil
+	}
+
+	shape, ok := asi.shapes[functionName]
+	if !ok {
+		return nil
+	}
+
+	return shape.check(functionName, len(vmInput.Arguments))
+}
+
+// AfterProcess does nothing
+func (asi *argumentsShapeInterceptor) AfterProcess(_ string, _ *vmcommon.ContractCallInput, _ *vmcommon.VMOutput, _ error) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (asi *argumentsShapeInterceptor) IsInterfaceNil() bool {
+	return asi == nil
+}
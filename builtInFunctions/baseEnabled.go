@@ -1,7 +1,6 @@
 package builtInFunctions
 
 import (
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	logger "github.com/ME-MotherEarth/me-logger"
 )
 
@@ -20,46 +19,20 @@ func (b baseAlwaysActive) IsInterfaceNil() bool {
 	return false
 }
 
-type baseEnabled struct {
-	function        string
-	activationEpoch uint32
-	flagActivated   atomic.Flag
+type baseActiveHandler struct {
+	activeHandler func() bool
 }
 
 // IsActive returns true if function is activated
-func (b *baseEnabled) IsActive() bool {
-	return b.flagActivated.IsSet()
-}
-
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (b *baseEnabled) EpochConfirmed(epoch uint32, _ uint64) {
-	b.flagActivated.SetValue(epoch >= b.activationEpoch)
-	log.Debug("built in function", "name: ", b.function, "enabled", b.flagActivated.IsSet())
+func (b *baseActiveHandler) IsActive() bool {
+	return b.activeHandler()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
-func (b *baseEnabled) IsInterfaceNil() bool {
+func (b *baseActiveHandler) IsInterfaceNil() bool {
 	return b == nil
 }
 
-type baseDisabled struct {
-	function          string
-	deActivationEpoch uint32
-	flagActivated     atomic.Flag
-}
-
-// IsActive returns true if function is activated
-func (b *baseDisabled) IsActive() bool {
-	return b.flagActivated.IsSet()
-}
-
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (b *baseDisabled) EpochConfirmed(epoch uint32, _ uint64) {
-	b.flagActivated.SetValue(epoch < b.deActivationEpoch)
-	log.Debug("built in function", "name: ", b.function, "enabled", b.flagActivated.IsSet())
-}
-
-// IsInterfaceNil returns true if there is no value under the interface
-func (b *baseDisabled) IsInterfaceNil() bool {
-	return b == nil
+func trueHandler() bool {
+	return true
 }
@@ -0,0 +1,149 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// ErrorCategory classifies the errors returned by the built-in functions
+type ErrorCategory int
+
+const (
+	// CategoryInternal is used for failures of the node components or of the configuration
+	CategoryInternal ErrorCategory = iota
+	// CategoryInput is used for malformed or invalid transaction arguments
+	CategoryInput
+	// CategoryGas is used when the provided gas does not cover the execution
+	CategoryGas
+	// CategoryFunds is used when the sender does not hold enough balance or tokens
+	CategoryFunds
+	// CategoryPermission is used when the caller is not allowed to execute the operation
+	CategoryPermission
+	// CategoryState is used when the accounts or tokens state does not allow the operation
+	CategoryState
+	// CategoryNotActive is used when the called function is not available
+	CategoryNotActive
+)
+
+func (ec ErrorCategory) String() string {
+	switch ec {
+	case CategoryInternal:
+		return "internal"
+	case CategoryInput:
+		return "input"
+	case CategoryGas:
+		return "gas"
+	case CategoryFunds:
+		return "funds"
+	case CategoryPermission:
+		return "permission"
+	case CategoryState:
+		return "state"
+	case CategoryNotActive:
+		return "not active"
+	default:
+		return fmt.Sprintf("unknown category: %d", ec)
+	}
+}
+
+// ReturnCode returns the vm return code that errors of this category map to
+func (ec ErrorCategory) ReturnCode() vmcommon.ReturnCode {
+	switch ec {
+	case CategoryInput, CategoryPermission, CategoryState:
+		return vmcommon.UserError
+	case CategoryGas:
+		return vmcommon.OutOfGas
+	case CategoryFunds:
+		return vmcommon.OutOfFunds
+	case CategoryNotActive:
+		return vmcommon.FunctionNotFound
+	default:
+		return vmcommon.ExecutionFailed
+	}
+}
+
+// BuiltInError is an error returned by the built-in functions, carrying a stable numeric code and a category.
+// Codes are never reused: a new error receives the next free code
+type BuiltInError struct {
+	code     uint32
+	category ErrorCategory
+	message  string
+}
+
+func newBuiltInError(code uint32, category ErrorCategory, message string) error {
+	return &BuiltInError{
+		code:     code,
+		category: category,
+		message:  message,
+	}
+}
+
+// Error returns the error message
+func (e *BuiltInError) Error() string {
+	return e.message
+}
+
+// Code returns the stable numeric code of the error
+func (e *BuiltInError) Code() uint32 {
+	return e.code
+}
+
+// Category returns the category of the error
+func (e *BuiltInError) Category() ErrorCategory {
+	return e.category
+}
+
+// ReturnCode returns the vm return code the error maps to
+func (e *BuiltInError) ReturnCode() vmcommon.ReturnCode {
+	return e.category.ReturnCode()
+}
+
+// GetBuiltInError returns the built-in error found in the chain of the provided error, if any
+func GetBuiltInError(err error) (*BuiltInError, bool) {
+	var builtInErr *BuiltInError
+	ok := errors.As(err, &builtInErr)
+
+	return builtInErr, ok
+}
+
+// ReturnCodeFromError returns the vm return code the provided error maps to. Errors that are not built-in errors
+// are considered execution failures
+func ReturnCodeFromError(err error) vmcommon.ReturnCode {
+	if err == nil {
+		return vmcommon.Ok
+	}
+
+	builtInErr, ok := GetBuiltInError(err)
+	if !ok {
+		return vmcommon.ExecutionFailed
+	}
+
+	return builtInErr.ReturnCode()
+}
+
+// CreateVMOutputFromError converts an error returned by ProcessBuiltinFunction in a failed vm output. All the gas
+// is consumed, the return message holds the error text and, for built-in errors, the return data holds the error
+// category and the error code as big endian bytes
+func CreateVMOutputFromError(err error) *vmcommon.VMOutput {
+	if err == nil {
+		return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:    ReturnCodeFromError(err),
+		ReturnMessage: err.Error(),
+	}
+
+	builtInErr, ok := GetBuiltInError(err)
+	if ok {
+		vmOutput.ReturnData = [][]byte{
+			[]byte(builtInErr.Category().String()),
+			big.NewInt(0).SetUint64(uint64(builtInErr.Code())).Bytes(),
+		}
+	}
+
+	return vmOutput
+}
@@ -0,0 +1,119 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"math/big"
+	"strings"
+	"testing"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltInError_CodesShouldBeUnique(t *testing.T) {
+	t.Parallel()
+
+	fileSet := token.NewFileSet()
+	isNotTestFile := func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}
+	packages, err := parser.ParseDir(fileSet, ".", isNotTestFile, 0)
+	require.Nil(t, err)
+
+	codes := make(map[string]string)
+	for _, file := range packages["builtInFunctions"].Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				for i, ident := range valueSpec.Names {
+					if !ident.IsExported() || !strings.HasPrefix(ident.Name, "Err") {
+						continue
+					}
+
+					require.Less(t, i, len(valueSpec.Values), ident.Name)
+					call, isCall := valueSpec.Values[i].(*ast.CallExpr)
+					require.True(t, isCall, ident.Name)
+					funcName, isIdent := call.Fun.(*ast.Ident)
+					require.True(t, isIdent && funcName.Name == "newBuiltInError", fmt.Sprintf("%s is not created by newBuiltInError", ident.Name))
+					code, isLiteral := call.Args[0].(*ast.BasicLit)
+					require.True(t, isLiteral, ident.Name)
+
+					previous, found := codes[code.Value]
+					assert.False(t, found, fmt.Sprintf("code %s used by %s and %s", code.Value, previous, ident.Name))
+					codes[code.Value] = ident.Name
+				}
+			}
+		}
+	}
+	assert.NotEmpty(t, codes)
+}
+
+func TestBuiltInError_ReturnCodeFromError(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, vmcommon.Ok, ReturnCodeFromError(nil))
+	assert.Equal(t, vmcommon.ExecutionFailed, ReturnCodeFromError(errors.New("not a built-in error")))
+	assert.Equal(t, vmcommon.OutOfGas, ReturnCodeFromError(ErrNotEnoughGas))
+	assert.Equal(t, vmcommon.UserError, ReturnCodeFromError(ErrMECTIsFrozenForAccount))
+	assert.Equal(t, vmcommon.ExecutionFailed, ReturnCodeFromError(ErrWrongTypeAssertion))
+	assert.Equal(t, vmcommon.OutOfFunds, ReturnCodeFromError(ErrInsufficientFunds))
+	assert.Equal(t, vmcommon.FunctionNotFound, ReturnCodeFromError(ErrBuiltInFunctionIsNotActive))
+	assert.Equal(t, vmcommon.UserError, ReturnCodeFromError(fmt.Errorf("%w for token", ErrInvalidNonce)))
+}
+
+func TestBuiltInError_GetBuiltInError(t *testing.T) {
+	t.Parallel()
+
+	builtInErr, ok := GetBuiltInError(fmt.Errorf("%w in test", ErrNotEnoughGas))
+	require.True(t, ok)
+	assert.Equal(t, uint32(11), builtInErr.Code())
+	assert.Equal(t, CategoryGas, builtInErr.Category())
+	assert.Equal(t, ErrNotEnoughGas.Error(), builtInErr.Error())
+
+	_, ok = GetBuiltInError(errors.New("not a built-in error"))
+	assert.False(t, ok)
+}
+
+func TestBuiltInError_CreateVMOutputFromError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil error", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := CreateVMOutputFromError(nil)
+		assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	})
+	t.Run("built-in error", func(t *testing.T) {
+		t.Parallel()
+
+		err := fmt.Errorf("%w, token: TKN", ErrMECTTokenIsPaused)
+		vmOutput := CreateVMOutputFromError(err)
+		assert.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+		assert.Equal(t, err.Error(), vmOutput.ReturnMessage)
+		assert.Zero(t, vmOutput.GasRemaining)
+
+		builtInErr, _ := GetBuiltInError(ErrMECTTokenIsPaused)
+		require.Equal(t, 2, len(vmOutput.ReturnData))
+		assert.Equal(t, []byte(CategoryPermission.String()), vmOutput.ReturnData[0])
+		assert.Equal(t, uint64(builtInErr.Code()), big.NewInt(0).SetBytes(vmOutput.ReturnData[1]).Uint64())
+	})
+	t.Run("other error", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := CreateVMOutputFromError(errors.New("marshal failed"))
+		assert.Equal(t, vmcommon.ExecutionFailed, vmOutput.ReturnCode)
+		assert.Equal(t, "marshal failed", vmOutput.ReturnMessage)
+		assert.Nil(t, vmOutput.ReturnData)
+	})
+}
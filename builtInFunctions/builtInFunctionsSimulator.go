@@ -0,0 +1,125 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// SimulationResult holds the outcome of a simulated built-in function call
+type SimulationResult struct {
+	VMOutput       *vmcommon.VMOutput
+	StorageChanges []*StorageChange
+	BalanceChanges []*BalanceChange
+}
+
+type builtInFunctionsSimulator struct {
+	mutSimulation    sync.Mutex
+	accounts         *accountsOverlay
+	shardCoordinator vmcommon.Coordinator
+	creator          *builtInFuncCreator
+}
+
+// NewBuiltInFunctionsSimulator creates a component which runs the built-in functions against a copy-on-write overlay
+// of the provided accounts adapter. The built-in functions are created with the same arguments as the real ones,
+// except for the interceptors, which are dropped so that simulations do not reach the metrics or any other observer.
+// None of the writes reach the provided accounts adapter.
+func NewBuiltInFunctionsSimulator(args ArgsCreateBuiltInFunctionContainer) (*builtInFunctionsSimulator, error) {
+	accounts, err := newAccountsOverlay(args.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	args.Accounts = accounts
+	args.Interceptors = nil
+	creator, err := NewBuiltInFunctionsCreator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = creator.CreateBuiltInFunctionContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &builtInFunctionsSimulator{
+		accounts:         accounts,
+		shardCoordinator: args.ShardCoordinator,
+		creator:          creator,
+	}, nil
+}
+
+// SetPayableHandler sets the payable handler used by the simulated transfer functions
+func (s *builtInFunctionsSimulator) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
+	return s.creator.SetPayableHandler(payableHandler)
+}
+
+// GasScheduleChange updates the gas costs of the simulated built-in functions
+func (s *builtInFunctionsSimulator) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
+	s.creator.GasScheduleChange(gasSchedule)
+}
+
+// Simulate runs the built-in function named in the input and returns its output together with all the storage and
+// balance changes it would produce. The buffered changes are discarded afterwards.
+func (s *builtInFunctionsSimulator) Simulate(vmInput *vmcommon.ContractCallInput) (*SimulationResult, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+
+	builtInFunc, err := s.creator.BuiltInFunctionContainer().Get(vmInput.Function)
+	if err != nil {
+		return nil, err
+	}
+	if !builtInFunc.IsActive() {
+		return nil, fmt.Errorf("%w: %s", ErrBuiltInFunctionIsNotActive, vmInput.Function)
+	}
+
+	s.mutSimulation.Lock()
+	defer s.mutSimulation.Unlock()
+	defer s.accounts.reset()
+
+	acntSnd, err := s.loadAccountIfInShard(vmInput.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+	acntDst, err := s.loadAccountIfInShard(vmInput.RecipientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput, err := builtInFunc.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SimulationResult{
+		VMOutput:       vmOutput,
+		StorageChanges: s.accounts.storageChanges(),
+		BalanceChanges: s.accounts.balanceChanges(),
+	}, nil
+}
+
+func (s *builtInFunctionsSimulator) loadAccountIfInShard(address []byte) (vmcommon.UserAccountHandler, error) {
+	if len(address) == 0 || s.shardCoordinator.ComputeId(address) != s.shardCoordinator.SelfId() {
+		return nil, nil
+	}
+
+	account, err := s.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok || check.IfNil(userAccount) {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (s *builtInFunctionsSimulator) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,153 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBuiltInFunctionsSimulator(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.Accounts = nil
+	simulator, err := NewBuiltInFunctionsSimulator(args)
+	assert.Nil(t, simulator)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	args = createMockArguments()
+	args.Marshalizer = nil
+	simulator, err = NewBuiltInFunctionsSimulator(args)
+	assert.Nil(t, simulator)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	simulator, err = NewBuiltInFunctionsSimulator(createMockArguments())
+	assert.Nil(t, err)
+	assert.False(t, simulator.IsInterfaceNil())
+}
+
+func TestBuiltInFunctionsSimulator_SimulateErrors(t *testing.T) {
+	t.Parallel()
+
+	simulator, _ := NewBuiltInFunctionsSimulator(createMockArguments())
+
+	result, err := simulator.Simulate(nil)
+	assert.Nil(t, result)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	result, err = simulator.Simulate(&vmcommon.ContractCallInput{Function: "missing"})
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+
+	args := createMockArguments()
+	enableEpochsHandler := createEnableEpochsHandlerStubAllFlags()
+	enableEpochsHandler.IsMECTNFTImprovementV1FlagEnabledField = false
+	args.EnableEpochsHandler = enableEpochsHandler
+	simulator, _ = NewBuiltInFunctionsSimulator(args)
+	result, err = simulator.Simulate(&vmcommon.ContractCallInput{Function: core.BuiltInFunctionMultiMECTNFTTransfer})
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrBuiltInFunctionIsNotActive))
+}
+
+func TestBuiltInFunctionsSimulator_SimulateMECTTransferShouldNotAlterState(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	tokenID := []byte("TOKEN-abcdef")
+	tokenKey := append([]byte(baseMECTKeyPrefix), tokenID...)
+	senderBalance, _ := marshaller.Marshal(&mect.MECToken{Value: big.NewInt(100)})
+
+	sender := mock.NewUserAccount(bytes.Repeat([]byte{1}, 32))
+	_ = sender.SaveKeyValue(tokenKey, senderBalance)
+	receiver := mock.NewUserAccount(bytes.Repeat([]byte{2}, 32))
+
+	args := createMockArguments()
+	args.Marshalizer = marshaller
+	args.Accounts = createAccountsStubWithAccounts(sender, receiver)
+	simulator, err := NewBuiltInFunctionsSimulator(args)
+	require.Nil(t, err)
+	err = simulator.SetPayableHandler(&mock.PayableHandlerStub{})
+	require.Nil(t, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  sender.Address,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(30).Bytes()},
+		},
+		RecipientAddr: receiver.Address,
+		Function:      core.BuiltInFunctionMECTTransfer,
+	}
+
+	for i := 0; i < 2; i++ {
+		result, errSimulate := simulator.Simulate(input)
+		require.Nil(t, errSimulate)
+		assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+
+		expectedSenderBalance, _ := marshaller.Marshal(&mect.MECToken{Value: big.NewInt(70)})
+		expectedReceiverBalance, _ := marshaller.Marshal(&mect.MECToken{Value: big.NewInt(30)})
+		expectedChanges := []*StorageChange{
+			{Address: sender.Address, Key: tokenKey, OldValue: senderBalance, NewValue: expectedSenderBalance},
+			{Address: receiver.Address, Key: tokenKey, OldValue: nil, NewValue: expectedReceiverBalance},
+		}
+		assert.Equal(t, expectedChanges, result.StorageChanges)
+	}
+
+	assert.Equal(t, senderBalance, sender.Storage[string(tokenKey)])
+	assert.Equal(t, 0, len(receiver.Storage))
+}
+
+func TestBuiltInFunctionsSimulator_SimulateClaimDeveloperRewardsShouldReportBalanceChanges(t *testing.T) {
+	t.Parallel()
+
+	owner := mock.NewUserAccount(bytes.Repeat([]byte{1}, 32))
+	owner.Balance = big.NewInt(100)
+	contract := mock.NewUserAccount(bytes.Repeat([]byte{2}, 32))
+	contract.OwnerAddress = owner.Address
+	contract.DeveloperReward = big.NewInt(40)
+
+	interceptorCalled := false
+	args := createMockArguments()
+	args.Accounts = createAccountsStubWithAccounts(owner, contract)
+	args.Interceptors = []vmcommon.BuiltInFunctionInterceptor{
+		&mock.BuiltInFunctionInterceptorStub{
+			BeforeProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) error {
+				interceptorCalled = true
+				return nil
+			},
+		},
+	}
+	simulator, err := NewBuiltInFunctionsSimulator(args)
+	require.Nil(t, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner.Address,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+		},
+		RecipientAddr: contract.Address,
+		Function:      core.BuiltInFunctionClaimDeveloperRewards,
+	}
+
+	result, err := simulator.Simulate(input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, result.VMOutput.ReturnCode)
+	expectedChanges := []*BalanceChange{
+		{Address: owner.Address, OldBalance: big.NewInt(100), NewBalance: big.NewInt(140)},
+	}
+	assert.Equal(t, expectedChanges, result.BalanceChanges)
+	assert.False(t, interceptorCalled)
+
+	assert.Equal(t, big.NewInt(100), owner.Balance)
+	assert.Equal(t, big.NewInt(40), contract.DeveloperReward)
+}
@@ -85,6 +85,27 @@ func computeGasRemaining(snd vmcommon.UserAccountHandler, gasProvided uint64, ga
 	return gasProvided - gasToUse
 }
 
+// computeGasToConsume mirrors computeGasRemaining: built in functions consume gas only in the sender shard
+func computeGasToConsume(snd vmcommon.UserAccountHandler, gasToUse uint64) uint64 {
+	if check.IfNil(snd) {
+		return 0
+	}
+
+	return gasToUse
+}
+
+// EstimateGas returns the gas consumed by the change owner address function, which is paid only on the sender shard
+func (c *changeOwnerAddress) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	c.mutExecution.RLock()
+	defer c.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return computeGasToConsume(acntSnd, c.gasCost), nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (c *changeOwnerAddress) IsInterfaceNil() bool {
 	return c == nil
@@ -103,6 +103,18 @@ func (c *claimDeveloperRewards) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// EstimateGas returns the gas consumed by the claim developer rewards function, which is paid only on the sender shard
+func (c *claimDeveloperRewards) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	c.mutExecution.RLock()
+	defer c.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return computeGasToConsume(acntSnd, c.gasCost), nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (c *claimDeveloperRewards) IsInterfaceNil() bool {
 	return c == nil
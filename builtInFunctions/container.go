@@ -9,22 +9,42 @@ import (
 )
 
 var _ vmcommon.BuiltInFunctionContainer = (*functionContainer)(nil)
+var _ vmcommon.GasEstimator = (*functionContainer)(nil)
 
 // functionContainer is an interceptors holder organized by type
 type functionContainer struct {
-	objects *container.MutexMap
+	objects          *container.MutexMap
+	interceptors     *interceptorsChain
+	metadataProvider functionMetadataProvider
 }
 
 // NewBuiltInFunctionContainer will create a new instance of a container
 func NewBuiltInFunctionContainer() *functionContainer {
 	return &functionContainer{
-		objects: container.NewMutexMap(),
+		objects:      container.NewMutexMap(),
+		interceptors: &interceptorsChain{},
 	}
 }
 
-// Get returns the object stored at a certain key.
-// Returns an error if the element does not exist
+// Get returns the object stored at a certain key, wrapped in the interceptors chain if any interceptor was added.
+// The wrapped function implements vmcommon.WrappedBuiltinFunction. Returns an error if the element does not exist
 func (f *functionContainer) Get(key string) (vmcommon.BuiltinFunction, error) {
+	function, err := f.getFunction(key)
+	if err != nil {
+		return nil, err
+	}
+	if f.interceptors.isEmpty() {
+		return function, nil
+	}
+
+	return &interceptedFunction{
+		name:     key,
+		function: function,
+		chain:    f.interceptors,
+	}, nil
+}
+
+func (f *functionContainer) getFunction(key string) (vmcommon.BuiltinFunction, error) {
 	value, ok := f.objects.Get(key)
 	if !ok {
 		return nil, fmt.Errorf("%w in function container for key %v", ErrInvalidContainerKey, key)
@@ -69,6 +89,16 @@ func (f *functionContainer) Replace(key string, function vmcommon.BuiltinFunctio
 	return nil
 }
 
+// AddInterceptor appends an interceptor to the chain called around every built-in function execution
+func (f *functionContainer) AddInterceptor(interceptor vmcommon.BuiltInFunctionInterceptor) error {
+	if check.IfNil(interceptor) {
+		return ErrNilBuiltInFunctionInterceptor
+	}
+
+	f.interceptors.add(interceptor)
+	return nil
+}
+
 // Remove will remove an object at a given key
 func (f *functionContainer) Remove(key string) {
 	f.objects.Remove(key)
@@ -95,6 +125,28 @@ func (f *functionContainer) Keys() map[string]struct{} {
 	return keys
 }
 
+// EstimateGas returns the gas that the built-in function named in the input would consume
+func (f *functionContainer) EstimateGas(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	function, err := f.getFunction(vmInput.Function)
+	if err != nil {
+		return 0, err
+	}
+
+	gasEstimator, ok := function.(vmcommon.GasEstimator)
+	if !ok {
+		return 0, fmt.Errorf("%w for function %s", ErrGasEstimationNotSupported, vmInput.Function)
+	}
+
+	return gasEstimator.EstimateGas(acntSnd, acntDst, vmInput)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *functionContainer) IsInterfaceNil() bool {
 	return f == nil
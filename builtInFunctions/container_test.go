@@ -4,9 +4,12 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/ME-MotherEarth/me-core/core"
 	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 	"github.com/ME-MotherEarth/me-vm-common/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewBuiltInFunctionContainer_ShouldWork(t *testing.T) {
@@ -156,3 +159,160 @@ func TestBuiltInFunctionContainer_LenShouldWork(t *testing.T) {
 	c.Remove("key1")
 	assert.Equal(t, 1, c.Len())
 }
+
+//------- EstimateGas
+
+func TestBuiltInFunctionContainer_EstimateGas(t *testing.T) {
+	t.Parallel()
+
+	c := NewBuiltInFunctionContainer()
+	_ = c.Add("stub", &mock.BuiltInFunctionStub{})
+	_ = c.Add(core.BuiltInFunctionChangeOwnerAddress, NewChangeOwnerAddressFunc(10))
+
+	gas, err := c.EstimateGas(nil, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+	assert.Zero(t, gas)
+
+	_, err = c.EstimateGas(nil, nil, &vmcommon.ContractCallInput{Function: "missing"})
+	assert.True(t, errors.Is(err, ErrInvalidContainerKey))
+
+	_, err = c.EstimateGas(nil, nil, &vmcommon.ContractCallInput{Function: "stub"})
+	assert.True(t, errors.Is(err, ErrGasEstimationNotSupported))
+
+	gas, err = c.EstimateGas(mock.NewUserAccount([]byte("addr")), nil, &vmcommon.ContractCallInput{Function: core.BuiltInFunctionChangeOwnerAddress})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), gas)
+}
+
+//------- Interceptors
+
+func TestBuiltInFunctionContainer_AddInterceptorNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c := NewBuiltInFunctionContainer()
+	err := c.AddInterceptor(nil)
+	assert.Equal(t, ErrNilBuiltInFunctionInterceptor, err)
+}
+
+func TestBuiltInFunctionContainer_GetWithoutInterceptorsShouldReturnTheFunction(t *testing.T) {
+	t.Parallel()
+
+	c := NewBuiltInFunctionContainer()
+	fn := &mock.BuiltInFunctionStub{}
+	_ = c.Add("fn", fn)
+
+	builtInFunc, err := c.Get("fn")
+	assert.Nil(t, err)
+	assert.True(t, builtInFunc == fn)
+}
+
+func TestBuiltInFunctionContainer_InterceptorsChain(t *testing.T) {
+	t.Parallel()
+
+	vmInput := &vmcommon.ContractCallInput{Function: "fn"}
+	expectedOutput := &vmcommon.VMOutput{GasRemaining: 7}
+
+	t.Run("hooks are called in order around the execution", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make([]string, 0)
+		createInterceptor := func(name string) *mock.BuiltInFunctionInterceptorStub {
+			return &mock.BuiltInFunctionInterceptorStub{
+				BeforeProcessCalled: func(functionName string, _, _ vmcommon.UserAccountHandler, input *vmcommon.ContractCallInput) error {
+					assert.Equal(t, "fn", functionName)
+					assert.True(t, input == vmInput)
+					calls = append(calls, "before "+name)
+					return nil
+				},
+				AfterProcessCalled: func(functionName string, input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) {
+					assert.Equal(t, "fn", functionName)
+					assert.True(t, input == vmInput)
+					assert.True(t, vmOutput == expectedOutput)
+					assert.Nil(t, err)
+					calls = append(calls, "after "+name)
+				},
+			}
+		}
+
+		c := NewBuiltInFunctionContainer()
+		_ = c.Add("fn", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				calls = append(calls, "process")
+				return expectedOutput, nil
+			},
+		})
+		_ = c.AddInterceptor(createInterceptor("first"))
+		_ = c.AddInterceptor(createInterceptor("second"))
+
+		builtInFunc, _ := c.Get("fn")
+		vmOutput, err := builtInFunc.ProcessBuiltinFunction(nil, nil, vmInput)
+		assert.Nil(t, err)
+		assert.True(t, vmOutput == expectedOutput)
+		assert.Equal(t, []string{"before first", "before second", "process", "after second", "after first"}, calls)
+	})
+	t.Run("veto should stop the execution", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("vetoed")
+		afterCalledWithErr := false
+		lastBeforeCalled := false
+		c := NewBuiltInFunctionContainer()
+		_ = c.Add("fn", &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+		_ = c.AddInterceptor(&mock.BuiltInFunctionInterceptorStub{
+			AfterProcessCalled: func(_ string, _ *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) {
+				assert.Nil(t, vmOutput)
+				afterCalledWithErr = err == expectedErr
+			},
+		})
+		_ = c.AddInterceptor(&mock.BuiltInFunctionInterceptorStub{
+			BeforeProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) error {
+				return expectedErr
+			},
+		})
+		_ = c.AddInterceptor(&mock.BuiltInFunctionInterceptorStub{
+			BeforeProcessCalled: func(_ string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) error {
+				lastBeforeCalled = true
+				return nil
+			},
+		})
+
+		builtInFunc, _ := c.Get("fn")
+		vmOutput, err := builtInFunc.ProcessBuiltinFunction(nil, nil, vmInput)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, vmOutput)
+		assert.True(t, afterCalledWithErr)
+		assert.False(t, lastBeforeCalled)
+	})
+	t.Run("wrapped function should forward the other calls", func(t *testing.T) {
+		t.Parallel()
+
+		gasConfigSet := false
+		fn := &mock.BuiltInFunctionStub{
+			SetNewGasConfigCalled: func(_ *vmcommon.GasCost) {
+				gasConfigSet = true
+			},
+			IsActiveCalled: func() bool {
+				return false
+			},
+		}
+		c := NewBuiltInFunctionContainer()
+		_ = c.Add("fn", fn)
+		_ = c.AddInterceptor(&mock.BuiltInFunctionInterceptorStub{})
+
+		builtInFunc, _ := c.Get("fn")
+		builtInFunc.SetNewGasConfig(&vmcommon.GasCost{})
+		assert.True(t, gasConfigSet)
+		assert.False(t, builtInFunc.IsActive())
+		wrappedFunc, ok := builtInFunc.(vmcommon.WrappedBuiltinFunction)
+		require.True(t, ok)
+		assert.True(t, wrappedFunc.Unwrap() == fn)
+
+		err := builtInFunc.(vmcommon.AcceptPayableChecker).SetPayableChecker(&mock.PayableHandlerStub{})
+		assert.Equal(t, ErrWrongTypeAssertion, err)
+	})
+}
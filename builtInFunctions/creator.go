@@ -1,62 +1,54 @@
 package builtInFunctions
 
 import (
+	"sync"
+
 	"github.com/ME-MotherEarth/me-core/core"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
-	"github.com/mitchellh/mapstructure"
 )
 
 var _ vmcommon.BuiltInFunctionFactory = (*builtInFuncCreator)(nil)
 
 // ArgsCreateBuiltInFunctionContainer defines the input arguments to create built in functions container
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasMap                              map[string]map[string]uint64
-	MapDNSAddresses                     map[string]struct{}
-	EnableUserNameChange                bool
-	Marshalizer                         vmcommon.Marshalizer
-	Accounts                            vmcommon.AccountsAdapter
-	ShardCoordinator                    vmcommon.Coordinator
-	EpochNotifier                       vmcommon.EpochNotifier
-	MECTNFTImprovementV1ActivationEpoch uint32
-	MECTTransferRoleEnableEpoch         uint32
-	GlobalMintBurnDisableEpoch          uint32
-	MECTTransferToMetaEnableEpoch       uint32
-	NFTCreateMultiShardEnableEpoch      uint32
-	SaveNFTToSystemAccountEnableEpoch   uint32
-	CheckCorrectTokenIDEnableEpoch      uint32
-	SendMECTMetadataAlwaysEnableEpoch   uint32
-	CheckFunctionArgumentEnableEpoch    uint32
-	FixAsyncCallbackCheckEnableEpoch    uint32
-	FixOldTokenLiquidityEnableEpoch     uint32
-	MaxNumOfAddressesForTransferRole    uint32
-	ConfigAddress                       []byte
+	GasMap                           map[string]map[string]uint64
+	MapDNSAddresses                  map[string]struct{}
+	EnableUserNameChange             bool
+	Marshalizer                      vmcommon.Marshalizer
+	Accounts                         vmcommon.AccountsAdapter
+	ShardCoordinator                 vmcommon.Coordinator
+	EnableEpochsHandler              vmcommon.EnableEpochsHandler
+	MaxNumOfAddressesForTransferRole uint32
+	ConfigAddress                    []byte
+	Interceptors                     []vmcommon.BuiltInFunctionInterceptor
+	GasScheduleHistorySize           uint32
+	Plugins                          []BuiltInFunctionPlugin
+	EnabledPlugins                   map[string]struct{}
 }
 
 type builtInFuncCreator struct {
-	mapDNSAddresses                     map[string]struct{}
-	enableUserNameChange                bool
-	marshaller                          vmcommon.Marshalizer
-	accounts                            vmcommon.AccountsAdapter
-	builtInFunctions                    vmcommon.BuiltInFunctionContainer
-	gasConfig                           *vmcommon.GasCost
-	shardCoordinator                    vmcommon.Coordinator
-	epochNotifier                       vmcommon.EpochNotifier
-	mectStorageHandler                  vmcommon.MECTNFTStorageHandler
-	mectGlobalSettingsHandler           vmcommon.MECTGlobalSettingsHandler
-	mectNFTImprovementV1ActivationEpoch uint32
-	mectTransferRoleEnableEpoch         uint32
-	globalMintBurnDisableEpoch          uint32
-	mectTransferToMetaEnableEpoch       uint32
-	nftCreateMultiShardEnableEpoch      uint32
-	saveNFTToSystemAccountEnableEpoch   uint32
-	checkCorrectTokenIDEnableEpoch      uint32
-	sendMECTMetadataAlwaysEnableEpoch   uint32
-	checkFunctionArgumentEnableEpoch    uint32
-	fixAsnycCallbackCheckEnableEpoch    uint32
-	fixOldTokenLiquidityEnableEpoch     uint32
-	maxNumOfAddressesForTransferRole    uint32
-	configAddress                       []byte
+	mapDNSAddresses                  map[string]struct{}
+	enableUserNameChange             bool
+	marshaller                       vmcommon.Marshalizer
+	accounts                         vmcommon.AccountsAdapter
+	builtInFunctions                 vmcommon.BuiltInFunctionContainer
+	gasConfig                        *vmcommon.GasCost
+	shardCoordinator                 vmcommon.Coordinator
+	enableEpochsHandler              vmcommon.EnableEpochsHandler
+	mectStorageHandler               vmcommon.MECTNFTStorageHandler
+	mectGlobalSettingsHandler        *mectGlobalSettings
+	rolesHandler                     *mectRoles
+	supplyLedger                     *mectSupplyLedger
+	registry                         []*builtInFunctionDefinition
+	maxNumOfAddressesForTransferRole uint32
+	configAddress                    []byte
+	interceptors                     []vmcommon.BuiltInFunctionInterceptor
+	mutGasConfig                     sync.RWMutex
+	gasScheduleHistory               []GasScheduleHistoryEntry
+	gasScheduleHistorySize           uint32
+	plugins                          []BuiltInFunctionPlugin
+	enabledPlugins                   map[string]struct{}
 }
 
 // NewBuiltInFunctionsCreator creates a component which will instantiate the built in functions contracts
@@ -73,57 +65,60 @@ func NewBuiltInFunctionsCreator(args ArgsCreateBuiltInFunctionContainer) (*built
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
-	if check.IfNil(args.EpochNotifier) {
-		return nil, ErrNilEpochHandler
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	for _, interceptor := range args.Interceptors {
+		if check.IfNil(interceptor) {
+			return nil, ErrNilBuiltInFunctionInterceptor
+		}
 	}
 
 	b := &builtInFuncCreator{
-		mapDNSAddresses:                     args.MapDNSAddresses,
-		enableUserNameChange:                args.EnableUserNameChange,
-		marshaller:                          args.Marshalizer,
-		accounts:                            args.Accounts,
-		shardCoordinator:                    args.ShardCoordinator,
-		epochNotifier:                       args.EpochNotifier,
-		mectNFTImprovementV1ActivationEpoch: args.MECTNFTImprovementV1ActivationEpoch,
-		mectTransferRoleEnableEpoch:         args.MECTTransferRoleEnableEpoch,
-		globalMintBurnDisableEpoch:          args.GlobalMintBurnDisableEpoch,
-		mectTransferToMetaEnableEpoch:       args.MECTTransferToMetaEnableEpoch,
-		nftCreateMultiShardEnableEpoch:      args.NFTCreateMultiShardEnableEpoch,
-		saveNFTToSystemAccountEnableEpoch:   args.SaveNFTToSystemAccountEnableEpoch,
-		checkCorrectTokenIDEnableEpoch:      args.CheckCorrectTokenIDEnableEpoch,
-		sendMECTMetadataAlwaysEnableEpoch:   args.SendMECTMetadataAlwaysEnableEpoch,
-		checkFunctionArgumentEnableEpoch:    args.CheckFunctionArgumentEnableEpoch,
-		fixOldTokenLiquidityEnableEpoch:     args.FixOldTokenLiquidityEnableEpoch,
-		maxNumOfAddressesForTransferRole:    args.MaxNumOfAddressesForTransferRole,
-		configAddress:                       args.ConfigAddress,
+		mapDNSAddresses:                  args.MapDNSAddresses,
+		enableUserNameChange:             args.EnableUserNameChange,
+		marshaller:                       args.Marshalizer,
+		accounts:                         args.Accounts,
+		shardCoordinator:                 args.ShardCoordinator,
+		enableEpochsHandler:              args.EnableEpochsHandler,
+		maxNumOfAddressesForTransferRole: args.MaxNumOfAddressesForTransferRole,
+		configAddress:                    args.ConfigAddress,
+		registry:                         builtInFunctionsRegistry(),
+		interceptors:                     args.Interceptors,
+		gasScheduleHistorySize:           args.GasScheduleHistorySize,
+		enabledPlugins:                   args.EnabledPlugins,
+	}
+	if b.gasScheduleHistorySize == 0 {
+		b.gasScheduleHistorySize = defaultGasScheduleHistorySize
 	}
 
-	var err error
-	b.gasConfig, err = createGasConfig(args.GasMap)
+	err := checkRegistry(b.registry)
 	if err != nil {
 		return nil, err
 	}
-	b.builtInFunctions = NewBuiltInFunctionContainer()
-
-	return b, nil
-}
-
-// GasScheduleChange is called when gas schedule is changed, thus all contracts must be updated
-func (b *builtInFuncCreator) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
-	newGasConfig, err := createGasConfig(gasSchedule)
+	err = b.checkRequiredFlags()
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	b.gasConfig = newGasConfig
-	for key := range b.builtInFunctions.Keys() {
-		builtInFunc, errGet := b.builtInFunctions.Get(key)
-		if errGet != nil {
-			return
+	for _, plugin := range args.Plugins {
+		err = b.RegisterPlugin(plugin)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		builtInFunc.SetNewGasConfig(b.gasConfig)
+	b.gasConfig, err = createGasConfig(args.GasMap)
+	if err != nil {
+		return nil, err
+	}
+	b.addToGasScheduleHistory(b.enableEpochsHandler.GetCurrentEpoch(), nil)
+	b.builtInFunctions, err = b.createContainer()
+	if err != nil {
+		return nil, err
 	}
+
+	return b, nil
 }
 
 // NFTStorageHandler will return the mect storage handler from the built in functions factory
@@ -136,6 +131,11 @@ func (b *builtInFuncCreator) MECTGlobalSettingsHandler() vmcommon.MECTGlobalSett
 	return b.mectGlobalSettingsHandler
 }
 
+// MECTSupplyHandler will return the component which provides the MECT supply recorded on the current shard
+func (b *builtInFuncCreator) MECTSupplyHandler() vmcommon.MECTSupplyHandler {
+	return b.supplyLedger
+}
+
 // BuiltInFunctionContainer will return the built in function container
 func (b *builtInFuncCreator) BuiltInFunctionContainer() vmcommon.BuiltInFunctionContainer {
 	return b.builtInFunctions
@@ -143,384 +143,123 @@ func (b *builtInFuncCreator) BuiltInFunctionContainer() vmcommon.BuiltInFunction
 
 // CreateBuiltInFunctionContainer will create the list of built-in functions
 func (b *builtInFuncCreator) CreateBuiltInFunctionContainer() error {
-
-	b.builtInFunctions = NewBuiltInFunctionContainer()
-	var newFunc vmcommon.BuiltinFunction
-	newFunc = NewClaimDeveloperRewardsFunc(b.gasConfig.BuiltInCost.ClaimDeveloperRewards)
-	err := b.builtInFunctions.Add(core.BuiltInFunctionClaimDeveloperRewards, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc = NewChangeOwnerAddressFunc(b.gasConfig.BuiltInCost.ChangeOwnerAddress)
-	err = b.builtInFunctions.Add(core.BuiltInFunctionChangeOwnerAddress, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewSaveUserNameFunc(b.gasConfig.BuiltInCost.SaveUserName, b.mapDNSAddresses, b.enableUserNameChange)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionSetUserName, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewSaveKeyValueStorageFunc(b.gasConfig.BaseOperationCost, b.gasConfig.BuiltInCost.SaveKeyValue)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionSaveKeyValue, newFunc)
-	if err != nil {
-		return err
-	}
-
-	globalSettingsFunc, err := NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, core.BuiltInFunctionMECTPause, 0, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTPause, globalSettingsFunc)
+	var err error
+	b.builtInFunctions, err = b.createContainer()
 	if err != nil {
 		return err
 	}
-	b.mectGlobalSettingsHandler = globalSettingsFunc
 
-	setRoleFunc, err := NewMECTRolesFunc(b.marshaller, true)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionSetMECTRole, setRoleFunc)
+	err = b.createDependencies()
 	if err != nil {
 		return err
 	}
 
-	newFunc, err = NewMECTTransferFunc(
-		b.gasConfig.BuiltInCost.MECTTransfer,
-		b.marshaller,
-		globalSettingsFunc,
-		b.shardCoordinator,
-		setRoleFunc,
-		b.mectTransferToMetaEnableEpoch,
-		b.checkCorrectTokenIDEnableEpoch,
-		b.epochNotifier,
-	)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTTransfer, newFunc)
-	if err != nil {
-		return err
-	}
+	for _, definition := range b.registry {
+		newFunc, errCreate := b.createFromDefinition(definition)
+		if errCreate != nil {
+			return errCreate
+		}
 
-	newFunc, err = NewMECTBurnFunc(b.gasConfig.BuiltInCost.MECTBurn, b.marshaller, globalSettingsFunc, b.globalMintBurnDisableEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTBurn, newFunc)
-	if err != nil {
-		return err
+		err = b.builtInFunctions.Add(definition.name, newFunc)
+		if err != nil {
+			return err
+		}
 	}
 
-	newFunc, err = NewMECTFreezeWipeFunc(b.marshaller, true, false)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTFreeze, newFunc)
-	if err != nil {
-		return err
-	}
+	return b.createPlugins()
+}
 
-	newFunc, err = NewMECTFreezeWipeFunc(b.marshaller, false, false)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTUnFreeze, newFunc)
+func (b *builtInFuncCreator) createContainer() (vmcommon.BuiltInFunctionContainer, error) {
+	functionContainer := NewBuiltInFunctionContainer()
+	functionContainer.metadataProvider = b
+	err := functionContainer.AddInterceptor(newArgumentsShapeInterceptor(b.registry))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	newFunc, err = NewMECTFreezeWipeFunc(b.marshaller, false, true)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTWipe, newFunc)
-	if err != nil {
-		return err
+	for _, interceptor := range b.interceptors {
+		err = functionContainer.AddInterceptor(interceptor)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	newFunc, err = NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, core.BuiltInFunctionMECTUnPause, 0, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTUnPause, newFunc)
-	if err != nil {
-		return err
-	}
+	return functionContainer, nil
+}
 
-	newFunc, err = NewMECTRolesFunc(b.marshaller, false)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionUnSetMECTRole, newFunc)
+func (b *builtInFuncCreator) createDependencies() error {
+	var err error
+	b.mectGlobalSettingsHandler, err = NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, core.BuiltInFunctionMECTPause, b.enableEpochsHandler, trueHandler)
 	if err != nil {
 		return err
 	}
 
-	newFunc, err = NewMECTLocalBurnFunc(b.gasConfig.BuiltInCost.MECTLocalBurn, b.marshaller, globalSettingsFunc, setRoleFunc)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTLocalBurn, newFunc)
+	b.rolesHandler, err = NewMECTRolesFunc(b.marshaller, true, b.enableEpochsHandler)
 	if err != nil {
 		return err
 	}
 
-	newFunc, err = NewMECTLocalMintFunc(b.gasConfig.BuiltInCost.MECTLocalMint, b.marshaller, globalSettingsFunc, setRoleFunc)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTLocalMint, newFunc)
+	b.supplyLedger, err = NewMECTSupplyLedger(b.accounts, b.shardCoordinator, b.enableEpochsHandler)
 	if err != nil {
 		return err
 	}
 
 	args := ArgsNewMECTDataStorage{
-		Accounts:                        b.accounts,
-		GlobalSettingsHandler:           globalSettingsFunc,
-		Marshalizer:                     b.marshaller,
-		SaveToSystemEnableEpoch:         b.saveNFTToSystemAccountEnableEpoch,
-		EpochNotifier:                   b.epochNotifier,
-		ShardCoordinator:                b.shardCoordinator,
-		SendAlwaysEnableEpoch:           b.sendMECTMetadataAlwaysEnableEpoch,
-		FixOldTokenLiquidityEnableEpoch: b.fixOldTokenLiquidityEnableEpoch,
+		Accounts:              b.accounts,
+		GlobalSettingsHandler: b.mectGlobalSettingsHandler,
+		Marshalizer:           b.marshaller,
+		EnableEpochsHandler:   b.enableEpochsHandler,
+		ShardCoordinator:      b.shardCoordinator,
 	}
 	b.mectStorageHandler, err = NewMECTDataStorage(args)
-	if err != nil {
-		return err
-	}
 
-	newFunc, err = NewMECTNFTAddQuantityFunc(b.gasConfig.BuiltInCost.MECTNFTAddQuantity, b.mectStorageHandler, globalSettingsFunc, setRoleFunc, b.saveNFTToSystemAccountEnableEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTNFTAddQuantity, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTNFTBurnFunc(b.gasConfig.BuiltInCost.MECTNFTBurn, b.mectStorageHandler, globalSettingsFunc, setRoleFunc)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTNFTBurn, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTNFTCreateFunc(b.gasConfig.BuiltInCost.MECTNFTCreate, b.gasConfig.BaseOperationCost, b.marshaller, globalSettingsFunc, setRoleFunc, b.mectStorageHandler, b.accounts, b.saveNFTToSystemAccountEnableEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTNFTCreate, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTNFTTransferFunc(
-		b.gasConfig.BuiltInCost.MECTNFTTransfer,
-		b.marshaller,
-		globalSettingsFunc,
-		b.accounts,
-		b.shardCoordinator,
-		b.gasConfig.BaseOperationCost,
-		setRoleFunc,
-		b.mectTransferToMetaEnableEpoch,
-		b.saveNFTToSystemAccountEnableEpoch,
-		b.checkCorrectTokenIDEnableEpoch,
-		b.mectStorageHandler,
-		b.epochNotifier,
-	)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTNFTTransfer, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTNFTCreateRoleTransfer(b.marshaller, b.accounts, b.shardCoordinator)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTNFTCreateRoleTransfer, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTNFTUpdateAttributesFunc(b.gasConfig.BuiltInCost.MECTNFTUpdateAttributes, b.gasConfig.BaseOperationCost, b.mectStorageHandler, globalSettingsFunc, setRoleFunc, b.mectNFTImprovementV1ActivationEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTNFTUpdateAttributes, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTNFTAddUriFunc(b.gasConfig.BuiltInCost.MECTNFTAddURI, b.gasConfig.BaseOperationCost, b.mectStorageHandler, globalSettingsFunc, setRoleFunc, b.mectNFTImprovementV1ActivationEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTNFTAddURI, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTNFTMultiTransferFunc(
-		b.gasConfig.BuiltInCost.MECTNFTMultiTransfer,
-		b.marshaller,
-		globalSettingsFunc,
-		b.accounts,
-		b.shardCoordinator,
-		b.gasConfig.BaseOperationCost,
-		b.mectNFTImprovementV1ActivationEpoch,
-		b.epochNotifier,
-		setRoleFunc,
-		b.mectTransferToMetaEnableEpoch,
-		b.checkCorrectTokenIDEnableEpoch,
-		b.mectStorageHandler,
-	)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMultiMECTNFTTransfer, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, core.BuiltInFunctionMECTSetLimitedTransfer, b.mectTransferRoleEnableEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTSetLimitedTransfer, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, core.BuiltInFunctionMECTUnSetLimitedTransfer, b.mectTransferRoleEnableEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionMECTUnSetLimitedTransfer, newFunc)
-	if err != nil {
-		return err
-	}
-
-	argsNewDeleteFunc := ArgsNewMECTDeleteMetadata{
-		FuncGasCost:     b.gasConfig.BuiltInCost.MECTNFTBurn,
-		Marshalizer:     b.marshaller,
-		Accounts:        b.accounts,
-		ActivationEpoch: b.sendMECTMetadataAlwaysEnableEpoch,
-		EpochNotifier:   b.epochNotifier,
-		AllowedAddress:  b.configAddress,
-		Delete:          true,
-	}
-	newFunc, err = NewMECTDeleteMetadataFunc(argsNewDeleteFunc)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(vmcommon.MECTDeleteMetadata, newFunc)
-	if err != nil {
-		return err
-	}
-
-	argsNewDeleteFunc.Delete = false
-	newFunc, err = NewMECTDeleteMetadataFunc(argsNewDeleteFunc)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(vmcommon.MECTAddMetadata, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, vmcommon.BuiltInFunctionMECTSetBurnRoleForAll, b.sendMECTMetadataAlwaysEnableEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionMECTSetBurnRoleForAll, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll, b.sendMECTMetadataAlwaysEnableEpoch, b.epochNotifier)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTTransferRoleAddressFunc(b.accounts, b.marshaller, b.sendMECTMetadataAlwaysEnableEpoch, b.epochNotifier, b.maxNumOfAddressesForTransferRole, false)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionMECTTransferRoleDeleteAddress, newFunc)
-	if err != nil {
-		return err
-	}
-
-	newFunc, err = NewMECTTransferRoleAddressFunc(b.accounts, b.marshaller, b.sendMECTMetadataAlwaysEnableEpoch, b.epochNotifier, b.maxNumOfAddressesForTransferRole, true)
-	if err != nil {
-		return err
-	}
-	err = b.builtInFunctions.Add(vmcommon.BuiltInFunctionMECTTransferRoleAddAddress, newFunc)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return err
 }
 
-func createGasConfig(gasMap map[string]map[string]uint64) (*vmcommon.GasCost, error) {
-	baseOps := &vmcommon.BaseOperationCost{}
-	err := mapstructure.Decode(gasMap[core.BaseOperationCostString], baseOps)
-	if err != nil {
-		return nil, err
-	}
-
-	err = check.ForZeroUintFields(*baseOps)
-	if err != nil {
-		return nil, err
-	}
-
-	builtInOps := &vmcommon.BuiltInCost{}
-	err = mapstructure.Decode(gasMap[core.BuiltInCostString], builtInOps)
-	if err != nil {
-		return nil, err
+func (b *builtInFuncCreator) createDeleteMetadataArgs(gasCost uint64, activeHandler func() bool, isDelete bool) ArgsNewMECTDeleteMetadata {
+	return ArgsNewMECTDeleteMetadata{
+		FuncGasCost:    gasCost,
+		Marshalizer:    b.marshaller,
+		Accounts:       b.accounts,
+		ActiveHandler:  activeHandler,
+		AllowedAddress: b.configAddress,
+		Delete:         isDelete,
 	}
+}
 
-	err = check.ForZeroUintFields(*builtInOps)
-	if err != nil {
-		return nil, err
+func (b *builtInFuncCreator) createAllowanceArgs(gasCost uint64, activeHandler func() bool) ArgsNewMECTAllowanceFunc {
+	return ArgsNewMECTAllowanceFunc{
+		FuncGasCost:           gasCost,
+		Marshaller:            b.marshaller,
+		GlobalSettingsHandler: b.mectGlobalSettingsHandler,
+		RolesHandler:          b.rolesHandler,
+		StorageHandler:        b.mectStorageHandler,
+		ShardCoordinator:      b.shardCoordinator,
+		EnableEpochsHandler:   b.enableEpochsHandler,
+		ActiveHandler:         activeHandler,
 	}
+}
 
-	gasCost := vmcommon.GasCost{
-		BaseOperationCost: *baseOps,
-		BuiltInCost:       *builtInOps,
+func (b *builtInFuncCreator) createVestingArgs(gasCost uint64, activeHandler func() bool) ArgsNewMECTVestingFunc {
+	return ArgsNewMECTVestingFunc{
+		FuncGasCost:           gasCost,
+		GasConfig:             b.gasConfig.BaseOperationCost,
+		Marshaller:            b.marshaller,
+		Accounts:              b.accounts,
+		GlobalSettingsHandler: b.mectGlobalSettingsHandler,
+		RolesHandler:          b.rolesHandler,
+		StorageHandler:        b.mectStorageHandler,
+		ShardCoordinator:      b.shardCoordinator,
+		EnableEpochsHandler:   b.enableEpochsHandler,
+		ActiveHandler:         activeHandler,
 	}
-
-	return &gasCost, nil
 }
 
 // SetPayableHandler sets the payableCheck interface to the needed functions
 func (b *builtInFuncCreator) SetPayableHandler(payableHandler vmcommon.PayableHandler) error {
 	payableChecker, err := NewPayableCheckFunc(
 		payableHandler,
-		b.checkFunctionArgumentEnableEpoch,
-		b.fixAsnycCallbackCheckEnableEpoch,
-		b.epochNotifier,
+		b.enableEpochsHandler,
 	)
 	if err != nil {
 		return err
@@ -1,10 +1,12 @@
 package builtInFunctions
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ME-MotherEarth/me-core/core"
 	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 	"github.com/ME-MotherEarth/me-vm-common/mock"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,13 +22,47 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		Marshalizer:                      &mock.MarshalizerMock{},
 		Accounts:                         &mock.AccountsStub{},
 		ShardCoordinator:                 mock.NewMultiShardsCoordinatorMock(1),
-		EpochNotifier:                    &mock.EpochNotifierStub{},
+		EnableEpochsHandler:              createEnableEpochsHandlerStubAllFlags(),
 		MaxNumOfAddressesForTransferRole: 100,
 	}
 
 	return args
 }
 
+func createEnableEpochsHandlerStubAllFlags() *mock.EnableEpochsHandlerStub {
+	return &mock.EnableEpochsHandlerStub{
+		IsMECTTransferRoleFlagEnabledField:      true,
+		IsTransferToMetaFlagEnabledField:        true,
+		IsMECTNFTImprovementV1FlagEnabledField:  true,
+		IsSaveToSystemAccountFlagEnabledField:   true,
+		IsCheckFrozenCollectionFlagEnabledField: true,
+		IsValueLengthCheckFlagEnabledField:      true,
+		IsCheckTransferFlagEnabledField:         true,
+		IsCheckCorrectTokenIDEnabledField:       true,
+		IsSendAlwaysFlagEnabledField:            true,
+		IsCheckFunctionArgumentFlagEnabledField: true,
+		IsFixAsyncCallbackCheckFlagEnabledField: true,
+		IsFixOldTokenLiquidityEnabledField:      true,
+		IsMECTAllowanceFlagEnabledField:         true,
+		IsMECTVestingFlagEnabledField:           true,
+		IsMECTRoyaltiesFlagEnabledField:         true,
+		IsMECTNFTCreateBatchFlagEnabledField:    true,
+		IsMECTNFTModifyURIsFlagEnabledField:     true,
+		IsMECTMetadataFreezeFlagEnabledField:    true,
+		IsMECTSoulboundFlagEnabledField:         true,
+		IsMECTTransferFeeFlagEnabledField:       true,
+		IsMECTMaxSupplyFlagEnabledField:         true,
+		IsMECTRoleExpiryFlagEnabledField:        true,
+		IsMECTRoleTransferFlagEnabledField:      true,
+		IsMECTMintQuotaFlagEnabledField:         true,
+		IsMECTScheduledSettingsFlagEnabledField: true,
+	}
+}
+
+func falseHandler() bool {
+	return false
+}
+
 func fillGasMapInternal(gasMap map[string]map[string]uint64, value uint64) map[string]map[string]uint64 {
 	gasMap[core.BaseOperationCostString] = fillGasMapBaseOperationCosts(value)
 	gasMap[core.BuiltInCostString] = fillGasMapBuiltInCosts(value)
@@ -70,6 +106,13 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["MECTNFTAddUri"] = value
 	gasMap["MECTNFTUpdateAttributes"] = value
 	gasMap["MECTNFTMultiTransfer"] = value
+	gasMap["MECTApprove"] = value
+	gasMap["MECTTransferFrom"] = value
+	gasMap["MECTVestedTransfer"] = value
+	gasMap["MECTClaimVested"] = value
+	gasMap["MECTNFTCreateBatch"] = value
+	gasMap["MECTNFTSetURIs"] = value
+	gasMap["MECTNFTRemoveURI"] = value
 
 	return gasMap
 }
@@ -88,9 +131,9 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Equal(t, err, ErrNilShardCoordinator)
 
 	args = createMockArguments()
-	args.EpochNotifier = nil
+	args.EnableEpochsHandler = nil
 	_, err = NewBuiltInFunctionsCreator(args)
-	assert.Equal(t, err, ErrNilEpochHandler)
+	assert.Equal(t, err, ErrNilEnableEpochsHandler)
 
 	args = createMockArguments()
 	args.Marshalizer = nil
@@ -102,6 +145,11 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	_, err = NewBuiltInFunctionsCreator(args)
 	assert.Equal(t, err, ErrNilAccountsAdapter)
 
+	args = createMockArguments()
+	args.Interceptors = []vmcommon.BuiltInFunctionInterceptor{nil}
+	_, err = NewBuiltInFunctionsCreator(args)
+	assert.Equal(t, err, ErrNilBuiltInFunctionInterceptor)
+
 	args = createMockArguments()
 	f, err = NewBuiltInFunctionsCreator(args)
 	assert.Nil(t, err)
@@ -128,7 +176,7 @@ func TestCreateBuiltInContainter_Create(t *testing.T) {
 
 	err := f.CreateBuiltInFunctionContainer()
 	assert.Nil(t, err)
-	assert.Equal(t, f.BuiltInFunctionContainer().Len(), 31)
+	assert.Equal(t, len(builtInFunctionsRegistry()), f.BuiltInFunctionContainer().Len())
 
 	err = f.SetPayableHandler(nil)
 	assert.NotNil(t, err)
@@ -143,3 +191,58 @@ func TestCreateBuiltInContainter_Create(t *testing.T) {
 	nftStorageHandler := f.NFTStorageHandler()
 	assert.False(t, check.IfNil(nftStorageHandler))
 }
+
+func TestCreateBuiltInContainter_CreateWithInterceptors(t *testing.T) {
+	args := createMockArguments()
+	interceptedFunctions := make([]string, 0)
+	args.Interceptors = []vmcommon.BuiltInFunctionInterceptor{
+		&mock.BuiltInFunctionInterceptorStub{
+			BeforeProcessCalled: func(functionName string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) error {
+				interceptedFunctions = append(interceptedFunctions, functionName)
+				return nil
+			},
+		},
+	}
+	f, _ := NewBuiltInFunctionsCreator(args)
+
+	err := f.CreateBuiltInFunctionContainer()
+	assert.Nil(t, err)
+
+	err = f.SetPayableHandler(&mock.PayableHandlerStub{})
+	assert.Nil(t, err)
+
+	builtInFunc, err := f.BuiltInFunctionContainer().Get(core.BuiltInFunctionChangeOwnerAddress)
+	assert.Nil(t, err)
+
+	_, _ = builtInFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, []string{core.BuiltInFunctionChangeOwnerAddress}, interceptedFunctions)
+}
+
+func TestCreateBuiltInContainter_InvalidNumberOfArgumentsShouldNotReachTheInterceptors(t *testing.T) {
+	args := createMockArguments()
+	interceptedFunctions := make([]string, 0)
+	args.Interceptors = []vmcommon.BuiltInFunctionInterceptor{
+		&mock.BuiltInFunctionInterceptorStub{
+			BeforeProcessCalled: func(functionName string, _, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) error {
+				interceptedFunctions = append(interceptedFunctions, functionName)
+				return nil
+			},
+		},
+	}
+	f, _ := NewBuiltInFunctionsCreator(args)
+
+	err := f.CreateBuiltInFunctionContainer()
+	assert.Nil(t, err)
+
+	builtInFunc, err := f.BuiltInFunctionContainer().Get(core.BuiltInFunctionMECTBurn)
+	assert.Nil(t, err)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			Arguments: [][]byte{[]byte("TKN-abcdef")},
+		},
+	}
+	_, err = builtInFunc.ProcessBuiltinFunction(nil, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+	assert.Empty(t, interceptedFunctions)
+}
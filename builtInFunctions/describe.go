@@ -0,0 +1,100 @@
+package builtInFunctions
+
+import (
+	"encoding/json"
+	"sort"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// FunctionDescription holds the runtime details of a built-in function registered in the container
+type FunctionDescription struct {
+	Name                  string   `json:"name"`
+	IsActive              bool     `json:"isActive"`
+	ActivationFlag        string   `json:"activationFlag,omitempty"`
+	ActivationEpoch       *uint32  `json:"activationEpoch,omitempty"`
+	DeactivationEpoch     *uint32  `json:"deactivationEpoch,omitempty"`
+	GasCostKey            string   `json:"gasCostKey,omitempty"`
+	GasCost               uint64   `json:"gasCost"`
+	AcceptsPayableChecker bool     `json:"acceptsPayableChecker"`
+	Dependencies          []string `json:"dependencies"`
+}
+
+// functionMetadataProvider completes the description of a built-in function with the details known by its creator
+type functionMetadataProvider interface {
+	describeFunction(description *FunctionDescription)
+}
+
+// Describe returns the description of all the functions in the container, sorted by name
+func (f *functionContainer) Describe() []FunctionDescription {
+	keys := make([]string, 0, f.Len())
+	for key := range f.Keys() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	descriptions := make([]FunctionDescription, 0, len(keys))
+	for _, key := range keys {
+		function, err := f.getFunction(key)
+		if err != nil {
+			continue
+		}
+
+		_, acceptsPayableChecker := function.(vmcommon.AcceptPayableChecker)
+		description := FunctionDescription{
+			Name:                  key,
+			IsActive:              function.IsActive(),
+			AcceptsPayableChecker: acceptsPayableChecker,
+			Dependencies:          make([]string, 0),
+		}
+		if f.metadataProvider != nil {
+			f.metadataProvider.describeFunction(&description)
+		}
+
+		descriptions = append(descriptions, description)
+	}
+
+	return descriptions
+}
+
+// DescribeJSON returns the JSON rendering of the container description
+func (f *functionContainer) DescribeJSON() ([]byte, error) {
+	return json.Marshal(f.Describe())
+}
+
+// describeFunction completes the description with the activation, gas and dependencies details from the registry
+func (b *builtInFuncCreator) describeFunction(description *FunctionDescription) {
+	definition := b.getDefinition(description.Name)
+	if definition == nil {
+		return
+	}
+
+	b.mutGasConfig.RLock()
+	description.GasCostKey = definition.gasCostKey
+	description.GasCost, _ = getBuiltInCostByKey(b.gasConfig.BuiltInCost, definition.gasCostKey)
+	b.mutGasConfig.RUnlock()
+	description.Dependencies = append(description.Dependencies, definition.dependencies...)
+
+	if len(definition.activationFlag) == 0 {
+		return
+	}
+
+	description.ActivationFlag = definition.activationFlag
+	epoch := b.enableEpochsHandler.GetActivationEpoch(definition.activationFlag)
+	if b.enableEpochsHandler.IsFlagEnabledInEpoch(definition.activationFlag, epoch) {
+		description.ActivationEpoch = &epoch
+		return
+	}
+
+	description.DeactivationEpoch = &epoch
+}
+
+func (b *builtInFuncCreator) getDefinition(name string) *builtInFunctionDefinition {
+	for _, definition := range b.registry {
+		if definition.name == name {
+			return definition
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package builtInFunctions
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDescribedContainer(t *testing.T) *functionContainer {
+	args := createMockArguments()
+	enableEpochsHandler := createEnableEpochsHandlerStubAllFlags()
+	enableEpochsHandler.GetActivationEpochCalled = func(flag string) uint32 {
+		switch flag {
+		case vmcommon.GlobalMintBurnFlag:
+			return 3
+		case vmcommon.MECTNFTImprovementV1Flag:
+			return 7
+		default:
+			return 0
+		}
+	}
+	args.EnableEpochsHandler = enableEpochsHandler
+
+	b, err := NewBuiltInFunctionsCreator(args)
+	require.Nil(t, err)
+	err = b.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	return b.BuiltInFunctionContainer().(*functionContainer)
+}
+
+func getDescription(descriptions []FunctionDescription, name string) FunctionDescription {
+	for _, description := range descriptions {
+		if description.Name == name {
+			return description
+		}
+	}
+
+	return FunctionDescription{}
+}
+
+func TestFunctionContainer_DescribeWithoutMetadata(t *testing.T) {
+	t.Parallel()
+
+	c := NewBuiltInFunctionContainer()
+	_ = c.Add("b", &mock.BuiltInFunctionStub{IsActiveCalled: func() bool { return false }})
+	_ = c.Add("a", &mock.BuiltInFunctionStub{})
+
+	descriptions := c.Describe()
+	expectedDescriptions := []FunctionDescription{
+		{Name: "a", IsActive: true, Dependencies: make([]string, 0)},
+		{Name: "b", IsActive: false, Dependencies: make([]string, 0)},
+	}
+	assert.Equal(t, expectedDescriptions, descriptions)
+}
+
+func TestFunctionContainer_DescribeShouldBeSortedAndComplete(t *testing.T) {
+	t.Parallel()
+
+	c := createDescribedContainer(t)
+	descriptions := c.Describe()
+	require.Equal(t, c.Len(), len(descriptions))
+	assert.True(t, sort.SliceIsSorted(descriptions, func(i, j int) bool {
+		return descriptions[i].Name < descriptions[j].Name
+	}))
+
+	mectTransfer := getDescription(descriptions, core.BuiltInFunctionMECTTransfer)
+	assert.True(t, mectTransfer.IsActive)
+	assert.True(t, mectTransfer.AcceptsPayableChecker)
+	assert.Equal(t, "MECTTransfer", mectTransfer.GasCostKey)
+	assert.Equal(t, uint64(1), mectTransfer.GasCost)
+	assert.Equal(t, []string{globalSettingsDependency, rolesDependency}, mectTransfer.Dependencies)
+	assert.Empty(t, mectTransfer.ActivationFlag)
+	assert.Nil(t, mectTransfer.ActivationEpoch)
+	assert.Nil(t, mectTransfer.DeactivationEpoch)
+
+	addURI := getDescription(descriptions, core.BuiltInFunctionMECTNFTAddURI)
+	assert.True(t, addURI.IsActive)
+	assert.False(t, addURI.AcceptsPayableChecker)
+	assert.Equal(t, vmcommon.MECTNFTImprovementV1Flag, addURI.ActivationFlag)
+	require.NotNil(t, addURI.ActivationEpoch)
+	assert.Equal(t, uint32(7), *addURI.ActivationEpoch)
+	assert.Nil(t, addURI.DeactivationEpoch)
+
+	removeURI := getDescription(descriptions, vmcommon.BuiltInFunctionMECTNFTRemoveURI)
+	assert.Equal(t, "MECTNFTRemoveURI", removeURI.GasCostKey)
+	assert.Equal(t, uint64(1), removeURI.GasCost)
+
+	mectBurn := getDescription(descriptions, core.BuiltInFunctionMECTBurn)
+	assert.False(t, mectBurn.IsActive)
+	assert.Equal(t, vmcommon.GlobalMintBurnFlag, mectBurn.ActivationFlag)
+	assert.Nil(t, mectBurn.ActivationEpoch)
+	require.NotNil(t, mectBurn.DeactivationEpoch)
+	assert.Equal(t, uint32(3), *mectBurn.DeactivationEpoch)
+}
+
+func TestFunctionContainer_DescribeJSON(t *testing.T) {
+	t.Parallel()
+
+	c := createDescribedContainer(t)
+	buff, err := c.DescribeJSON()
+	require.Nil(t, err)
+
+	descriptions := make([]FunctionDescription, 0)
+	err = json.Unmarshal(buff, &descriptions)
+	require.Nil(t, err)
+	assert.Equal(t, c.Describe(), descriptions)
+}
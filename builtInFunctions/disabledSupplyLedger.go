@@ -0,0 +1,36 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// disabledSupplyLedger is a supply ledger which records nothing, used until a real ledger is set
+type disabledSupplyLedger struct {
+}
+
+// GetMECTSupply returns an empty supply
+func (d *disabledSupplyLedger) GetMECTSupply(_ []byte, _ uint64) (*vmcommon.MECTSupply, error) {
+	return newEmptySupply(), nil
+}
+
+// AddMinted does nothing
+func (d *disabledSupplyLedger) AddMinted(_ []byte, _ uint64, _ *big.Int) error {
+	return nil
+}
+
+// AddBurned does nothing
+func (d *disabledSupplyLedger) AddBurned(_ []byte, _ uint64, _ *big.Int) error {
+	return nil
+}
+
+// AddWiped does nothing
+func (d *disabledSupplyLedger) AddWiped(_ []byte, _ uint64, _ *big.Int) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledSupplyLedger) IsInterfaceNil() bool {
+	return d == nil
+}
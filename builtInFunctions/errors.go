@@ -1,165 +1,272 @@
 package builtInFunctions
 
-import (
-	"errors"
-)
-
 // ErrNilAccountsAdapter defines the error when trying to use a nil AccountsAddapter
-var ErrNilAccountsAdapter = errors.New("nil AccountsAdapter")
+var ErrNilAccountsAdapter = newBuiltInError(1, CategoryInternal, "nil AccountsAdapter")
 
 // ErrInsufficientFunds signals the funds are insufficient for the move balance operation but the
 // transaction fee is covered by the current balance
-var ErrInsufficientFunds = errors.New("insufficient funds")
+var ErrInsufficientFunds = newBuiltInError(2, CategoryFunds, "insufficient funds")
 
 // ErrNilValue signals the value is nil
-var ErrNilValue = errors.New("nil value")
+var ErrNilValue = newBuiltInError(3, CategoryInput, "nil value")
 
 // ErrNilMarshalizer signals that an operation has been attempted to or with a nil Marshalizer implementation
-var ErrNilMarshalizer = errors.New("nil Marshalizer")
+var ErrNilMarshalizer = newBuiltInError(4, CategoryInternal, "nil Marshalizer")
 
 // ErrInvalidRcvAddr signals that an invalid receiver address was provided
-var ErrInvalidRcvAddr = errors.New("invalid receiver address")
+var ErrInvalidRcvAddr = newBuiltInError(5, CategoryInput, "invalid receiver address")
 
 // ErrNegativeValue signals that a negative value has been detected and it is not allowed
-var ErrNegativeValue = errors.New("negative value")
+var ErrNegativeValue = newBuiltInError(6, CategoryInput, "negative value")
 
 // ErrNilShardCoordinator signals that an operation has been attempted to or with a nil shard coordinator
-var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+var ErrNilShardCoordinator = newBuiltInError(7, CategoryInternal, "nil shard coordinator")
 
 // ErrWrongTypeAssertion signals that an type assertion failed
-var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+var ErrWrongTypeAssertion = newBuiltInError(8, CategoryInternal, "wrong type assertion")
 
 // ErrNilSCDestAccount signals that destination account is nil
-var ErrNilSCDestAccount = errors.New("nil destination SC account")
+var ErrNilSCDestAccount = newBuiltInError(9, CategoryInput, "nil destination SC account")
 
 // ErrNilEpochHandler signals that a nil epoch handler was provided
-var ErrNilEpochHandler = errors.New("nil epoch handler")
+var ErrNilEpochHandler = newBuiltInError(10, CategoryInternal, "nil epoch handler")
 
 // ErrNotEnoughGas signals that not enough gas has been provided
-var ErrNotEnoughGas = errors.New("not enough gas was sent in the transaction")
+var ErrNotEnoughGas = newBuiltInError(11, CategoryGas, "not enough gas was sent in the transaction")
 
 // ErrInvalidArguments signals that invalid arguments were given to process built-in function
-var ErrInvalidArguments = errors.New("invalid arguments to process built-in function")
+var ErrInvalidArguments = newBuiltInError(12, CategoryInput, "invalid arguments to process built-in function")
 
 // ErrOperationNotPermitted signals that operation is not permitted
-var ErrOperationNotPermitted = errors.New("operation in account not permitted")
+var ErrOperationNotPermitted = newBuiltInError(13, CategoryPermission, "operation in account not permitted")
 
 // ErrInvalidAddressLength signals that address length is invalid
-var ErrInvalidAddressLength = errors.New("invalid address length")
+var ErrInvalidAddressLength = newBuiltInError(14, CategoryInput, "invalid address length")
 
 // ErrNilVmInput signals that provided vm input is nil
-var ErrNilVmInput = errors.New("nil vm input")
+var ErrNilVmInput = newBuiltInError(15, CategoryInternal, "nil vm input")
 
 // ErrNilDnsAddresses signals that nil dns addresses map was provided
-var ErrNilDnsAddresses = errors.New("nil dns addresses map")
+var ErrNilDnsAddresses = newBuiltInError(16, CategoryInternal, "nil dns addresses map")
 
 // ErrCallerIsNotTheDNSAddress signals that called address is not the DNS address
-var ErrCallerIsNotTheDNSAddress = errors.New("not a dns address")
+var ErrCallerIsNotTheDNSAddress = newBuiltInError(17, CategoryPermission, "not a dns address")
 
 // ErrUserNameChangeIsDisabled signals the user name change is not allowed
-var ErrUserNameChangeIsDisabled = errors.New("user name change is disabled")
+var ErrUserNameChangeIsDisabled = newBuiltInError(18, CategoryNotActive, "user name change is disabled")
 
 // ErrBuiltInFunctionCalledWithValue signals that builtin function was called with value that is not allowed
-var ErrBuiltInFunctionCalledWithValue = errors.New("built in function called with tx value is not allowed")
+var ErrBuiltInFunctionCalledWithValue = newBuiltInError(19, CategoryInput, "built in function called with tx value is not allowed")
 
 // ErrAccountNotPayable will be sent when trying to send tokens to a non-payableCheck account
-var ErrAccountNotPayable = errors.New("sending value to non payable contract")
+var ErrAccountNotPayable = newBuiltInError(20, CategoryPermission, "sending value to non payable contract")
 
 // ErrNilUserAccount signals that nil user account was provided
-var ErrNilUserAccount = errors.New("nil user account")
+var ErrNilUserAccount = newBuiltInError(21, CategoryInput, "nil user account")
 
 // ErrAddressIsNotMECTSystemSC signals that destination is not a system sc address
-var ErrAddressIsNotMECTSystemSC = errors.New("destination is not system sc address")
+var ErrAddressIsNotMECTSystemSC = newBuiltInError(22, CategoryPermission, "destination is not system sc address")
 
 // ErrOnlySystemAccountAccepted signals that only system account is accepted
-var ErrOnlySystemAccountAccepted = errors.New("only system account is accepted")
+var ErrOnlySystemAccountAccepted = newBuiltInError(23, CategoryPermission, "only system account is accepted")
 
 // ErrNilGlobalSettingsHandler signals that nil pause handler has been provided
-var ErrNilGlobalSettingsHandler = errors.New("nil pause handler")
+var ErrNilGlobalSettingsHandler = newBuiltInError(24, CategoryInternal, "nil pause handler")
 
 // ErrNilRolesHandler signals that nil roles handler has been provided
-var ErrNilRolesHandler = errors.New("nil roles handler")
+var ErrNilRolesHandler = newBuiltInError(25, CategoryInternal, "nil roles handler")
 
 // ErrMECTTokenIsPaused signals that mect token is paused
-var ErrMECTTokenIsPaused = errors.New("mect token is paused")
+var ErrMECTTokenIsPaused = newBuiltInError(26, CategoryPermission, "mect token is paused")
 
 // ErrMECTIsFrozenForAccount signals that account is frozen for given mect token
-var ErrMECTIsFrozenForAccount = errors.New("account is frozen for this mect token")
+var ErrMECTIsFrozenForAccount = newBuiltInError(27, CategoryPermission, "account is frozen for this mect token")
 
 // ErrCannotWipeAccountNotFrozen signals that account isn't frozen so the wipe is not possible
-var ErrCannotWipeAccountNotFrozen = errors.New("cannot wipe because the account is not frozen for this mect token")
+var ErrCannotWipeAccountNotFrozen = newBuiltInError(28, CategoryState, "cannot wipe because the account is not frozen for this mect token")
 
 // ErrNilPayableHandler signals that nil payableHandler was provided
-var ErrNilPayableHandler = errors.New("nil payableHandler was provided")
+var ErrNilPayableHandler = newBuiltInError(29, CategoryInternal, "nil payableHandler was provided")
 
 // ErrActionNotAllowed signals that action is not allowed
-var ErrActionNotAllowed = errors.New("action is not allowed")
+var ErrActionNotAllowed = newBuiltInError(30, CategoryPermission, "action is not allowed")
 
 // ErrOnlyFungibleTokensHaveBalanceTransfer signals that only fungible tokens have balance transfer
-var ErrOnlyFungibleTokensHaveBalanceTransfer = errors.New("only fungible tokens have balance transfer")
+var ErrOnlyFungibleTokensHaveBalanceTransfer = newBuiltInError(31, CategoryInput, "only fungible tokens have balance transfer")
 
 // ErrNFTTokenDoesNotExist signals that NFT token does not exist
-var ErrNFTTokenDoesNotExist = errors.New("NFT token does not exist")
+var ErrNFTTokenDoesNotExist = newBuiltInError(32, CategoryState, "NFT token does not exist")
 
 // ErrNFTDoesNotHaveMetadata signals that NFT does not have metadata
-var ErrNFTDoesNotHaveMetadata = errors.New("NFT does not have metadata")
+var ErrNFTDoesNotHaveMetadata = newBuiltInError(33, CategoryState, "NFT does not have metadata")
 
 // ErrInvalidNFTQuantity signals that invalid NFT quantity was provided
-var ErrInvalidNFTQuantity = errors.New("invalid NFT quantity")
+var ErrInvalidNFTQuantity = newBuiltInError(34, CategoryInput, "invalid NFT quantity")
 
 // ErrNewNFTDataOnSenderAddress signals that a new NFT data was found on the sender address
-var ErrNewNFTDataOnSenderAddress = errors.New("new NFT data on sender")
+var ErrNewNFTDataOnSenderAddress = newBuiltInError(35, CategoryState, "new NFT data on sender")
 
 // ErrNilContainerElement signals when trying to add a nil element in the container
-var ErrNilContainerElement = errors.New("element cannot be nil")
+var ErrNilContainerElement = newBuiltInError(36, CategoryInternal, "element cannot be nil")
 
 // ErrInvalidContainerKey signals that an element does not exist in the container's map
-var ErrInvalidContainerKey = errors.New("element does not exist in container")
+var ErrInvalidContainerKey = newBuiltInError(37, CategoryInternal, "element does not exist in container")
 
 // ErrContainerKeyAlreadyExists signals that an element was already set in the container's map
-var ErrContainerKeyAlreadyExists = errors.New("provided key already exists in container")
+var ErrContainerKeyAlreadyExists = newBuiltInError(38, CategoryInternal, "provided key already exists in container")
 
 // ErrWrongTypeInContainer signals that a wrong type of object was found in container
-var ErrWrongTypeInContainer = errors.New("wrong type of object inside container")
+var ErrWrongTypeInContainer = newBuiltInError(39, CategoryInternal, "wrong type of object inside container")
 
 // ErrEmptyFunctionName signals that an empty function name has been provided
-var ErrEmptyFunctionName = errors.New("empty function name")
+var ErrEmptyFunctionName = newBuiltInError(40, CategoryInternal, "empty function name")
 
 // ErrInsufficientQuantityMECT signals the funds are insufficient for the MECT transfer
-var ErrInsufficientQuantityMECT = errors.New("insufficient quantity")
+var ErrInsufficientQuantityMECT = newBuiltInError(41, CategoryFunds, "insufficient quantity")
 
 // ErrNilMECTNFTStorageHandler signals that a nil nft storage handler has been provided
-var ErrNilMECTNFTStorageHandler = errors.New("nil mect nft storage handler")
+var ErrNilMECTNFTStorageHandler = newBuiltInError(42, CategoryInternal, "nil mect nft storage handler")
 
 // ErrNilTransactionHandler signals that a nil transaction handler has been provided
-var ErrNilTransactionHandler = errors.New("nil transaction handler")
+var ErrNilTransactionHandler = newBuiltInError(43, CategoryInternal, "nil transaction handler")
 
 // ErrAddressIsNotAllowed signals that sender is not allowed to do the action
-var ErrAddressIsNotAllowed = errors.New("address is not allowed to do the action")
+var ErrAddressIsNotAllowed = newBuiltInError(44, CategoryPermission, "address is not allowed to do the action")
 
 // ErrInvalidNumOfArgs signals that the number of arguments is invalid
-var ErrInvalidNumOfArgs = errors.New("invalid number of arguments")
+var ErrInvalidNumOfArgs = newBuiltInError(45, CategoryInput, "invalid number of arguments")
 
 // ErrInvalidNonce signals that invalid nonce for mect
-var ErrInvalidNonce = errors.New("invalid nonce for mect")
+var ErrInvalidNonce = newBuiltInError(46, CategoryInput, "invalid nonce for mect")
 
 // ErrTokenHasValidMetadata signals that token has a valid metadata
-var ErrTokenHasValidMetadata = errors.New("token has valid metadata")
+var ErrTokenHasValidMetadata = newBuiltInError(47, CategoryState, "token has valid metadata")
 
 // ErrInvalidTokenID signals that invalid tokenID was provided
-var ErrInvalidTokenID = errors.New("invalid tokenID")
+var ErrInvalidTokenID = newBuiltInError(48, CategoryInput, "invalid tokenID")
 
 // ErrNilMECTData signals that MECT data does not exist
-var ErrNilMECTData = errors.New("nil mect data")
+var ErrNilMECTData = newBuiltInError(49, CategoryInternal, "nil mect data")
 
 // ErrInvalidMetadata signals that invalid metadata was provided
-var ErrInvalidMetadata = errors.New("invalid metadata")
+var ErrInvalidMetadata = newBuiltInError(50, CategoryInput, "invalid metadata")
 
 // ErrInvalidLiquidityForMECT signals that liquidity is invalid for MECT
-var ErrInvalidLiquidityForMECT = errors.New("invalid liquidity for MECT")
+var ErrInvalidLiquidityForMECT = newBuiltInError(51, CategoryState, "invalid liquidity for MECT")
 
 // ErrTooManyTransferAddresses signals that too many transfer address roles has been added
-var ErrTooManyTransferAddresses = errors.New("too many transfer addresses")
+var ErrTooManyTransferAddresses = newBuiltInError(52, CategoryInput, "too many transfer addresses")
 
 // ErrInvalidMaxNumAddresses signals that there is an invalid max number of addresses
-var ErrInvalidMaxNumAddresses = errors.New("invalid max number of addresses")
+var ErrInvalidMaxNumAddresses = newBuiltInError(53, CategoryInternal, "invalid max number of addresses")
+
+// ErrNilBuiltInFunctionCreateHandler signals that a nil create handler was declared for a built-in function
+var ErrNilBuiltInFunctionCreateHandler = newBuiltInError(54, CategoryInternal, "nil built-in function create handler")
+
+// ErrInvalidGasCostKey signals that the gas cost key does not name a built-in cost
+var ErrInvalidGasCostKey = newBuiltInError(55, CategoryInternal, "invalid gas cost key")
+
+// ErrUnknownDependency signals that an unknown dependency was declared for a built-in function
+var ErrUnknownDependency = newBuiltInError(56, CategoryInternal, "unknown dependency")
+
+// ErrMissingDependency signals that a dependency required by a built-in function was not created
+var ErrMissingDependency = newBuiltInError(57, CategoryInternal, "missing dependency")
+
+// ErrBuiltInFunctionIsNotActive signals that the built-in function is not active
+var ErrBuiltInFunctionIsNotActive = newBuiltInError(58, CategoryNotActive, "built-in function is not active")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler was provided
+var ErrNilEnableEpochsHandler = newBuiltInError(59, CategoryInternal, "nil enable epochs handler")
+
+// ErrNilActiveHandler signals that a nil active handler was provided
+var ErrNilActiveHandler = newBuiltInError(60, CategoryInternal, "nil active handler")
+
+// ErrGasEstimationNotSupported signals that the built-in function can not estimate the gas it consumes
+var ErrGasEstimationNotSupported = newBuiltInError(61, CategoryInternal, "gas estimation not supported")
+
+// ErrNilBuiltInFunctionInterceptor signals that a nil built-in function interceptor has been provided
+var ErrNilBuiltInFunctionInterceptor = newBuiltInError(62, CategoryInternal, "nil built-in function interceptor")
+
+// ErrInvalidGasCostValue signals that a gas cost value is out of the accepted bounds
+var ErrInvalidGasCostValue = newBuiltInError(63, CategoryInternal, "invalid gas cost value")
+
+// ErrBuiltInFunctionNameCollision signals that a plugin uses the name of an already known built-in function
+var ErrBuiltInFunctionNameCollision = newBuiltInError(64, CategoryInternal, "built-in function name collision")
+
+// ErrUnknownPlugin signals that the enabled plugins list contains a plugin which was not registered
+var ErrUnknownPlugin = newBuiltInError(65, CategoryInternal, "unknown plugin")
+
+// ErrNilSupplyLedger signals that a nil supply ledger has been provided
+var ErrNilSupplyLedger = newBuiltInError(66, CategoryInternal, "nil supply ledger")
+
+// ErrInvalidSupplyData signals that the supply data saved on the system account can not be decoded
+var ErrInvalidSupplyData = newBuiltInError(67, CategoryInternal, "invalid supply data")
+
+// ErrAccountDataIterationNotSupported signals that the account data handler can not iterate over its keys
+var ErrAccountDataIterationNotSupported = newBuiltInError(68, CategoryInternal, "account data iteration not supported")
+
+// ErrInsufficientAllowance signals that the allowance given by the owner does not cover the transferred value
+var ErrInsufficientAllowance = newBuiltInError(69, CategoryFunds, "insufficient allowance")
+
+// ErrAllowanceExpired signals that the allowance expired
+var ErrAllowanceExpired = newBuiltInError(70, CategoryState, "allowance expired")
+
+// ErrInvalidAllowanceData signals that the allowance saved on the owner account can not be decoded
+var ErrInvalidAllowanceData = newBuiltInError(71, CategoryInternal, "invalid allowance data")
+
+// ErrInvalidVestingSchedule signals that the provided vesting schedule is not valid
+var ErrInvalidVestingSchedule = newBuiltInError(72, CategoryInput, "invalid vesting schedule")
+
+// ErrNothingToClaim signals that no locked tokens were released so far
+var ErrNothingToClaim = newBuiltInError(73, CategoryState, "nothing to claim")
+
+// ErrInvalidVestingData signals that the vesting data saved on the account can not be decoded
+var ErrInvalidVestingData = newBuiltInError(74, CategoryInternal, "invalid vesting data")
+
+// ErrTooManyVestingSchedules signals that the account already holds the maximum number of vesting schedules for the token
+var ErrTooManyVestingSchedules = newBuiltInError(75, CategoryState, "too many vesting schedules")
+
+// ErrRoyaltiesExceedPayment signals that the royalties of the transferred NFTs exceed the payment
+var ErrRoyaltiesExceedPayment = newBuiltInError(76, CategoryInput, "royalties exceed payment")
+
+// ErrURINotFound signals that the URI to be removed does not exist in the NFT metadata
+var ErrURINotFound = newBuiltInError(77, CategoryState, "URI not found")
+
+// ErrMECTMetadataIsFrozen signals that the metadata of the NFTs of the collection can not be changed anymore
+var ErrMECTMetadataIsFrozen = newBuiltInError(78, CategoryPermission, "mect metadata is frozen")
+
+// ErrMECTTokenIsSoulbound signals that the mect token is soulbound and can not be transferred
+var ErrMECTTokenIsSoulbound = newBuiltInError(79, CategoryPermission, "mect token is soulbound")
+
+// ErrInvalidTransferFee signals that the transfer fee basis points are not in the accepted interval
+var ErrInvalidTransferFee = newBuiltInError(80, CategoryInput, "invalid transfer fee")
+
+// ErrMaxSupplyExceeded signals that the mint would take the supply of the token over its maximum supply
+var ErrMaxSupplyExceeded = newBuiltInError(81, CategoryPermission, "max supply exceeded")
+
+// ErrMaxSupplyAlreadySet signals that the maximum supply of the token was already set
+var ErrMaxSupplyAlreadySet = newBuiltInError(82, CategoryState, "max supply already set")
+
+// ErrInvalidRolesData signals that the roles saved on the account can not be decoded
+var ErrInvalidRolesData = newBuiltInError(83, CategoryInternal, "invalid roles data")
+
+// ErrMintQuotaExceeded signals that the mint would take the account over its mint quota
+var ErrMintQuotaExceeded = newBuiltInError(84, CategoryPermission, "mint quota exceeded")
+
+// ErrInvalidMintQuotaData signals that the mint quota saved on the account can not be decoded
+var ErrInvalidMintQuotaData = newBuiltInError(85, CategoryInternal, "invalid mint quota data")
+
+// ErrScheduledSettingNotFound signals that the scheduled setting to be cancelled does not exist or already took effect
+var ErrScheduledSettingNotFound = newBuiltInError(86, CategoryState, "scheduled setting not found")
+
+// ErrTransferFeeNotSupported signals that the built-in function can not move a fungible token with a transfer fee
+var ErrTransferFeeNotSupported = newBuiltInError(87, CategoryPermission, "transfer fee not supported")
+
+// ErrSupplyLedgerNotActive signals that the built-in function can not be used before the supply ledger is active
+var ErrSupplyLedgerNotActive = newBuiltInError(88, CategoryNotActive, "supply ledger not active")
+
+// ErrRequiredFlagActivatedLater signals that a built-in function is activated before a flag it relies on
+var ErrRequiredFlagActivatedLater = newBuiltInError(89, CategoryInternal, "required flag activated later")
+
+// ErrInvalidArgumentsShape signals that a built-in function declares an invalid number of accepted arguments
+var ErrInvalidArgumentsShape = newBuiltInError(90, CategoryInternal, "invalid arguments shape")
@@ -0,0 +1,235 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	// maxGasCostValue is the upper bound of every gas cost field, keeping the gas arithmetic far from overflows
+	maxGasCostValue = uint64(math.MaxUint32)
+
+	defaultGasScheduleHistorySize = 10
+
+	baseOperationCostSection = "BaseOperationCost"
+	builtInCostSection       = "BuiltInCost"
+)
+
+// GasCostChange holds a gas cost field changed by a gas schedule update
+type GasCostChange struct {
+	Section  string
+	Field    string
+	OldValue uint64
+	NewValue uint64
+}
+
+// GasCostViolation holds a gas cost field which is out of the accepted bounds
+type GasCostViolation struct {
+	Section string
+	Field   string
+	Value   uint64
+	Reason  string
+}
+
+// GasScheduleChangeReport holds the outcome of a gas schedule update. When the update is rejected, no built-in function
+// was changed and Err holds the reason
+type GasScheduleChangeReport struct {
+	Applied    bool
+	Epoch      uint32
+	Changes    []GasCostChange
+	Violations []GasCostViolation
+	Err        error
+}
+
+// GasScheduleHistoryEntry holds a gas schedule applied on the built-in functions
+type GasScheduleHistoryEntry struct {
+	Epoch   uint32
+	GasCost vmcommon.GasCost
+	Changes []GasCostChange
+}
+
+// GasScheduleChange is called when gas schedule is changed, thus all contracts must be updated
+func (b *builtInFuncCreator) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
+	report := b.ApplyGasSchedule(gasSchedule)
+	if !report.Applied {
+		log.Warn("builtInFuncCreator.GasScheduleChange: gas schedule rejected",
+			"epoch", report.Epoch, "error", report.Err, "num violations", len(report.Violations))
+		return
+	}
+
+	log.Debug("builtInFuncCreator.GasScheduleChange: gas schedule applied",
+		"epoch", report.Epoch, "num changes", len(report.Changes))
+}
+
+// ApplyGasSchedule validates the provided gas schedule and applies it on all the built-in functions or on none of them
+func (b *builtInFuncCreator) ApplyGasSchedule(gasSchedule map[string]map[string]uint64) *GasScheduleChangeReport {
+	report := &GasScheduleChangeReport{
+		Epoch: b.enableEpochsHandler.GetCurrentEpoch(),
+	}
+
+	newGasConfig, err := decodeGasConfig(gasSchedule)
+	if err != nil {
+		report.Err = err
+		return report
+	}
+
+	report.Violations = checkGasCostBounds(newGasConfig)
+	if len(report.Violations) > 0 {
+		report.Err = violationToError(report.Violations[0])
+		return report
+	}
+
+	b.mutGasConfig.Lock()
+	defer b.mutGasConfig.Unlock()
+
+	report.Changes = diffGasCost(b.gasConfig, newGasConfig)
+
+	functions := make([]vmcommon.BuiltinFunction, 0, b.builtInFunctions.Len())
+	for key := range b.builtInFunctions.Keys() {
+		builtInFunc, errGet := b.builtInFunctions.Get(key)
+		if errGet != nil {
+			report.Err = errGet
+			return report
+		}
+
+		functions = append(functions, builtInFunc)
+	}
+
+	b.gasConfig = newGasConfig
+	for _, builtInFunc := range functions {
+		builtInFunc.SetNewGasConfig(b.gasConfig)
+	}
+
+	b.addToGasScheduleHistory(report.Epoch, report.Changes)
+	report.Applied = true
+
+	return report
+}
+
+// GasScheduleHistory returns the last applied gas schedules, the oldest first
+func (b *builtInFuncCreator) GasScheduleHistory() []GasScheduleHistoryEntry {
+	b.mutGasConfig.RLock()
+	defer b.mutGasConfig.RUnlock()
+
+	history := make([]GasScheduleHistoryEntry, len(b.gasScheduleHistory))
+	copy(history, b.gasScheduleHistory)
+
+	return history
+}
+
+// addToGasScheduleHistory must be called under the gas config mutex
+func (b *builtInFuncCreator) addToGasScheduleHistory(epoch uint32, changes []GasCostChange) {
+	b.gasScheduleHistory = append(b.gasScheduleHistory, GasScheduleHistoryEntry{
+		Epoch:   epoch,
+		GasCost: *b.gasConfig,
+		Changes: changes,
+	})
+
+	numToRemove := len(b.gasScheduleHistory) - int(b.gasScheduleHistorySize)
+	if numToRemove > 0 {
+		b.gasScheduleHistory = b.gasScheduleHistory[numToRemove:]
+	}
+}
+
+func createGasConfig(gasMap map[string]map[string]uint64) (*vmcommon.GasCost, error) {
+	gasCost, err := decodeGasConfig(gasMap)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := checkGasCostBounds(gasCost)
+	if len(violations) > 0 {
+		return nil, violationToError(violations[0])
+	}
+
+	return gasCost, nil
+}
+
+func decodeGasConfig(gasMap map[string]map[string]uint64) (*vmcommon.GasCost, error) {
+	baseOps := &vmcommon.BaseOperationCost{}
+	err := mapstructure.Decode(gasMap[core.BaseOperationCostString], baseOps)
+	if err != nil {
+		return nil, err
+	}
+
+	builtInOps := &vmcommon.BuiltInCost{}
+	err = mapstructure.Decode(gasMap[core.BuiltInCostString], builtInOps)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCost := vmcommon.GasCost{
+		BaseOperationCost: *baseOps,
+		BuiltInCost:       *builtInOps,
+	}
+
+	return &gasCost, nil
+}
+
+func checkGasCostBounds(gasCost *vmcommon.GasCost) []GasCostViolation {
+	violations := make([]GasCostViolation, 0)
+	checkSection := func(section string, value reflect.Value) {
+		for i := 0; i < value.NumField(); i++ {
+			if value.Field(i).Kind() != reflect.Uint64 {
+				continue
+			}
+
+			fieldValue := value.Field(i).Uint()
+			violation := GasCostViolation{
+				Section: section,
+				Field:   value.Type().Field(i).Name,
+				Value:   fieldValue,
+			}
+			switch {
+			case fieldValue == 0:
+				violation.Reason = "value is zero"
+			case fieldValue > maxGasCostValue:
+				violation.Reason = fmt.Sprintf("value is above %d", maxGasCostValue)
+			default:
+				continue
+			}
+
+			violations = append(violations, violation)
+		}
+	}
+
+	checkSection(baseOperationCostSection, reflect.ValueOf(gasCost.BaseOperationCost))
+	checkSection(builtInCostSection, reflect.ValueOf(gasCost.BuiltInCost))
+
+	return violations
+}
+
+func diffGasCost(oldGasCost *vmcommon.GasCost, newGasCost *vmcommon.GasCost) []GasCostChange {
+	changes := make([]GasCostChange, 0)
+	diffSection := func(section string, oldValue reflect.Value, newValue reflect.Value) {
+		for i := 0; i < newValue.NumField(); i++ {
+			if newValue.Field(i).Kind() != reflect.Uint64 {
+				continue
+			}
+			if oldValue.Field(i).Uint() == newValue.Field(i).Uint() {
+				continue
+			}
+
+			changes = append(changes, GasCostChange{
+				Section:  section,
+				Field:    newValue.Type().Field(i).Name,
+				OldValue: oldValue.Field(i).Uint(),
+				NewValue: newValue.Field(i).Uint(),
+			})
+		}
+	}
+
+	diffSection(baseOperationCostSection, reflect.ValueOf(oldGasCost.BaseOperationCost), reflect.ValueOf(newGasCost.BaseOperationCost))
+	diffSection(builtInCostSection, reflect.ValueOf(oldGasCost.BuiltInCost), reflect.ValueOf(newGasCost.BuiltInCost))
+
+	return changes
+}
+
+func violationToError(violation GasCostViolation) error {
+	return fmt.Errorf("%w: %s.%s %s", ErrInvalidGasCostValue, violation.Section, violation.Field, violation.Reason)
+}
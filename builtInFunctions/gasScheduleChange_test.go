@@ -0,0 +1,132 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGasScheduleCreator(t *testing.T) (*builtInFuncCreator, *mock.EnableEpochsHandlerStub) {
+	args := createMockArguments()
+	enableEpochsHandler := createEnableEpochsHandlerStubAllFlags()
+	args.EnableEpochsHandler = enableEpochsHandler
+	args.GasScheduleHistorySize = 2
+
+	b, err := NewBuiltInFunctionsCreator(args)
+	require.Nil(t, err)
+	err = b.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	return b, enableEpochsHandler
+}
+
+func TestBuiltInFuncCreator_ApplyGasScheduleShouldReportChanges(t *testing.T) {
+	t.Parallel()
+
+	b, enableEpochsHandler := createGasScheduleCreator(t)
+	enableEpochsHandler.CurrentEpochField = 4
+
+	gasMap := fillGasMapInternal(make(map[string]map[string]uint64), 1)
+	gasMap[core.BaseOperationCostString]["StorePerByte"] = 3
+	gasMap[core.BuiltInCostString]["MECTTransfer"] = 7
+
+	report := b.ApplyGasSchedule(gasMap)
+	assert.True(t, report.Applied)
+	assert.Nil(t, report.Err)
+	assert.Empty(t, report.Violations)
+	assert.Equal(t, uint32(4), report.Epoch)
+	expectedChanges := []GasCostChange{
+		{Section: baseOperationCostSection, Field: "StorePerByte", OldValue: 1, NewValue: 3},
+		{Section: builtInCostSection, Field: "MECTTransfer", OldValue: 1, NewValue: 7},
+	}
+	assert.Equal(t, expectedChanges, report.Changes)
+	assert.Equal(t, uint64(7), b.gasConfig.BuiltInCost.MECTTransfer)
+
+	mectTransferFunc, _ := b.BuiltInFunctionContainer().Get(core.BuiltInFunctionMECTTransfer)
+	assert.Equal(t, uint64(7), mectTransferFunc.(vmcommon.WrappedBuiltinFunction).Unwrap().(*mectTransfer).funcGasCost)
+}
+
+func TestBuiltInFuncCreator_ApplyGasScheduleShouldRejectOutOfBoundsValues(t *testing.T) {
+	t.Parallel()
+
+	b, _ := createGasScheduleCreator(t)
+
+	gasMap := fillGasMapInternal(make(map[string]map[string]uint64), 5)
+	gasMap[core.BaseOperationCostString]["PersistPerByte"] = 0
+	gasMap[core.BuiltInCostString]["MECTBurn"] = maxGasCostValue + 1
+
+	report := b.ApplyGasSchedule(gasMap)
+	assert.False(t, report.Applied)
+	assert.True(t, errors.Is(report.Err, ErrInvalidGasCostValue))
+	require.Equal(t, 2, len(report.Violations))
+	assert.Equal(t, "PersistPerByte", report.Violations[0].Field)
+	assert.Equal(t, baseOperationCostSection, report.Violations[0].Section)
+	assert.Equal(t, "MECTBurn", report.Violations[1].Field)
+	assert.Equal(t, maxGasCostValue+1, report.Violations[1].Value)
+
+	assert.Equal(t, uint64(1), b.gasConfig.BuiltInCost.MECTTransfer)
+	assert.Equal(t, 1, len(b.GasScheduleHistory()))
+}
+
+func TestBuiltInFuncCreator_ApplyGasScheduleShouldBeAtomic(t *testing.T) {
+	t.Parallel()
+
+	b, _ := createGasScheduleCreator(t)
+	setCalled := false
+	functionContainer := NewBuiltInFunctionContainer()
+	_ = functionContainer.Add("fn", &mock.BuiltInFunctionStub{
+		SetNewGasConfigCalled: func(_ *vmcommon.GasCost) {
+			setCalled = true
+		},
+	})
+	b.builtInFunctions = &failingGetContainer{
+		BuiltInFunctionContainer: functionContainer,
+		failingKey:               "missing",
+	}
+
+	report := b.ApplyGasSchedule(fillGasMapInternal(make(map[string]map[string]uint64), 5))
+	assert.False(t, report.Applied)
+	assert.True(t, errors.Is(report.Err, ErrInvalidContainerKey))
+	assert.False(t, setCalled)
+	assert.Equal(t, uint64(1), b.gasConfig.BuiltInCost.MECTTransfer)
+}
+
+func TestBuiltInFuncCreator_GasScheduleHistory(t *testing.T) {
+	t.Parallel()
+
+	b, enableEpochsHandler := createGasScheduleCreator(t)
+	history := b.GasScheduleHistory()
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, uint32(0), history[0].Epoch)
+	assert.Equal(t, uint64(1), history[0].GasCost.BuiltInCost.MECTTransfer)
+
+	enableEpochsHandler.CurrentEpochField = 2
+	b.GasScheduleChange(fillGasMapInternal(make(map[string]map[string]uint64), 2))
+	enableEpochsHandler.CurrentEpochField = 3
+	b.GasScheduleChange(fillGasMapInternal(make(map[string]map[string]uint64), 3))
+
+	history = b.GasScheduleHistory()
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, uint32(2), history[0].Epoch)
+	assert.Equal(t, uint64(2), history[0].GasCost.BuiltInCost.MECTTransfer)
+	assert.Equal(t, uint32(3), history[1].Epoch)
+	assert.Equal(t, uint64(3), history[1].GasCost.BuiltInCost.MECTTransfer)
+	assert.NotEmpty(t, history[1].Changes)
+}
+
+type failingGetContainer struct {
+	vmcommon.BuiltInFunctionContainer
+	failingKey string
+}
+
+func (f *failingGetContainer) Keys() map[string]struct{} {
+	keys := f.BuiltInFunctionContainer.Keys()
+	keys[f.failingKey] = struct{}{}
+
+	return keys
+}
@@ -0,0 +1,122 @@
+package builtInFunctions
+
+import (
+	"sync"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// interceptorsChain holds the interceptors registered on a container, in registration order
+type interceptorsChain struct {
+	mut          sync.RWMutex
+	interceptors []vmcommon.BuiltInFunctionInterceptor
+}
+
+func (ic *interceptorsChain) add(interceptor vmcommon.BuiltInFunctionInterceptor) {
+	ic.mut.Lock()
+	ic.interceptors = append(ic.interceptors, interceptor)
+	ic.mut.Unlock()
+}
+
+func (ic *interceptorsChain) isEmpty() bool {
+	ic.mut.RLock()
+	defer ic.mut.RUnlock()
+
+	return len(ic.interceptors) == 0
+}
+
+func (ic *interceptorsChain) snapshot() []vmcommon.BuiltInFunctionInterceptor {
+	ic.mut.RLock()
+	defer ic.mut.RUnlock()
+
+	interceptors := make([]vmcommon.BuiltInFunctionInterceptor, len(ic.interceptors))
+	copy(interceptors, ic.interceptors)
+
+	return interceptors
+}
+
+var _ vmcommon.BuiltinFunction = (*interceptedFunction)(nil)
+var _ vmcommon.AcceptPayableChecker = (*interceptedFunction)(nil)
+var _ vmcommon.GasEstimator = (*interceptedFunction)(nil)
+var _ vmcommon.WrappedBuiltinFunction = (*interceptedFunction)(nil)
+
+// interceptedFunction wraps a built-in function so that every ProcessBuiltinFunction call goes through the
+// interceptors chain. The before hooks are called in registration order and the after hooks in reverse order,
+// only for the interceptors whose before hook was called
+type interceptedFunction struct {
+	name     string
+	function vmcommon.BuiltinFunction
+	chain    *interceptorsChain
+}
+
+// ProcessBuiltinFunction calls the before hooks, the wrapped function if no interceptor vetoed it and the after hooks
+func (i *interceptedFunction) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	interceptors := i.chain.snapshot()
+
+	var vmOutput *vmcommon.VMOutput
+	var err error
+	numCalled := 0
+	for _, interceptor := range interceptors {
+		numCalled++
+		err = interceptor.BeforeProcess(i.name, acntSnd, acntDst, vmInput)
+		if err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		vmOutput, err = i.function.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	}
+
+	for idx := numCalled - 1; idx >= 0; idx-- {
+		interceptors[idx].AfterProcess(i.name, vmInput, vmOutput, err)
+	}
+
+	return vmOutput, err
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (i *interceptedFunction) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	i.function.SetNewGasConfig(gasCost)
+}
+
+// IsActive returns true if the wrapped function is active
+func (i *interceptedFunction) IsActive() bool {
+	return i.function.IsActive()
+}
+
+// SetPayableChecker will set the payable checker on the wrapped function, if it accepts one
+func (i *interceptedFunction) SetPayableChecker(payableHandler vmcommon.PayableChecker) error {
+	acceptPayableChecker, ok := i.function.(vmcommon.AcceptPayableChecker)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	return acceptPayableChecker.SetPayableChecker(payableHandler)
+}
+
+// EstimateGas returns the gas estimation of the wrapped function, if it supports one
+func (i *interceptedFunction) EstimateGas(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (uint64, error) {
+	gasEstimator, ok := i.function.(vmcommon.GasEstimator)
+	if !ok {
+		return 0, ErrGasEstimationNotSupported
+	}
+
+	return gasEstimator.EstimateGas(acntSnd, acntDst, vmInput)
+}
+
+// Unwrap returns the wrapped built-in function
+func (i *interceptedFunction) Unwrap() vmcommon.BuiltinFunction {
+	return i.function
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *interceptedFunction) IsInterfaceNil() bool {
+	return i == nil
+}
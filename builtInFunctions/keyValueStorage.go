@@ -64,26 +64,16 @@ func (k *saveKeyValueStorage) ProcessBuiltinFunction(
 	for i := 0; i < len(input.Arguments); i += 2 {
 		key := input.Arguments[i]
 		value := input.Arguments[i+1]
-		length := uint64(len(value) + len(key))
-		useGas += length * k.gasConfig.PersistPerByte
-
 		if !vmcommon.IsAllowedToSaveUnderKey(key) {
 			return nil, fmt.Errorf("%w it is not allowed to save under key %s", ErrOperationNotPermitted, key)
 		}
 
 		oldValue, _ := acntDest.AccountDataHandler().RetrieveValue(key)
+		useGas += k.computeGasForKeyValue(key, value, oldValue)
 		if bytes.Equal(oldValue, value) {
 			continue
 		}
 
-		lengthChange := uint64(0)
-		lengthOldValue := uint64(len(oldValue))
-		lengthNewValue := uint64(len(value))
-		if lengthOldValue < lengthNewValue {
-			lengthChange = lengthNewValue - lengthOldValue
-		}
-
-		useGas += k.gasConfig.StorePerByte * lengthChange
 		if input.GasProvided < useGas {
 			return nil, ErrNotEnoughGas
 		}
@@ -101,6 +91,50 @@ func (k *saveKeyValueStorage) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// EstimateGas returns the gas consumed by saving all the provided key-value pairs on the destination account
+func (k *saveKeyValueStorage) EstimateGas(_, acntDest vmcommon.UserAccountHandler, input *vmcommon.ContractCallInput) (uint64, error) {
+	k.mutExecution.RLock()
+	defer k.mutExecution.RUnlock()
+
+	err := checkArgumentsForSaveKeyValue(acntDest, input)
+	if err != nil {
+		return 0, err
+	}
+
+	savedValues := make(map[string][]byte)
+	useGas := k.funcGasCost
+	for i := 0; i < len(input.Arguments); i += 2 {
+		key := input.Arguments[i]
+		value := input.Arguments[i+1]
+
+		oldValue, ok := savedValues[string(key)]
+		if !ok {
+			oldValue, _ = acntDest.AccountDataHandler().RetrieveValue(key)
+		}
+		useGas += k.computeGasForKeyValue(key, value, oldValue)
+		savedValues[string(key)] = value
+	}
+
+	return useGas, nil
+}
+
+func (k *saveKeyValueStorage) computeGasForKeyValue(key []byte, value []byte, oldValue []byte) uint64 {
+	length := uint64(len(value) + len(key))
+	useGas := length * k.gasConfig.PersistPerByte
+	if bytes.Equal(oldValue, value) {
+		return useGas
+	}
+
+	lengthChange := uint64(0)
+	lengthOldValue := uint64(len(oldValue))
+	lengthNewValue := uint64(len(value))
+	if lengthOldValue < lengthNewValue {
+		lengthChange = lengthNewValue - lengthOldValue
+	}
+
+	return useGas + k.gasConfig.StorePerByte*lengthChange
+}
+
 func checkArgumentsForSaveKeyValue(acntDst vmcommon.UserAccountHandler, input *vmcommon.ContractCallInput) error {
 	if input == nil {
 		return ErrNilVmInput
@@ -188,3 +188,40 @@ func TestSaveKeyValue_ProcessBuiltinFunctionMultipleKeys(t *testing.T) {
 	_, err = skv.ProcessBuiltinFunction(acc, acc, vmInput)
 	require.Equal(t, err, ErrNotEnoughGas)
 }
+
+func TestSaveKeyValue_EstimateGasShouldMatchConsumedGas(t *testing.T) {
+	t.Parallel()
+
+	gasConfig := vmcommon.BaseOperationCost{
+		StorePerByte:   3,
+		PersistPerByte: 2,
+	}
+	skv, _ := NewSaveKeyValueStorageFunc(gasConfig, 5)
+
+	addr := []byte("addr")
+	acc := mock.NewUserAccount(addr)
+	_ = acc.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("v"))
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  addr,
+			GasProvided: 1000,
+			CallValue:   big.NewInt(0),
+			Arguments: [][]byte{
+				[]byte("key"), []byte("value"),
+				[]byte("key2"), []byte("value2"),
+				[]byte("key2"), []byte("value2"),
+			},
+		},
+		RecipientAddr: addr,
+	}
+
+	_, err := skv.EstimateGas(nil, nil, vmInput)
+	require.Equal(t, ErrNilSCDestAccount, err)
+
+	estimatedGas, err := skv.EstimateGas(nil, acc, vmInput)
+	require.Nil(t, err)
+
+	vmOutput, err := skv.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Nil(t, err)
+	require.Equal(t, vmInput.GasProvided-vmOutput.GasRemaining, estimatedGas)
+}
@@ -0,0 +1,119 @@
+package builtInFunctions
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const allowance = "allowance"
+
+// allowanceKeyPrefix is the owner account key prefix under which the allowance of each spender, token and nonce is saved
+var allowanceKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + allowance + core.MECTKeyIdentifier)
+
+// expiryEpochSize is the size of the expiry epoch written in front of the allowed value
+const expiryEpochSize = 4
+
+// ArgsNewMECTAllowanceFunc defines the arguments needed to create the MECTApprove and MECTTransferFrom built-in functions
+type ArgsNewMECTAllowanceFunc struct {
+	FuncGasCost           uint64
+	Marshaller            vmcommon.Marshalizer
+	GlobalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	RolesHandler          vmcommon.MECTRoleHandler
+	StorageHandler        vmcommon.MECTNFTStorageHandler
+	ShardCoordinator      vmcommon.Coordinator
+	EnableEpochsHandler   vmcommon.EnableEpochsHandler
+	ActiveHandler         func() bool
+}
+
+// mectAllowance is the value a spender can still transfer from the owner account. An expiry epoch of 0 means the
+// allowance never expires, otherwise it can be used up to and including the expiry epoch
+type mectAllowance struct {
+	value       *big.Int
+	expiryEpoch uint32
+}
+
+func checkBaseAllowanceArgs(args ArgsNewMECTAllowanceFunc) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.GlobalSettingsHandler) {
+		return ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(args.RolesHandler) {
+		return ErrNilRolesHandler
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return ErrNilEnableEpochsHandler
+	}
+	if args.ActiveHandler == nil {
+		return ErrNilActiveHandler
+	}
+
+	return nil
+}
+
+func computeAllowanceKey(spender []byte, tokenID []byte, nonce uint64) []byte {
+	allowanceKey := make([]byte, 0, len(allowanceKeyPrefix)+len(spender)+len(tokenID))
+	allowanceKey = append(allowanceKey, allowanceKeyPrefix...)
+	allowanceKey = append(allowanceKey, spender...)
+	allowanceKey = append(allowanceKey, tokenID...)
+
+	return computeMECTNFTTokenKey(allowanceKey, nonce)
+}
+
+func (a *mectAllowance) isExpired(currentEpoch uint32) bool {
+	return a.expiryEpoch != 0 && currentEpoch > a.expiryEpoch
+}
+
+func parseExpiryEpoch(arg []byte) (uint32, error) {
+	expiryEpoch := big.NewInt(0).SetBytes(arg)
+	if !expiryEpoch.IsUint64() || expiryEpoch.Uint64() > math.MaxUint32 {
+		return 0, ErrInvalidArguments
+	}
+
+	return uint32(expiryEpoch.Uint64()), nil
+}
+
+func serializeAllowance(mectAllowance *mectAllowance) []byte {
+	valueBytes := mectAllowance.value.Bytes()
+	data := make([]byte, expiryEpochSize, expiryEpochSize+len(valueBytes))
+	binary.BigEndian.PutUint32(data, mectAllowance.expiryEpoch)
+
+	return append(data, valueBytes...)
+}
+
+func deserializeAllowance(data []byte) (*mectAllowance, error) {
+	if len(data) < expiryEpochSize {
+		return nil, ErrInvalidAllowanceData
+	}
+
+	return &mectAllowance{
+		expiryEpoch: binary.BigEndian.Uint32(data[:expiryEpochSize]),
+		value:       big.NewInt(0).SetBytes(data[expiryEpochSize:]),
+	}, nil
+}
+
+func getAllowance(owner vmcommon.UserAccountHandler, allowanceKey []byte) (*mectAllowance, error) {
+	data, err := owner.AccountDataHandler().RetrieveValue(allowanceKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return &mectAllowance{value: big.NewInt(0)}, nil
+	}
+
+	return deserializeAllowance(data)
+}
+
+func saveAllowance(owner vmcommon.UserAccountHandler, allowanceKey []byte, mectAllowance *mectAllowance) error {
+	if mectAllowance.value.Sign() == 0 {
+		return owner.AccountDataHandler().SaveKeyValue(allowanceKey, nil)
+	}
+
+	return owner.AccountDataHandler().SaveKeyValue(allowanceKey, serializeAllowance(mectAllowance))
+}
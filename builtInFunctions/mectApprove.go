@@ -0,0 +1,179 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const minArgsMECTApprove = 4
+const maxArgsMECTApprove = 5
+
+type mectApprove struct {
+	*baseActiveHandler
+	funcGasCost           uint64
+	marshaller            vmcommon.Marshalizer
+	keyPrefix             []byte
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	rolesHandler          vmcommon.MECTRoleHandler
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
+	mutExecution          sync.RWMutex
+}
+
+// NewMECTApproveFunc returns the mect approve built-in function component
+func NewMECTApproveFunc(args ArgsNewMECTAllowanceFunc) (*mectApprove, error) {
+	err := checkBaseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &mectApprove{
+		funcGasCost:           args.FuncGasCost,
+		marshaller:            args.Marshaller,
+		keyPrefix:             []byte(baseMECTKeyPrefix),
+		globalSettingsHandler: args.GlobalSettingsHandler,
+		rolesHandler:          args.RolesHandler,
+		enableEpochsHandler:   args.EnableEpochsHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: args.ActiveHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectApprove) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.MECTApprove
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction sets or clears the allowance of a spender. The owner sends the call to its own address.
+// Requires 4 arguments and an optional fifth one:
+// arg0 - spender address
+// arg1 - token identifier
+// arg2 - nonce
+// arg3 - allowed value, 0 clears the allowance
+// arg4 - expiry epoch, the last epoch in which the allowance can be used
+func (e *mectApprove) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicMECTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < minArgsMECTApprove || len(vmInput.Arguments) > maxArgsMECTApprove {
+		return nil, ErrInvalidArguments
+	}
+	if check.IfNil(acntSnd) {
+		return nil, ErrNilUserAccount
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, ErrInvalidRcvAddr
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, ErrNotEnoughGas
+	}
+
+	spender := vmInput.Arguments[0]
+	if len(spender) != len(vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, not a valid spender address", ErrInvalidArguments)
+	}
+	if bytes.Equal(spender, vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, can not approve self", ErrInvalidArguments)
+	}
+
+	tokenID := vmInput.Arguments[1]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[2]).Uint64()
+	mectAllowance := &mectAllowance{
+		value: big.NewInt(0).SetBytes(vmInput.Arguments[3]),
+	}
+	if len(vmInput.Arguments) == maxArgsMECTApprove {
+		mectAllowance.expiryEpoch, err = parseExpiryEpoch(vmInput.Arguments[4])
+		if err != nil {
+			return nil, err
+		}
+		if mectAllowance.isExpired(e.enableEpochsHandler.GetCurrentEpoch()) {
+			return nil, ErrAllowanceExpired
+		}
+	}
+
+	if mectAllowance.value.Sign() > 0 {
+		err = e.checkOwnerCanTransfer(acntSnd, spender, tokenID, nonce, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = saveAllowance(acntSnd, computeAllowanceKey(spender, tokenID, nonce), mectAllowance)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	addMECTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionMECTApprove), tokenID, nonce, mectAllowance.value, vmInput.CallerAddr, spender)
+
+	return vmOutput, nil
+}
+
+func (e *mectApprove) checkOwnerCanTransfer(
+	owner vmcommon.UserAccountHandler,
+	spender []byte,
+	tokenID []byte,
+	nonce uint64,
+	isReturnWithError bool,
+) error {
+	mectTokenKey := append(e.keyPrefix, tokenID...)
+	mectData, err := getMECTDataFromKey(owner, computeMECTNFTTokenKey(mectTokenKey, nonce), e.marshaller)
+	if err != nil {
+		return err
+	}
+
+	err = checkFrozeAndPause(owner.AddressBytes(), mectTokenKey, mectData, e.globalSettingsHandler, isReturnWithError)
+	if err != nil {
+		return err
+	}
+
+	keyToCheck := mectTokenKey
+	if e.enableEpochsHandler.IsCheckCorrectTokenIDEnabled() {
+		keyToCheck = tokenID
+	}
+
+	err = checkIfTransferCanHappenWithLimitedTransfer(keyToCheck, mectTokenKey, owner.AddressBytes(), spender, e.globalSettingsHandler, e.rolesHandler, owner, nil, isReturnWithError)
+	if err != nil {
+		return err
+	}
+
+	return checkIfTransferCanHappenWithSoulbound(mectTokenKey, e.globalSettingsHandler, owner, isReturnWithError)
+}
+
+// EstimateGas returns the gas consumed by the MECT approve function
+func (e *mectApprove) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return computeGasToConsume(acntSnd, e.funcGasCost), nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectApprove) IsInterfaceNil() bool {
+	return e == nil
+}
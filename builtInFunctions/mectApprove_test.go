@@ -0,0 +1,224 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	allowanceOwner   = bytes.Repeat([]byte{1}, 32)
+	allowanceSpender = bytes.Repeat([]byte{2}, 32)
+)
+
+func createMockArgsForAllowance() ArgsNewMECTAllowanceFunc {
+	return ArgsNewMECTAllowanceFunc{
+		FuncGasCost:           10,
+		Marshaller:            &mock.MarshalizerMock{},
+		GlobalSettingsHandler: &mock.GlobalSettingsHandlerStub{},
+		RolesHandler:          &mock.MECTRoleHandlerStub{},
+		StorageHandler:        createNewMECTDataStorageHandler(),
+		ShardCoordinator:      &mock.ShardCoordinatorStub{},
+		EnableEpochsHandler:   &mock.EnableEpochsHandlerStub{CurrentEpochField: 5},
+		ActiveHandler:         trueHandler,
+	}
+}
+
+func createApproveInput(args ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  allowanceOwner,
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+			Arguments:   args,
+		},
+		RecipientAddr: allowanceOwner,
+	}
+}
+
+func TestNewMECTApproveFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.Marshaller = nil
+		e, err := NewMECTApproveFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil global settings handler should error", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.GlobalSettingsHandler = nil
+		e, err := NewMECTApproveFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+	})
+	t.Run("nil roles handler should error", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.RolesHandler = nil
+		e, err := NewMECTApproveFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilRolesHandler, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.EnableEpochsHandler = nil
+		e, err := NewMECTApproveFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("nil active handler should error", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.ActiveHandler = nil
+		e, err := NewMECTApproveFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilActiveHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.StorageHandler = nil
+		e, err := NewMECTApproveFunc(args)
+		assert.Nil(t, err)
+		assert.False(t, e.IsInterfaceNil())
+		assert.True(t, e.IsActive())
+	})
+}
+
+func TestMectApprove_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTApproveFunc(createMockArgsForAllowance())
+	e.SetNewGasConfig(nil)
+	assert.Equal(t, uint64(10), e.funcGasCost)
+
+	gasCost := createMockGasCost()
+	e.SetNewGasConfig(&gasCost)
+	assert.Equal(t, gasCost.BuiltInCost.MECTApprove, e.funcGasCost)
+}
+
+func TestMectApprove_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTApproveFunc(createMockArgsForAllowance())
+	owner := mock.NewUserAccount(allowanceOwner)
+	tokenID := []byte("TKN-abcdef")
+
+	_, err := e.ProcessBuiltinFunction(owner, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createApproveInput(allowanceSpender, tokenID, []byte{})
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createApproveInput(allowanceSpender, tokenID, []byte{}, big.NewInt(10).Bytes())
+	_, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrNilUserAccount, err)
+
+	input.RecipientAddr = allowanceSpender
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+
+	input = createApproveInput(allowanceSpender, tokenID, []byte{}, big.NewInt(10).Bytes())
+	input.GasProvided = 1
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Equal(t, ErrNotEnoughGas, err)
+
+	input = createApproveInput([]byte("short"), tokenID, []byte{}, big.NewInt(10).Bytes())
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	input = createApproveInput(allowanceOwner, tokenID, []byte{}, big.NewInt(10).Bytes())
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	input = createApproveInput(allowanceSpender, tokenID, []byte{}, big.NewInt(10).Bytes(), big.NewInt(4).Bytes())
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Equal(t, ErrAllowanceExpired, err)
+
+	input = createApproveInput(allowanceSpender, tokenID, []byte{}, big.NewInt(10).Bytes(), big.NewInt(1<<33).Bytes())
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+}
+
+func TestMectApprove_ProcessBuiltinFunctionFrozenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	e, _ := NewMECTApproveFunc(createMockArgsForAllowance())
+	owner := mock.NewUserAccount(allowanceOwner)
+	tokenID := []byte("TKN-abcdef")
+
+	frozenMetadata := MECTUserMetadata{Frozen: true}
+	marshaledData, _ := marshaller.Marshal(&mect.MECToken{Value: big.NewInt(100), Properties: frozenMetadata.ToBytes()})
+	_ = owner.AccountDataHandler().SaveKeyValue(append([]byte(baseMECTKeyPrefix), tokenID...), marshaledData)
+
+	input := createApproveInput(allowanceSpender, tokenID, []byte{}, big.NewInt(10).Bytes())
+	_, err := e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Equal(t, ErrMECTIsFrozenForAccount, err)
+
+	// clearing the allowance is always possible
+	input = createApproveInput(allowanceSpender, tokenID, []byte{}, []byte{})
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	assert.Nil(t, err)
+}
+
+func TestMectApprove_ProcessBuiltinFunctionShouldSetAndClear(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTApproveFunc(createMockArgsForAllowance())
+	owner := mock.NewUserAccount(allowanceOwner)
+	tokenID := []byte("TKN-abcdef")
+	allowanceKey := computeAllowanceKey(allowanceSpender, tokenID, 3)
+
+	input := createApproveInput(allowanceSpender, tokenID, big.NewInt(3).Bytes(), big.NewInt(10).Bytes(), big.NewInt(5).Bytes())
+	vmOutput, err := e.ProcessBuiltinFunction(owner, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionMECTApprove), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, allowanceOwner, vmOutput.Logs[0].Address)
+	assert.Equal(t, [][]byte{tokenID, big.NewInt(3).Bytes(), big.NewInt(10).Bytes(), allowanceSpender}, vmOutput.Logs[0].Topics)
+
+	savedAllowance, err := getAllowance(owner, allowanceKey)
+	require.Nil(t, err)
+	assert.Equal(t, &mectAllowance{value: big.NewInt(10), expiryEpoch: 5}, savedAllowance)
+
+	input = createApproveInput(allowanceSpender, tokenID, big.NewInt(3).Bytes(), []byte{})
+	_, err = e.ProcessBuiltinFunction(owner, nil, input)
+	require.Nil(t, err)
+	assert.Empty(t, owner.Storage[string(allowanceKey)])
+}
+
+func TestMectApprove_EstimateGas(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTApproveFunc(createMockArgsForAllowance())
+
+	_, err := e.EstimateGas(nil, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	gas, err := e.EstimateGas(mock.NewUserAccount(allowanceOwner), nil, createApproveInput())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), gas)
+}
+
+func TestGetAllowance_ReadErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	allowanceKey := computeAllowanceKey([]byte("spender"), []byte("TKN-abcdef"), 0)
+	allowance, err := getAllowance(mock.NewUserAccount([]byte("owner")), allowanceKey)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), allowance.value)
+
+	retrieveErr := errors.New("retrieve error")
+	allowance, err = getAllowance(createAccountWithRetrieveValueError(retrieveErr), allowanceKey)
+	assert.Nil(t, allowance)
+	assert.Equal(t, retrieveErr, err)
+}
@@ -6,13 +6,13 @@ import (
 	"sync"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
 
 type mectBurn struct {
-	*baseDisabled
+	*baseActiveHandler
+	baseSupplyLedgerHolder
 	funcGasCost           uint64
 	marshaller            vmcommon.Marshalizer
 	keyPrefix             []byte
@@ -25,8 +25,7 @@ func NewMECTBurnFunc(
 	funcGasCost uint64,
 	marshaller vmcommon.Marshalizer,
 	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler,
-	disableEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	activeHandler func() bool,
 ) (*mectBurn, error) {
 	if check.IfNil(marshaller) {
 		return nil, ErrNilMarshalizer
@@ -34,22 +33,22 @@ func NewMECTBurnFunc(
 	if check.IfNil(globalSettingsHandler) {
 		return nil, ErrNilGlobalSettingsHandler
 	}
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
 
 	e := &mectBurn{
-		funcGasCost:           funcGasCost,
-		marshaller:            marshaller,
-		keyPrefix:             []byte(baseMECTKeyPrefix),
-		globalSettingsHandler: globalSettingsHandler,
+		baseSupplyLedgerHolder: newBaseSupplyLedgerHolder(),
+		funcGasCost:            funcGasCost,
+		marshaller:             marshaller,
+		keyPrefix:              []byte(baseMECTKeyPrefix),
+		globalSettingsHandler:  globalSettingsHandler,
 	}
 
-	e.baseDisabled = &baseDisabled{
-		function:          core.BuiltInFunctionMECTBurn,
-		deActivationEpoch: disableEpoch,
-		flagActivated:     atomic.Flag{},
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
@@ -100,6 +99,10 @@ func (e *mectBurn) ProcessBuiltinFunction(
 	if err != nil {
 		return nil, err
 	}
+	err = e.supplyLedger.AddBurned(vmInput.Arguments[0], 0, value)
+	if err != nil {
+		return nil, err
+	}
 
 	gasRemaining := computeGasRemaining(acntSnd, vmInput.GasProvided, e.funcGasCost)
 	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}
@@ -119,6 +122,18 @@ func (e *mectBurn) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// EstimateGas returns the gas consumed by the MECT burn function, which is paid only on the sender shard
+func (e *mectBurn) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return computeGasToConsume(acntSnd, e.funcGasCost), nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectBurn) IsInterfaceNil() bool {
 	return e == nil
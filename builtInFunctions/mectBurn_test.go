@@ -15,7 +15,7 @@ func TestMECTBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
 	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{}
-	burnFunc, _ := NewMECTBurnFunc(10, &mock.MarshalizerMock{}, globalSettingsHandler, 1000, &mock.EpochNotifierStub{})
+	burnFunc, _ := NewMECTBurnFunc(10, &mock.MarshalizerMock{}, globalSettingsHandler, trueHandler)
 	_, err := burnFunc.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -61,7 +61,7 @@ func TestMECTBurn_ProcessBuiltInFunctionSenderBurns(t *testing.T) {
 
 	marshaller := &mock.MarshalizerMock{}
 	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{}
-	burnFunc, _ := NewMECTBurnFunc(10, marshaller, globalSettingsHandler, 1000, &mock.EpochNotifierStub{})
+	burnFunc, _ := NewMECTBurnFunc(10, marshaller, globalSettingsHandler, trueHandler)
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -0,0 +1,179 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const numArgsMECTClaimVested = 2
+
+type mectClaimVested struct {
+	*baseActiveHandler
+	funcGasCost           uint64
+	marshaller            vmcommon.Marshalizer
+	keyPrefix             []byte
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	mectStorageHandler    vmcommon.MECTNFTStorageHandler
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
+	mutExecution          sync.RWMutex
+}
+
+// NewMECTClaimVestedFunc returns the mect claim vested built-in function component
+func NewMECTClaimVestedFunc(args ArgsNewMECTVestingFunc) (*mectClaimVested, error) {
+	err := checkVestingArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &mectClaimVested{
+		funcGasCost:           args.FuncGasCost,
+		marshaller:            args.Marshaller,
+		keyPrefix:             []byte(baseMECTKeyPrefix),
+		globalSettingsHandler: args.GlobalSettingsHandler,
+		mectStorageHandler:    args.StorageHandler,
+		enableEpochsHandler:   args.EnableEpochsHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: args.ActiveHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectClaimVested) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.MECTClaimVested
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction moves the released part of the locked balance into the normal balance. The holder sends the
+// call to its own address. Requires 2 arguments:
+// arg0 - token identifier
+// arg1 - nonce, 0 for fungible tokens
+func (e *mectClaimVested) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicMECTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != numArgsMECTClaimVested {
+		return nil, ErrInvalidArguments
+	}
+	if check.IfNil(acntSnd) {
+		return nil, ErrNilUserAccount
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, ErrInvalidRcvAddr
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	schedules, err := getVestingSchedules(acntSnd, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch := e.enableEpochsHandler.GetCurrentEpoch()
+	claimed := big.NewInt(0)
+	remainingSchedules := make([]*vmcommon.MECTVestingSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		claimed.Add(claimed, schedule.ClaimableAt(currentEpoch))
+		schedule.Claimed = schedule.UnlockedAt(currentEpoch)
+		if schedule.Claimed.Cmp(schedule.Amount) < 0 {
+			remainingSchedules = append(remainingSchedules, schedule)
+		}
+	}
+	if claimed.Sign() == 0 {
+		return nil, ErrNothingToClaim
+	}
+
+	err = saveVestingSchedules(acntSnd, tokenID, nonce, remainingSchedules)
+	if err != nil {
+		return nil, err
+	}
+
+	mectTokenKey := append(e.keyPrefix, tokenID...)
+	if nonce == 0 {
+		err = addToMECTBalance(acntSnd, mectTokenKey, claimed, e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+	} else {
+		err = e.addClaimedNFT(acntSnd, tokenID, mectTokenKey, nonce, claimed, len(remainingSchedules) == 0, vmInput.ReturnCallAfterError)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	addMECTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionMECTClaimVested), tokenID, nonce, claimed, vmInput.CallerAddr)
+
+	return vmOutput, nil
+}
+
+func (e *mectClaimVested) addClaimedNFT(
+	acnt vmcommon.UserAccountHandler,
+	tokenID []byte,
+	mectTokenKey []byte,
+	nonce uint64,
+	claimed *big.Int,
+	isFullyClaimed bool,
+	isReturnWithError bool,
+) error {
+	mectData, _, err := e.mectStorageHandler.GetMECTNFTTokenOnDestination(acnt, mectTokenKey, nonce)
+	if err != nil {
+		return err
+	}
+
+	tokenData, err := getVestingTokenData(acnt, tokenID, nonce, e.marshaller)
+	if err != nil {
+		return err
+	}
+	if mectData.TokenMetaData == nil && tokenData != nil {
+		mectData.Type = tokenData.Type
+		mectData.TokenMetaData = tokenData.TokenMetaData
+	}
+
+	mectData.Value.Add(mectData.Value, claimed)
+	_, err = e.mectStorageHandler.SaveMECTNFTToken(acnt.AddressBytes(), acnt, mectTokenKey, nonce, mectData, false, isReturnWithError)
+	if err != nil {
+		return err
+	}
+	if !isFullyClaimed {
+		return nil
+	}
+
+	return acnt.AccountDataHandler().SaveKeyValue(computeVestingKey(vestingMetaDataKeyPrefix, tokenID, nonce), nil)
+}
+
+// EstimateGas returns the gas consumed by the MECT claim vested function
+func (e *mectClaimVested) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return computeGasToConsume(acntSnd, e.funcGasCost), nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectClaimVested) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,163 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createClaimVestedInput(tokenID []byte, nonce uint64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  vestingBeneficiary,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(0).SetUint64(nonce).Bytes()},
+		},
+		RecipientAddr: vestingBeneficiary,
+	}
+}
+
+func TestNewMECTClaimVestedFunc(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(0)
+	args.Marshaller = nil
+	e, err := NewMECTClaimVestedFunc(args)
+	assert.Nil(t, e)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args, _ = createMockArgsForVesting(0)
+	e, err = NewMECTClaimVestedFunc(args)
+	assert.Nil(t, err)
+	assert.False(t, e.IsInterfaceNil())
+
+	gasCost := createMockGasCost()
+	e.SetNewGasConfig(&gasCost)
+	assert.Equal(t, gasCost.BuiltInCost.MECTClaimVested, e.funcGasCost)
+}
+
+func TestMectClaimVested_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(5)
+	e, _ := NewMECTClaimVestedFunc(args)
+	beneficiary := mock.NewUserAccount(vestingBeneficiary)
+	tokenID := []byte("TKN-abcdef")
+
+	_, err := e.ProcessBuiltinFunction(beneficiary, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createClaimVestedInput(tokenID, 0)
+	input.Arguments = append(input.Arguments, []byte("extra"))
+	_, err = e.ProcessBuiltinFunction(beneficiary, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createClaimVestedInput(tokenID, 0)
+	_, err = e.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrNilUserAccount, err)
+
+	input.RecipientAddr = vestingSender
+	_, err = e.ProcessBuiltinFunction(beneficiary, nil, input)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+
+	input = createClaimVestedInput(tokenID, 0)
+	input.GasProvided = 1
+	_, err = e.ProcessBuiltinFunction(beneficiary, nil, input)
+	assert.Equal(t, ErrNotEnoughGas, err)
+
+	input = createClaimVestedInput(tokenID, 0)
+	_, err = e.ProcessBuiltinFunction(beneficiary, nil, input)
+	assert.Equal(t, ErrNothingToClaim, err)
+
+	_ = saveVestingSchedules(beneficiary, tokenID, 0, []*vmcommon.MECTVestingSchedule{
+		{Amount: big.NewInt(10), Claimed: big.NewInt(0), CliffEpoch: 6, EndEpoch: 10},
+	})
+	_, err = e.ProcessBuiltinFunction(beneficiary, nil, input)
+	assert.Equal(t, ErrNothingToClaim, err)
+}
+
+func TestMectClaimVested_ProcessBuiltinFunctionFungible(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(15)
+	enableEpochsHandler := args.EnableEpochsHandler.(*mock.EnableEpochsHandlerStub)
+	e, _ := NewMECTClaimVestedFunc(args)
+	beneficiary := mock.NewUserAccount(vestingBeneficiary)
+	tokenID := []byte("TKN-abcdef")
+	saveFungibleBalance(t, beneficiary, tokenID, 5)
+	_ = saveVestingSchedules(beneficiary, tokenID, 0, []*vmcommon.MECTVestingSchedule{
+		{Amount: big.NewInt(100), Claimed: big.NewInt(0), CliffEpoch: 10, EndEpoch: 20},
+		{Amount: big.NewInt(8), Claimed: big.NewInt(0), CliffEpoch: 12, EndEpoch: 12},
+	})
+
+	vmOutput, err := e.ProcessBuiltinFunction(beneficiary, nil, createClaimVestedInput(tokenID, 0))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(63), getFungibleBalance(beneficiary, tokenID))
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionMECTClaimVested), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, big.NewInt(58).Bytes(), vmOutput.Logs[0].Topics[2])
+
+	schedules, _ := getVestingSchedules(beneficiary, tokenID, 0)
+	assert.Equal(t, []*vmcommon.MECTVestingSchedule{
+		{Amount: big.NewInt(100), Claimed: big.NewInt(50), CliffEpoch: 10, EndEpoch: 20},
+	}, schedules)
+
+	_, err = e.ProcessBuiltinFunction(beneficiary, nil, createClaimVestedInput(tokenID, 0))
+	assert.Equal(t, ErrNothingToClaim, err)
+
+	enableEpochsHandler.CurrentEpochField = 25
+	_, err = e.ProcessBuiltinFunction(beneficiary, nil, createClaimVestedInput(tokenID, 0))
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(113), getFungibleBalance(beneficiary, tokenID))
+	assert.Empty(t, beneficiary.Storage[string(computeVestingKey(vestingKeyPrefix, tokenID, 0))])
+}
+
+func TestMectClaimVested_ProcessBuiltinFunctionFrozenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(15)
+	e, _ := NewMECTClaimVestedFunc(args)
+	beneficiary := mock.NewUserAccount(vestingBeneficiary)
+	tokenID := []byte("TKN-abcdef")
+	frozenMetadata := MECTUserMetadata{Frozen: true}
+	marshaledData, _ := args.Marshaller.Marshal(&mect.MECToken{Value: big.NewInt(0), Properties: frozenMetadata.ToBytes()})
+	_ = beneficiary.AccountDataHandler().SaveKeyValue(append([]byte(baseMECTKeyPrefix), tokenID...), marshaledData)
+	_ = saveVestingSchedules(beneficiary, tokenID, 0, []*vmcommon.MECTVestingSchedule{
+		{Amount: big.NewInt(100), Claimed: big.NewInt(0), CliffEpoch: 10, EndEpoch: 10},
+	})
+
+	_, err := e.ProcessBuiltinFunction(beneficiary, nil, createClaimVestedInput(tokenID, 0))
+	assert.Equal(t, ErrMECTIsFrozenForAccount, err)
+}
+
+func TestMectClaimVested_ProcessBuiltinFunctionSFTShouldUseSavedTokenData(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(15)
+	e, _ := NewMECTClaimVestedFunc(args)
+	vestedTransfer, _ := NewMECTVestedTransferFunc(args)
+	beneficiary := mock.NewUserAccount(vestingBeneficiary)
+	tokenID := []byte("SFT-abcdef")
+	nonce := uint64(4)
+	tokenData := &mect.MECToken{Type: uint32(core.NonFungible), Value: big.NewInt(6), TokenMetaData: &mect.MetaData{Nonce: nonce, Name: []byte("sft")}}
+	schedule := &vmcommon.MECTVestingSchedule{Amount: big.NewInt(6), Claimed: big.NewInt(0), CliffEpoch: 10, EndEpoch: 10}
+	require.Nil(t, vestedTransfer.addLockedToDestination(vestingSender, beneficiary, tokenID, nonce, schedule, tokenData))
+
+	_, err := e.ProcessBuiltinFunction(beneficiary, nil, createClaimVestedInput(tokenID, nonce))
+	require.Nil(t, err)
+
+	mectTokenKey := append([]byte(baseMECTKeyPrefix), tokenID...)
+	claimedData, _, err := args.StorageHandler.GetMECTNFTTokenOnDestination(beneficiary, mectTokenKey, nonce)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(6), claimedData.Value)
+	assert.Equal(t, []byte("sft"), claimedData.TokenMetaData.Name)
+	assert.Empty(t, beneficiary.Storage[string(computeVestingKey(vestingMetaDataKeyPrefix, tokenID, nonce))])
+}
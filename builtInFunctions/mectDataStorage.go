@@ -6,7 +6,6 @@ import (
 	"math/big"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/data"
 	"github.com/ME-MotherEarth/me-core/data/mect"
@@ -23,27 +22,16 @@ type mectDataStorage struct {
 	keyPrefix             []byte
 	shardCoordinator      vmcommon.Coordinator
 	txDataParser          vmcommon.CallArgsParser
-
-	flagSaveToSystemAccount          atomic.Flag
-	saveToSystemEnableEpoch          uint32
-	flagCheckFrozenCollection        atomic.Flag
-	checkFrozenCollectionEnableEpoch uint32
-	flagSendAlwaysEnableEpoch        atomic.Flag
-	sendAlwaysEnableEpoch            uint32
-	flagFixOldTokenLiquidity         atomic.Flag
-	fixOldTokenLiquidityEnableEpoch  uint32
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
 }
 
 // ArgsNewMECTDataStorage defines the argument list for new mect data storage handler
 type ArgsNewMECTDataStorage struct {
-	Accounts                        vmcommon.AccountsAdapter
-	GlobalSettingsHandler           vmcommon.MECTGlobalSettingsHandler
-	Marshalizer                     vmcommon.Marshalizer
-	SaveToSystemEnableEpoch         uint32
-	SendAlwaysEnableEpoch           uint32
-	FixOldTokenLiquidityEnableEpoch uint32
-	EpochNotifier                   vmcommon.EpochNotifier
-	ShardCoordinator                vmcommon.Coordinator
+	Accounts              vmcommon.AccountsAdapter
+	GlobalSettingsHandler vmcommon.MECTGlobalSettingsHandler
+	Marshalizer           vmcommon.Marshalizer
+	EnableEpochsHandler   vmcommon.EnableEpochsHandler
+	ShardCoordinator      vmcommon.Coordinator
 }
 
 // NewMECTDataStorage creates a new mect data storage handler
@@ -57,8 +45,8 @@ func NewMECTDataStorage(args ArgsNewMECTDataStorage) (*mectDataStorage, error) {
 	if check.IfNil(args.Marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(args.EpochNotifier) {
-		return nil, ErrNilEpochHandler
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
 	}
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, ErrNilShardCoordinator
@@ -71,19 +59,9 @@ func NewMECTDataStorage(args ArgsNewMECTDataStorage) (*mectDataStorage, error) {
 		keyPrefix:             []byte(baseMECTKeyPrefix),
 		shardCoordinator:      args.ShardCoordinator,
 		txDataParser:          parsers.NewCallArgsParser(),
-
-		flagSaveToSystemAccount:          atomic.Flag{},
-		saveToSystemEnableEpoch:          args.SaveToSystemEnableEpoch,
-		flagCheckFrozenCollection:        atomic.Flag{},
-		checkFrozenCollectionEnableEpoch: args.SaveToSystemEnableEpoch,
-		flagSendAlwaysEnableEpoch:        atomic.Flag{},
-		sendAlwaysEnableEpoch:            args.SendAlwaysEnableEpoch,
-		flagFixOldTokenLiquidity:         atomic.Flag{},
-		fixOldTokenLiquidityEnableEpoch:  args.FixOldTokenLiquidityEnableEpoch,
+		enableEpochsHandler:   args.EnableEpochsHandler,
 	}
 
-	args.EpochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
@@ -125,7 +103,7 @@ func (e *mectDataStorage) GetMECTNFTTokenOnDestination(
 		return nil, false, err
 	}
 
-	if !e.flagSaveToSystemAccount.IsSet() || nonce == 0 {
+	if !e.enableEpochsHandler.IsSaveToSystemAccountFlagEnabled() || nonce == 0 {
 		return mectData, false, nil
 	}
 
@@ -183,7 +161,7 @@ func (e *mectDataStorage) checkCollectionIsFrozenForAccount(
 	nonce uint64,
 	isReturnWithError bool,
 ) error {
-	if !e.flagCheckFrozenCollection.IsSet() {
+	if !e.enableEpochsHandler.IsCheckFrozenCollectionFlagEnabled() {
 		return nil
 	}
 	if nonce == 0 || isReturnWithError {
@@ -244,7 +222,7 @@ func (e *mectDataStorage) AddToLiquiditySystemAcc(
 	nonce uint64,
 	transferValue *big.Int,
 ) error {
-	if !e.flagSaveToSystemAccount.IsSet() || !e.flagSendAlwaysEnableEpoch.IsSet() || nonce == 0 {
+	if !e.enableEpochsHandler.IsSaveToSystemAccountFlagEnabled() || !e.enableEpochsHandler.IsSendAlwaysFlagEnabled() || nonce == 0 {
 		return nil
 	}
 
@@ -263,7 +241,7 @@ func (e *mectDataStorage) AddToLiquiditySystemAcc(
 		return nil
 	}
 
-	if e.flagFixOldTokenLiquidity.IsSet() {
+	if e.enableEpochsHandler.IsFixOldTokenLiquidityEnabled() {
 		// old tokens which were transferred intra shard before the activation of this flag
 		if mectData.Value.Cmp(zero) == 0 && transferValue.Cmp(zero) < 0 {
 			mectData.Reserved = nil
@@ -310,7 +288,7 @@ func (e *mectDataStorage) SaveMECTNFTToken(
 
 	mectNFTTokenKey := computeMECTNFTTokenKey(mectTokenKey, nonce)
 	senderShardID := e.shardCoordinator.ComputeId(senderAddress)
-	if e.flagSaveToSystemAccount.IsSet() {
+	if e.enableEpochsHandler.IsSaveToSystemAccountFlagEnabled() {
 		err = e.saveMECTMetaDataToSystemAccount(acnt, senderShardID, mectNFTTokenKey, nonce, mectData, mustUpdate)
 		if err != nil {
 			return nil, err
@@ -321,7 +299,7 @@ func (e *mectDataStorage) SaveMECTNFTToken(
 		return nil, acnt.AccountDataHandler().SaveKeyValue(mectNFTTokenKey, nil)
 	}
 
-	if !e.flagSaveToSystemAccount.IsSet() {
+	if !e.enableEpochsHandler.IsSaveToSystemAccountFlagEnabled() {
 		marshaledData, err := e.marshaller.Marshal(mectData)
 		if err != nil {
 			return nil, err
@@ -374,7 +352,7 @@ func (e *mectDataStorage) saveMECTMetaDataToSystemAccount(
 		TokenMetaData: mectData.TokenMetaData,
 		Properties:    make([]byte, e.shardCoordinator.NumberOfShards()),
 	}
-	if len(currentSaveData) == 0 && e.flagSendAlwaysEnableEpoch.IsSet() {
+	if len(currentSaveData) == 0 && e.enableEpochsHandler.IsSendAlwaysFlagEnabled() {
 		mectDataOnSystemAcc.Properties = nil
 		mectDataOnSystemAcc.Reserved = []byte{1}
 
@@ -384,7 +362,7 @@ func (e *mectDataStorage) saveMECTMetaDataToSystemAccount(
 		}
 	}
 
-	if !e.flagSendAlwaysEnableEpoch.IsSet() {
+	if !e.enableEpochsHandler.IsSendAlwaysFlagEnabled() {
 		selfID := e.shardCoordinator.SelfId()
 		if selfID != core.MetachainShardId {
 			mectDataOnSystemAcc.Properties[selfID] = existsOnShard
@@ -402,7 +380,7 @@ func (e *mectDataStorage) setReservedToNilForOldToken(
 	userAcc vmcommon.UserAccountHandler,
 	mectNFTTokenKey []byte,
 ) error {
-	if !e.flagFixOldTokenLiquidity.IsSet() {
+	if !e.enableEpochsHandler.IsFixOldTokenLiquidityEnabled() {
 		return nil
 	}
 
@@ -471,34 +449,63 @@ func (e *mectDataStorage) WasAlreadySentToDestinationShardAndUpdateState(
 	nonce uint64,
 	dstAddress []byte,
 ) (bool, error) {
-	if !e.flagSaveToSystemAccount.IsSet() {
-		return false, nil
+	wasSent, mectData, systemAcc, err := e.checkSentToDestinationShard(tickerID, nonce, dstAddress)
+	if err != nil || wasSent || mectData == nil {
+		return wasSent, err
+	}
+
+	dstShardID := e.shardCoordinator.ComputeId(dstAddress)
+	mectData.Properties[dstShardID] = existsOnShard
+	mectNFTTokenKey := computeMECTNFTTokenKey(append(e.keyPrefix, tickerID...), nonce)
+	return false, e.marshalAndSaveData(systemAcc, mectData, mectNFTTokenKey)
+}
+
+// WasAlreadySentToDestinationShard checks whether NFT metadata was sent to destination shard or not, without
+// altering the state
+func (e *mectDataStorage) WasAlreadySentToDestinationShard(
+	tickerID []byte,
+	nonce uint64,
+	dstAddress []byte,
+) (bool, error) {
+	wasSent, _, _, err := e.checkSentToDestinationShard(tickerID, nonce, dstAddress)
+	return wasSent, err
+}
+
+// checkSentToDestinationShard returns the system account data when the metadata was not yet sent and the
+// destination shard must be marked as sent
+func (e *mectDataStorage) checkSentToDestinationShard(
+	tickerID []byte,
+	nonce uint64,
+	dstAddress []byte,
+) (bool, *mect.MECToken, vmcommon.UserAccountHandler, error) {
+	if !e.enableEpochsHandler.IsSaveToSystemAccountFlagEnabled() {
+		return false, nil, nil, nil
 	}
 
 	if nonce == 0 {
-		return true, nil
+		return true, nil, nil, nil
 	}
 	dstShardID := e.shardCoordinator.ComputeId(dstAddress)
 	if dstShardID == e.shardCoordinator.SelfId() {
-		return true, nil
+		return true, nil, nil, nil
 	}
 
-	if e.flagSendAlwaysEnableEpoch.IsSet() {
-		return false, nil
+	if e.enableEpochsHandler.IsSendAlwaysFlagEnabled() {
+		return false, nil, nil, nil
 	}
 
 	if dstShardID == core.MetachainShardId {
-		return true, nil
+		return true, nil, nil, nil
 	}
 	mectTokenKey := append(e.keyPrefix, tickerID...)
 	mectNFTTokenKey := computeMECTNFTTokenKey(mectTokenKey, nonce)
 
 	mectData, systemAcc, err := e.getMECTDigitalTokenDataFromSystemAccount(mectNFTTokenKey)
 	if err != nil {
-		return false, err
+		return false, nil, nil, err
 	}
 	if mectData == nil {
-		return false, nil
+		return false, nil, nil, nil
 	}
 
 	if uint32(len(mectData.Properties)) < e.shardCoordinator.NumberOfShards() {
@@ -510,21 +517,20 @@ func (e *mectDataStorage) WasAlreadySentToDestinationShardAndUpdateState(
 	}
 
 	if mectData.Properties[dstShardID] > 0 {
-		return true, nil
+		return true, nil, nil, nil
 	}
 
-	mectData.Properties[dstShardID] = existsOnShard
-	return false, e.marshalAndSaveData(systemAcc, mectData, mectNFTTokenKey)
+	return false, mectData, systemAcc, nil
 }
 
 // SaveNFTMetaDataToSystemAccount this saves the NFT metadata to the system account even if there was an error in processing
 func (e *mectDataStorage) SaveNFTMetaDataToSystemAccount(
 	tx data.TransactionHandler,
 ) error {
-	if !e.flagSaveToSystemAccount.IsSet() {
+	if !e.enableEpochsHandler.IsSaveToSystemAccountFlagEnabled() {
 		return nil
 	}
-	if e.flagSendAlwaysEnableEpoch.IsSet() {
+	if e.enableEpochsHandler.IsSendAlwaysFlagEnabled() {
 		return nil
 	}
 	if check.IfNil(tx) {
@@ -614,21 +620,6 @@ func (e *mectDataStorage) addMetaDataToSystemAccountFromMultiTransfer(
 	return nil
 }
 
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (e *mectDataStorage) EpochConfirmed(epoch uint32, _ uint64) {
-	e.flagSaveToSystemAccount.SetValue(epoch >= e.saveToSystemEnableEpoch)
-	log.Debug("MECT NFT save to system account", "enabled", e.flagSaveToSystemAccount.IsSet())
-
-	e.flagCheckFrozenCollection.SetValue(epoch >= e.checkFrozenCollectionEnableEpoch)
-	log.Debug("MECT NFT check frozen collection", "enabled", e.flagCheckFrozenCollection.IsSet())
-
-	e.flagSendAlwaysEnableEpoch.SetValue(epoch >= e.sendAlwaysEnableEpoch)
-	log.Debug("MECT send metadata always", "enabled", e.flagSendAlwaysEnableEpoch.IsSet())
-
-	e.flagFixOldTokenLiquidity.SetValue(epoch >= e.fixOldTokenLiquidityEnableEpoch)
-	log.Debug("MECT fix old token liquidity", "enabled", e.flagFixOldTokenLiquidity.IsSet())
-}
-
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectDataStorage) IsInterfaceNil() bool {
 	return e == nil
@@ -21,12 +21,16 @@ func createNewMECTDataStorageHandler() *mectDataStorage {
 		return acnt, nil
 	}}
 	args := ArgsNewMECTDataStorage{
-		Accounts:                accounts,
-		GlobalSettingsHandler:   &mock.GlobalSettingsHandlerStub{},
-		Marshalizer:             &mock.MarshalizerMock{},
-		SaveToSystemEnableEpoch: 0,
-		EpochNotifier:           &mock.EpochNotifierStub{},
-		ShardCoordinator:        &mock.ShardCoordinatorStub{},
+		Accounts:              accounts,
+		GlobalSettingsHandler: &mock.GlobalSettingsHandlerStub{},
+		Marshalizer:           &mock.MarshalizerMock{},
+		EnableEpochsHandler: &mock.EnableEpochsHandlerStub{
+			IsSaveToSystemAccountFlagEnabledField:   true,
+			IsCheckFrozenCollectionFlagEnabledField: true,
+			IsSendAlwaysFlagEnabledField:            true,
+			IsFixOldTokenLiquidityEnabledField:      true,
+		},
+		ShardCoordinator: &mock.ShardCoordinatorStub{},
 	}
 	dataStore, _ := NewMECTDataStorage(args)
 	return dataStore
@@ -38,12 +42,16 @@ func createMockArgsForNewMECTDataStorage() ArgsNewMECTDataStorage {
 		return acnt, nil
 	}}
 	args := ArgsNewMECTDataStorage{
-		Accounts:                accounts,
-		GlobalSettingsHandler:   &mock.GlobalSettingsHandlerStub{},
-		Marshalizer:             &mock.MarshalizerMock{},
-		SaveToSystemEnableEpoch: 0,
-		EpochNotifier:           &mock.EpochNotifierStub{},
-		ShardCoordinator:        &mock.ShardCoordinatorStub{},
+		Accounts:              accounts,
+		GlobalSettingsHandler: &mock.GlobalSettingsHandlerStub{},
+		Marshalizer:           &mock.MarshalizerMock{},
+		EnableEpochsHandler: &mock.EnableEpochsHandlerStub{
+			IsSaveToSystemAccountFlagEnabledField:   true,
+			IsCheckFrozenCollectionFlagEnabledField: true,
+			IsSendAlwaysFlagEnabledField:            true,
+			IsFixOldTokenLiquidityEnabledField:      true,
+		},
+		ShardCoordinator: &mock.ShardCoordinatorStub{},
 	}
 	return args
 }
@@ -53,12 +61,14 @@ func createNewMECTDataStorageHandlerWithArgs(
 	accounts vmcommon.AccountsAdapter,
 ) *mectDataStorage {
 	args := ArgsNewMECTDataStorage{
-		Accounts:                accounts,
-		GlobalSettingsHandler:   globalSettingsHandler,
-		Marshalizer:             &mock.MarshalizerMock{},
-		SaveToSystemEnableEpoch: 10,
-		EpochNotifier:           &mock.EpochNotifierStub{},
-		ShardCoordinator:        &mock.ShardCoordinatorStub{},
+		Accounts:              accounts,
+		GlobalSettingsHandler: globalSettingsHandler,
+		Marshalizer:           &mock.MarshalizerMock{},
+		EnableEpochsHandler: &mock.EnableEpochsHandlerStub{
+			IsSendAlwaysFlagEnabledField:       true,
+			IsFixOldTokenLiquidityEnabledField: true,
+		},
+		ShardCoordinator: &mock.ShardCoordinatorStub{},
 	}
 	dataStore, _ := NewMECTDataStorage(args)
 	return dataStore
@@ -92,10 +102,10 @@ func TestNewMECTDataStorage(t *testing.T) {
 	assert.Equal(t, err, ErrNilGlobalSettingsHandler)
 
 	args = createMockArgsForNewMECTDataStorage()
-	args.EpochNotifier = nil
+	args.EnableEpochsHandler = nil
 	e, err = NewMECTDataStorage(args)
 	assert.Nil(t, e)
-	assert.Equal(t, err, ErrNilEpochHandler)
+	assert.Equal(t, err, ErrNilEnableEpochsHandler)
 
 	args = createMockArgsForNewMECTDataStorage()
 	e, err = NewMECTDataStorage(args)
@@ -325,7 +335,7 @@ func TestMectDataStorage_WasAlreadySentToDestinationShard(t *testing.T) {
 	assert.True(t, val)
 	assert.Nil(t, err)
 
-	e.flagSendAlwaysEnableEpoch.Reset()
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSendAlwaysFlagEnabledField = false
 	shardCoordinator.ComputeIdCalled = func(_ []byte) uint32 {
 		return core.MetachainShardId
 	}
@@ -333,7 +343,7 @@ func TestMectDataStorage_WasAlreadySentToDestinationShard(t *testing.T) {
 	assert.True(t, val)
 	assert.Nil(t, err)
 
-	e.flagSendAlwaysEnableEpoch.SetValue(true)
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSendAlwaysFlagEnabledField = true
 
 	shardCoordinator.ComputeIdCalled = func(_ []byte) uint32 {
 		return 1
@@ -363,7 +373,7 @@ func TestMectDataStorage_WasAlreadySentToDestinationShard(t *testing.T) {
 	assert.False(t, val)
 	assert.Nil(t, err)
 
-	e.flagSendAlwaysEnableEpoch.Reset()
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSendAlwaysFlagEnabledField = false
 	val, err = e.WasAlreadySentToDestinationShardAndUpdateState(tickerID, 1, dstAddress)
 	assert.False(t, val)
 	assert.Nil(t, err)
@@ -384,16 +394,16 @@ func TestMectDataStorage_SaveNFTMetaDataToSystemAccount(t *testing.T) {
 	args.ShardCoordinator = shardCoordinator
 	e, _ := NewMECTDataStorage(args)
 
-	e.flagSaveToSystemAccount.Reset()
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSaveToSystemAccountFlagEnabledField = false
 	err := e.SaveNFTMetaDataToSystemAccount(nil)
 	assert.Nil(t, err)
 
-	_ = e.flagSaveToSystemAccount.SetReturningPrevious()
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSaveToSystemAccountFlagEnabledField = true
 
 	err = e.SaveNFTMetaDataToSystemAccount(nil)
 	assert.Nil(t, err)
 
-	e.flagSendAlwaysEnableEpoch.Reset()
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSendAlwaysFlagEnabledField = false
 	err = e.SaveNFTMetaDataToSystemAccount(nil)
 	assert.Equal(t, err, ErrNilTransactionHandler)
 
@@ -466,7 +476,7 @@ func TestMectDataStorage_SaveNFTMetaDataToSystemAccountWithMultiTransfer(t *test
 	shardCoordinator := &mock.ShardCoordinatorStub{}
 	args.ShardCoordinator = shardCoordinator
 	e, _ := NewMECTDataStorage(args)
-	e.flagSendAlwaysEnableEpoch.Reset()
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSendAlwaysFlagEnabledField = false
 
 	scr := &smartContractResult.SmartContractResult{
 		SndAddr: []byte("address1"),
@@ -530,7 +540,7 @@ func TestMectDataStorage_checkCollectionFrozen(t *testing.T) {
 	args.ShardCoordinator = shardCoordinator
 	e, _ := NewMECTDataStorage(args)
 
-	e.flagCheckFrozenCollection.SetValue(false)
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsCheckFrozenCollectionFlagEnabledField = false
 
 	acnt, _ := e.accounts.LoadAccount([]byte("address1"))
 	userAcc := acnt.(vmcommon.UserAccountHandler)
@@ -540,7 +550,7 @@ func TestMectDataStorage_checkCollectionFrozen(t *testing.T) {
 	err := e.checkCollectionIsFrozenForAccount(userAcc, mectTokenKey, 1, false)
 	assert.Nil(t, err)
 
-	e.flagCheckFrozenCollection.SetValue(true)
+	e.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsCheckFrozenCollectionFlagEnabledField = true
 	err = e.checkCollectionIsFrozenForAccount(userAcc, mectTokenKey, 0, false)
 	assert.Nil(t, err)
 
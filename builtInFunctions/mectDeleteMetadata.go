@@ -5,7 +5,6 @@ import (
 	"math/big"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/data/mect"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
@@ -14,7 +13,7 @@ import (
 const numArgsPerAdd = 3
 
 type mectDeleteMetaData struct {
-	*baseEnabled
+	*baseActiveHandler
 	allowedAddress []byte
 	delete         bool
 	accounts       vmcommon.AccountsAdapter
@@ -25,13 +24,12 @@ type mectDeleteMetaData struct {
 
 // ArgsNewMECTDeleteMetadata defines the argument list for new mect delete metadata built in function
 type ArgsNewMECTDeleteMetadata struct {
-	FuncGasCost     uint64
-	Marshalizer     vmcommon.Marshalizer
-	Accounts        vmcommon.AccountsAdapter
-	ActivationEpoch uint32
-	EpochNotifier   vmcommon.EpochNotifier
-	AllowedAddress  []byte
-	Delete          bool
+	FuncGasCost    uint64
+	Marshalizer    vmcommon.Marshalizer
+	Accounts       vmcommon.AccountsAdapter
+	ActiveHandler  func() bool
+	AllowedAddress []byte
+	Delete         bool
 }
 
 // NewMECTDeleteMetadataFunc returns the mect metadata deletion built-in function component
@@ -44,8 +42,8 @@ func NewMECTDeleteMetadataFunc(
 	if check.IfNil(args.Accounts) {
 		return nil, ErrNilAccountsAdapter
 	}
-	if check.IfNil(args.EpochNotifier) {
-		return nil, ErrNilEpochHandler
+	if args.ActiveHandler == nil {
+		return nil, ErrNilActiveHandler
 	}
 
 	e := &mectDeleteMetaData{
@@ -57,14 +55,10 @@ func NewMECTDeleteMetadataFunc(
 		delete:         args.Delete,
 	}
 
-	e.baseEnabled = &baseEnabled{
-		function:        core.BuiltInFunctionMultiMECTNFTTransfer,
-		activationEpoch: args.ActivationEpoch,
-		flagActivated:   atomic.Flag{},
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: args.ActiveHandler,
 	}
 
-	args.EpochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
@@ -311,6 +305,15 @@ func (e *mectDeleteMetaData) marshalAndSaveData(
 	return nil
 }
 
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectDeleteMetaData) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (e *mectDeleteMetaData) IsInterfaceNil() bool {
 	return e == nil
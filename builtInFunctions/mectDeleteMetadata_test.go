@@ -14,13 +14,12 @@ import (
 
 func createMockArgsForNewMECTDelete() ArgsNewMECTDeleteMetadata {
 	return ArgsNewMECTDeleteMetadata{
-		FuncGasCost:     1,
-		Marshalizer:     &mock.MarshalizerMock{},
-		Accounts:        &mock.AccountsStub{},
-		ActivationEpoch: 0,
-		EpochNotifier:   &mock.EpochNotifierStub{},
-		AllowedAddress:  bytes.Repeat([]byte{1}, 32),
-		Delete:          true,
+		FuncGasCost:    1,
+		Marshalizer:    &mock.MarshalizerMock{},
+		Accounts:       &mock.AccountsStub{},
+		ActiveHandler:  trueHandler,
+		AllowedAddress: bytes.Repeat([]byte{1}, 32),
+		Delete:         true,
 	}
 }
 
@@ -38,9 +37,9 @@ func TestNewMECTDeleteMetadataFunc(t *testing.T) {
 	assert.Equal(t, err, ErrNilAccountsAdapter)
 
 	args = createMockArgsForNewMECTDelete()
-	args.EpochNotifier = nil
+	args.ActiveHandler = nil
 	_, err = NewMECTDeleteMetadataFunc(args)
-	assert.Equal(t, err, ErrNilEpochHandler)
+	assert.Equal(t, err, ErrNilActiveHandler)
 
 	args = createMockArgsForNewMECTDelete()
 	e, err := NewMECTDeleteMetadataFunc(args)
@@ -49,7 +48,9 @@ func TestNewMECTDeleteMetadataFunc(t *testing.T) {
 	assert.True(t, e.IsActive())
 
 	args = createMockArgsForNewMECTDelete()
-	args.ActivationEpoch = 1
+	args.ActiveHandler = func() bool {
+		return false
+	}
 	e, _ = NewMECTDeleteMetadataFunc(args)
 	assert.False(t, e.IsActive())
 
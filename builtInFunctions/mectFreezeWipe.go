@@ -11,27 +11,35 @@ import (
 
 type mectFreezeWipe struct {
 	baseAlwaysActive
-	marshaller vmcommon.Marshalizer
-	keyPrefix  []byte
-	wipe       bool
-	freeze     bool
+	baseSupplyLedgerHolder
+	marshaller            vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	keyPrefix             []byte
+	wipe                  bool
+	freeze                bool
 }
 
 // NewMECTFreezeWipeFunc returns the mect freeze/un-freeze/wipe built-in function component
 func NewMECTFreezeWipeFunc(
 	marshaller vmcommon.Marshalizer,
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler,
 	freeze bool,
 	wipe bool,
 ) (*mectFreezeWipe, error) {
 	if check.IfNil(marshaller) {
 		return nil, ErrNilMarshalizer
 	}
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
 
 	e := &mectFreezeWipe{
-		marshaller: marshaller,
-		keyPrefix:  []byte(baseMECTKeyPrefix),
-		freeze:     freeze,
-		wipe:       wipe,
+		baseSupplyLedgerHolder: newBaseSupplyLedgerHolder(),
+		marshaller:             marshaller,
+		globalSettingsHandler:  globalSettingsHandler,
+		keyPrefix:              []byte(baseMECTKeyPrefix),
+		freeze:                 freeze,
+		wipe:                   wipe,
 	}
 
 	return e, nil
@@ -64,15 +72,20 @@ func (e *mectFreezeWipe) ProcessBuiltinFunction(
 
 	mectTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
 
+	identifier, nonce := extractTokenIdentifierAndNonceMECTWipe(vmInput.Arguments[0])
+
 	var amount *big.Int
 	var err error
 
 	if e.wipe {
-		amount, err = e.wipeIfApplicable(acntDst, mectTokenKey)
+		amount, err = e.wipeIfApplicable(acntDst, mectTokenKey, identifier)
+		if err != nil {
+			return nil, err
+		}
+		err = e.supplyLedger.AddWiped(identifier, nonce, amount)
 		if err != nil {
 			return nil, err
 		}
-
 	} else {
 		amount, err = e.toggleFreeze(acntDst, mectTokenKey)
 		if err != nil {
@@ -81,20 +94,22 @@ func (e *mectFreezeWipe) ProcessBuiltinFunction(
 	}
 
 	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
-	identifier, nonce := extractTokenIdentifierAndNonceMECTWipe(vmInput.Arguments[0])
 	addMECTEntryInVMOutput(vmOutput, []byte(vmInput.Function), identifier, nonce, amount, vmInput.CallerAddr, acntDst.AddressBytes())
 
 	return vmOutput, nil
 }
 
-func (e *mectFreezeWipe) wipeIfApplicable(acntDst vmcommon.UserAccountHandler, tokenKey []byte) (*big.Int, error) {
+// wipeIfApplicable removes the tokens of a frozen account. Soulbound tokens can be wiped without freezing the account
+// first, as they can not be moved by their holder anyway.
+func (e *mectFreezeWipe) wipeIfApplicable(acntDst vmcommon.UserAccountHandler, tokenKey []byte, identifier []byte) (*big.Int, error) {
 	tokenData, err := getMECTDataFromKey(acntDst, tokenKey, e.marshaller)
 	if err != nil {
 		return nil, err
 	}
 
 	mectUserMetadata := MECTUserMetadataFromBytes(tokenData.Properties)
-	if !mectUserMetadata.Frozen {
+	isSoulbound := e.globalSettingsHandler.IsSoulbound(append(e.keyPrefix, identifier...))
+	if !mectUserMetadata.Frozen && !isSoulbound {
 		return nil, ErrCannotWipeAccountNotFrozen
 	}
 
@@ -126,6 +141,15 @@ func (e *mectFreezeWipe) toggleFreeze(acntDst vmcommon.UserAccountHandler, token
 	return frozenAmount, nil
 }
 
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectFreezeWipe) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectFreezeWipe) IsInterfaceNil() bool {
 	return e == nil
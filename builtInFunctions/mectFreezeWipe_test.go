@@ -11,11 +11,23 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestNewMECTFreezeWipeFunc(t *testing.T) {
+	t.Parallel()
+
+	freeze, err := NewMECTFreezeWipeFunc(nil, &mock.GlobalSettingsHandlerStub{}, true, false)
+	assert.Nil(t, freeze)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	freeze, err = NewMECTFreezeWipeFunc(&mock.MarshalizerMock{}, nil, true, false)
+	assert.Nil(t, freeze)
+	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
+}
+
 func TestMECTFreezeWipe_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	freeze, _ := NewMECTFreezeWipeFunc(marshaller, true, false)
+	freeze, _ := NewMECTFreezeWipeFunc(marshaller, &mock.GlobalSettingsHandlerStub{}, true, false)
 	_, err := freeze.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -74,7 +86,7 @@ func TestMECTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	freeze, _ := NewMECTFreezeWipeFunc(marshaller, true, false)
+	freeze, _ := NewMECTFreezeWipeFunc(marshaller, &mock.GlobalSettingsHandlerStub{}, true, false)
 	_, err := freeze.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -104,7 +116,7 @@ func TestMECTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	mectUserData := MECTUserMetadataFromBytes(mectToken.Properties)
 	assert.True(t, mectUserData.Frozen)
 
-	unFreeze, _ := NewMECTFreezeWipeFunc(marshaller, false, false)
+	unFreeze, _ := NewMECTFreezeWipeFunc(marshaller, &mock.GlobalSettingsHandlerStub{}, false, false)
 	_, err = unFreeze.ProcessBuiltinFunction(nil, acnt, input)
 	assert.Nil(t, err)
 
@@ -115,7 +127,7 @@ func TestMECTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	assert.False(t, mectUserData.Frozen)
 
 	// cannot wipe if account is not frozen
-	wipe, _ := NewMECTFreezeWipeFunc(marshaller, false, true)
+	wipe, _ := NewMECTFreezeWipeFunc(marshaller, &mock.GlobalSettingsHandlerStub{}, false, true)
 	_, err = wipe.ProcessBuiltinFunction(nil, acnt, input)
 	assert.Equal(t, ErrCannotWipeAccountNotFrozen, err)
 
@@ -133,7 +145,7 @@ func TestMECTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	err = acnt.AccountDataHandler().SaveKeyValue(mectKey, mectTokenBytes)
 	assert.NoError(t, err)
 
-	wipe, _ = NewMECTFreezeWipeFunc(marshaller, false, true)
+	wipe, _ = NewMECTFreezeWipeFunc(marshaller, &mock.GlobalSettingsHandlerStub{}, false, true)
 	vmOutput, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
 	assert.NoError(t, err)
 
@@ -142,3 +154,39 @@ func TestMECTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	assert.Len(t, vmOutput.Logs, 1)
 	assert.Equal(t, [][]byte{key, {}, wipedAmount.Bytes(), []byte("dst")}, vmOutput.Logs[0].Topics)
 }
+
+func TestMECTFreezeWipe_WipeSoulboundWithoutFreeze(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	globalSettings := &mock.GlobalSettingsHandlerStub{}
+	wipe, _ := NewMECTFreezeWipeFunc(marshaller, globalSettings, false, true)
+
+	acnt := mock.NewUserAccount([]byte("dst"))
+	tokenID := []byte("SBT-abcdef")
+	nonce := uint64(5)
+	mectKey := computeMECTNFTTokenKey(append(keyPrefix, tokenID...), nonce)
+	mectToken := &mect.MECToken{Value: big.NewInt(1)}
+	mectTokenBytes, _ := marshaller.Marshal(mectToken)
+	_ = acnt.AccountDataHandler().SaveKeyValue(mectKey, mectTokenBytes)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{append(append([]byte{}, tokenID...), big.NewInt(int64(nonce)).Bytes()...)},
+		},
+	}
+	_, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, ErrCannotWipeAccountNotFrozen, err)
+
+	globalSettings.IsSoulboundCalled = func(token []byte) bool {
+		assert.Equal(t, append(keyPrefix, tokenID...), token)
+		return true
+	}
+	_, err = wipe.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+
+	marshaledData, _ := acnt.AccountDataHandler().RetrieveValue(mectKey)
+	assert.Equal(t, 0, len(marshaledData))
+}
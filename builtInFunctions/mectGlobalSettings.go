@@ -2,20 +2,31 @@ package builtInFunctions
 
 import (
 	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/marshal"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
 
+var royaltiesReceiverKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + "royaltiesReceiver" + core.MECTKeyIdentifier)
+var transferFeeKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + "transferFee" + core.MECTKeyIdentifier)
+var scheduledSettingsKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + "scheduledSettings" + core.MECTKeyIdentifier)
+
+const maxTransferFeeBasisPoints = 10000
+
 type mectGlobalSettings struct {
-	*baseEnabled
-	keyPrefix  []byte
-	set        bool
-	accounts   vmcommon.AccountsAdapter
-	marshaller marshal.Marshalizer
+	*baseActiveHandler
+	function            string
+	keyPrefix           []byte
+	set                 bool
+	accounts            vmcommon.AccountsAdapter
+	marshaller          marshal.Marshalizer
+	enableEpochsHandler vmcommon.EnableEpochsHandler
 }
 
 // NewMECTGlobalSettingsFunc returns the mect pause/un-pause built-in function component
@@ -24,8 +35,8 @@ func NewMECTGlobalSettingsFunc(
 	marshaller marshal.Marshalizer,
 	set bool,
 	function string,
-	activationEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	activeHandler func() bool,
 ) (*mectGlobalSettings, error) {
 	if check.IfNil(accounts) {
 		return nil, ErrNilAccountsAdapter
@@ -36,22 +47,29 @@ func NewMECTGlobalSettingsFunc(
 	if !isCorrectFunction(function) {
 		return nil, ErrInvalidArguments
 	}
+	if isOneWayFunction(function) && !set {
+		return nil, ErrInvalidArguments
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
 
 	e := &mectGlobalSettings{
-		keyPrefix:  []byte(baseMECTKeyPrefix),
-		set:        set,
-		accounts:   accounts,
-		marshaller: marshaller,
+		function:            function,
+		keyPrefix:           []byte(baseMECTKeyPrefix),
+		set:                 set,
+		accounts:            accounts,
+		marshaller:          marshaller,
+		enableEpochsHandler: enableEpochsHandler,
 	}
 
-	e.baseEnabled = &baseEnabled{
-		function:        function,
-		activationEpoch: activationEpoch,
-		flagActivated:   atomic.Flag{},
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
@@ -61,11 +79,24 @@ func isCorrectFunction(function string) bool {
 		return true
 	case vmcommon.BuiltInFunctionMECTSetBurnRoleForAll, vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll:
 		return true
+	case vmcommon.BuiltInFunctionMECTSetRoyaltiesEnforced, vmcommon.BuiltInFunctionMECTUnSetRoyaltiesEnforced:
+		return true
+	case vmcommon.BuiltInFunctionMECTFreezeMetadata, vmcommon.BuiltInFunctionMECTSetSoulbound:
+		return true
+	case vmcommon.BuiltInFunctionMECTSetTransferFee, vmcommon.BuiltInFunctionMECTUnSetTransferFee:
+		return true
+	case vmcommon.BuiltInFunctionMECTScheduleSetting, vmcommon.BuiltInFunctionMECTCancelScheduledSetting:
+		return true
 	default:
 		return false
 	}
 }
 
+// isOneWayFunction returns true for MECTFreezeMetadata and MECTSetSoulbound, which no built-in function reverts
+func isOneWayFunction(function string) bool {
+	return function == vmcommon.BuiltInFunctionMECTFreezeMetadata || function == vmcommon.BuiltInFunctionMECTSetSoulbound
+}
+
 // SetNewGasConfig is called whenever gas cost is changed
 func (e *mectGlobalSettings) SetNewGasConfig(_ *vmcommon.GasCost) {
 }
@@ -81,7 +112,7 @@ func (e *mectGlobalSettings) ProcessBuiltinFunction(
 	if vmInput.CallValue.Cmp(zero) != 0 {
 		return nil, ErrBuiltInFunctionCalledWithValue
 	}
-	if len(vmInput.Arguments) != 1 {
+	if !e.hasValidNumberOfArguments(vmInput.Arguments) {
 		return nil, ErrInvalidArguments
 	}
 	if !bytes.Equal(vmInput.CallerAddr, core.MECTSCAddress) {
@@ -91,6 +122,24 @@ func (e *mectGlobalSettings) ProcessBuiltinFunction(
 		return nil, ErrOnlySystemAccountAccepted
 	}
 
+	if e.isTransferFeeFunction() {
+		err := e.saveTransferFee(vmInput.Arguments, len(vmInput.CallerAddr))
+		if err != nil {
+			return nil, err
+		}
+
+		return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+	}
+
+	if e.isScheduleFunction() {
+		err := e.changeScheduledSettings(vmInput.Arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+	}
+
 	mectTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
 
 	err := e.toggleSetting(mectTokenKey)
@@ -98,17 +147,36 @@ func (e *mectGlobalSettings) ProcessBuiltinFunction(
 		return nil, err
 	}
 
+	if e.isRoyaltiesFunction() {
+		var receiver []byte
+		if len(vmInput.Arguments) > 1 {
+			receiver = vmInput.Arguments[1]
+		}
+		err = e.saveRoyaltiesReceiver(vmInput.Arguments[0], receiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
 	return vmOutput, nil
 }
 
+func (e *mectGlobalSettings) hasValidNumberOfArguments(arguments [][]byte) bool {
+	if e.function == vmcommon.BuiltInFunctionMECTSetTransferFee || e.isScheduleFunction() {
+		return len(arguments) == 3
+	}
+
+	return len(arguments) == 1 || e.isSetRoyaltiesWithReceiver(arguments)
+}
+
 func (e *mectGlobalSettings) toggleSetting(mectTokenKey []byte) error {
 	systemSCAccount, err := e.getSystemAccount()
 	if err != nil {
 		return err
 	}
 
-	mectMetaData, err := e.getGlobalMetadata(mectTokenKey)
+	mectMetaData, pendingSettings, hasAppliedSettings, err := e.getGlobalMetadataWithScheduledSettings(systemSCAccount, mectTokenKey)
 	if err != nil {
 		return err
 	}
@@ -123,6 +191,72 @@ func (e *mectGlobalSettings) toggleSetting(mectTokenKey []byte) error {
 	case vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll, vmcommon.BuiltInFunctionMECTSetBurnRoleForAll:
 		mectMetaData.BurnRoleForAll = e.set
 		break
+	case vmcommon.BuiltInFunctionMECTSetRoyaltiesEnforced, vmcommon.BuiltInFunctionMECTUnSetRoyaltiesEnforced:
+		mectMetaData.RoyaltiesEnforced = e.set
+		break
+	case vmcommon.BuiltInFunctionMECTFreezeMetadata:
+		mectMetaData.MetadataFrozen = true
+		break
+	case vmcommon.BuiltInFunctionMECTSetSoulbound:
+		mectMetaData.Soulbound = true
+		break
+	}
+
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(mectTokenKey, mectMetaData.ToBytes())
+	if err != nil {
+		return err
+	}
+
+	if hasAppliedSettings {
+		err = e.saveScheduledSettings(systemSCAccount, mectTokenKey[len(e.keyPrefix):], pendingSettings)
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+func (e *mectGlobalSettings) isScheduleFunction() bool {
+	return e.function == vmcommon.BuiltInFunctionMECTScheduleSetting || e.function == vmcommon.BuiltInFunctionMECTCancelScheduledSetting
+}
+
+// changeScheduledSettings adds or cancels a scheduled setting. MECTScheduleSetting and MECTCancelScheduledSetting require
+// the pause or limited transfer function and the epoch from which it takes effect as second and third arguments. The
+// scheduled settings which already took effect are written in the global metadata first, so that the remaining ones are
+// only the pending settings
+func (e *mectGlobalSettings) changeScheduledSettings(arguments [][]byte) error {
+	function := string(arguments[1])
+	if getSchedulableFunctionIndex(function) < 0 {
+		return fmt.Errorf("%w, function %s can not be scheduled", ErrInvalidArguments, function)
+	}
+	epoch := big.NewInt(0).SetBytes(arguments[2])
+	if !epoch.IsUint64() || epoch.Uint64() > math.MaxUint32 {
+		return ErrInvalidArguments
+	}
+	scheduledSetting := &MECTScheduledSetting{
+		Function: function,
+		Epoch:    uint32(epoch.Uint64()),
+	}
+
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	tokenID := arguments[0]
+	mectTokenKey := append(e.keyPrefix, tokenID...)
+	mectMetaData, pendingSettings, _, err := e.getGlobalMetadataWithScheduledSettings(systemSCAccount, mectTokenKey)
+	if err != nil {
+		return err
+	}
+	if e.set {
+		pendingSettings, err = e.addScheduledSetting(pendingSettings, scheduledSetting)
+	} else {
+		pendingSettings, err = removeScheduledSetting(pendingSettings, scheduledSetting)
+	}
+	if err != nil {
+		return err
 	}
 
 	err = systemSCAccount.AccountDataHandler().SaveKeyValue(mectTokenKey, mectMetaData.ToBytes())
@@ -130,6 +264,143 @@ func (e *mectGlobalSettings) toggleSetting(mectTokenKey []byte) error {
 		return err
 	}
 
+	err = e.saveScheduledSettings(systemSCAccount, tokenID, pendingSettings)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+// addScheduledSetting keeps the settings sorted by epoch, a setting scheduled again for the same epoch replacing the
+// previous one
+func (e *mectGlobalSettings) addScheduledSetting(
+	pendingSettings []*MECTScheduledSetting,
+	scheduledSetting *MECTScheduledSetting,
+) ([]*MECTScheduledSetting, error) {
+	currentEpoch := e.enableEpochsHandler.GetCurrentEpoch()
+	if scheduledSetting.Epoch <= currentEpoch {
+		return nil, fmt.Errorf("%w, the scheduled epoch has to be after the current epoch %d", ErrInvalidArguments, currentEpoch)
+	}
+
+	settingIndex := getSchedulableFunctionIndex(scheduledSetting.Function) / 2
+	newSettings := make([]*MECTScheduledSetting, 0, len(pendingSettings)+1)
+	for _, pendingSetting := range pendingSettings {
+		isSameSetting := getSchedulableFunctionIndex(pendingSetting.Function)/2 == settingIndex
+		if isSameSetting && pendingSetting.Epoch == scheduledSetting.Epoch {
+			continue
+		}
+		newSettings = append(newSettings, pendingSetting)
+	}
+	newSettings = append(newSettings, scheduledSetting)
+
+	sort.SliceStable(newSettings, func(i, j int) bool {
+		return newSettings[i].Epoch < newSettings[j].Epoch
+	})
+
+	return newSettings, nil
+}
+
+func removeScheduledSetting(
+	pendingSettings []*MECTScheduledSetting,
+	scheduledSetting *MECTScheduledSetting,
+) ([]*MECTScheduledSetting, error) {
+	for i, pendingSetting := range pendingSettings {
+		if pendingSetting.Function == scheduledSetting.Function && pendingSetting.Epoch == scheduledSetting.Epoch {
+			return append(pendingSettings[:i], pendingSettings[i+1:]...), nil
+		}
+	}
+
+	return nil, ErrScheduledSettingNotFound
+}
+
+func computeScheduledSettingsKey(tokenID []byte) []byte {
+	scheduledSettingsKey := make([]byte, 0, len(scheduledSettingsKeyPrefix)+len(tokenID))
+	scheduledSettingsKey = append(scheduledSettingsKey, scheduledSettingsKeyPrefix...)
+
+	return append(scheduledSettingsKey, tokenID...)
+}
+
+func (e *mectGlobalSettings) getScheduledSettings(systemSCAccount vmcommon.UserAccountHandler, tokenID []byte) ([]*MECTScheduledSetting, error) {
+	val, err := systemSCAccount.AccountDataHandler().RetrieveValue(computeScheduledSettingsKey(tokenID))
+	if err != nil {
+		return nil, err
+	}
+
+	return MECTScheduledSettingsFromBytes(val), nil
+}
+
+func (e *mectGlobalSettings) saveScheduledSettings(
+	systemSCAccount vmcommon.UserAccountHandler,
+	tokenID []byte,
+	scheduledSettings []*MECTScheduledSetting,
+) error {
+	var scheduledSettingsBytes []byte
+	if len(scheduledSettings) > 0 {
+		scheduledSettingsBytes = MECTScheduledSettingsToBytes(scheduledSettings)
+	}
+
+	return systemSCAccount.AccountDataHandler().SaveKeyValue(computeScheduledSettingsKey(tokenID), scheduledSettingsBytes)
+}
+
+func (e *mectGlobalSettings) isRoyaltiesFunction() bool {
+	return e.function == vmcommon.BuiltInFunctionMECTSetRoyaltiesEnforced || e.function == vmcommon.BuiltInFunctionMECTUnSetRoyaltiesEnforced
+}
+
+func (e *mectGlobalSettings) isSetRoyaltiesWithReceiver(arguments [][]byte) bool {
+	return e.function == vmcommon.BuiltInFunctionMECTSetRoyaltiesEnforced && len(arguments) == 2 && len(arguments[1]) > 0
+}
+
+// saveRoyaltiesReceiver saves the address which receives the royalties instead of the creator of each NFT, given to
+// MECTSetRoyaltiesEnforced as optional second argument. An empty receiver removes the previous one
+func (e *mectGlobalSettings) saveRoyaltiesReceiver(tokenID []byte, receiver []byte) error {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(append(royaltiesReceiverKeyPrefix, tokenID...), receiver)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+func (e *mectGlobalSettings) isTransferFeeFunction() bool {
+	return e.function == vmcommon.BuiltInFunctionMECTSetTransferFee || e.function == vmcommon.BuiltInFunctionMECTUnSetTransferFee
+}
+
+// saveTransferFee saves the transfer fee of the token. MECTSetTransferFee requires the fee basis points and the fee
+// receiver as second and third arguments, while MECTUnSetTransferFee only requires the token and removes the fee
+func (e *mectGlobalSettings) saveTransferFee(arguments [][]byte, addressLength int) error {
+	var transferFeeBytes []byte
+	if e.set {
+		basisPoints := big.NewInt(0).SetBytes(arguments[1])
+		if !basisPoints.IsUint64() || basisPoints.Uint64() == 0 || basisPoints.Uint64() >= maxTransferFeeBasisPoints {
+			return ErrInvalidTransferFee
+		}
+		if len(arguments[2]) != addressLength {
+			return fmt.Errorf("%w, invalid transfer fee receiver", ErrInvalidArguments)
+		}
+
+		transferFee := &MECTTransferFee{
+			BasisPoints: uint32(basisPoints.Uint64()),
+			Receiver:    arguments[2],
+		}
+		transferFeeBytes = transferFee.ToBytes()
+	}
+
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	err = systemSCAccount.AccountDataHandler().SaveKeyValue(append(transferFeeKeyPrefix, arguments[0]...), transferFeeBytes)
+	if err != nil {
+		return err
+	}
+
 	return e.accounts.SaveAccount(systemSCAccount)
 }
 
@@ -147,9 +418,14 @@ func (e *mectGlobalSettings) getSystemAccount() (vmcommon.UserAccountHandler, er
 	return userAcc, nil
 }
 
-// IsPaused returns true if the mectTokenKey (prefixed) is paused
+// IsPaused returns true if the mectTokenKey (prefixed) is paused, including the pauses scheduled up to the current epoch
 func (e *mectGlobalSettings) IsPaused(mectTokenKey []byte) bool {
-	mectMetadata, err := e.getGlobalMetadata(mectTokenKey)
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return false
+	}
+
+	mectMetadata, _, _, err := e.getGlobalMetadataWithScheduledSettings(systemSCAccount, mectTokenKey)
 	if err != nil {
 		return false
 	}
@@ -157,9 +433,15 @@ func (e *mectGlobalSettings) IsPaused(mectTokenKey []byte) bool {
 	return mectMetadata.Paused
 }
 
-// IsLimitedTransfer returns true if the mectTokenKey (prefixed) is with limited transfer
+// IsLimitedTransfer returns true if the mectTokenKey (prefixed) is with limited transfer, including the limited transfer
+// scheduled up to the current epoch
 func (e *mectGlobalSettings) IsLimitedTransfer(mectTokenKey []byte) bool {
-	mectMetadata, err := e.getGlobalMetadata(mectTokenKey)
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return false
+	}
+
+	mectMetadata, _, _, err := e.getGlobalMetadataWithScheduledSettings(systemSCAccount, mectTokenKey)
 	if err != nil {
 		return false
 	}
@@ -167,6 +449,22 @@ func (e *mectGlobalSettings) IsLimitedTransfer(mectTokenKey []byte) bool {
 	return mectMetadata.LimitedTransfer
 }
 
+// GetScheduledSettings returns the pause and limited transfer changes of the token which did not take effect yet,
+// sorted by epoch
+func (e *mectGlobalSettings) GetScheduledSettings(tokenID []byte) []*MECTScheduledSetting {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return nil
+	}
+
+	_, pendingSettings, _, err := e.getGlobalMetadataWithScheduledSettings(systemSCAccount, append(e.keyPrefix, tokenID...))
+	if err != nil {
+		return nil
+	}
+
+	return pendingSettings
+}
+
 // IsBurnForAll returns true if the mectTokenKey (prefixed) is with burn for all
 func (e *mectGlobalSettings) IsBurnForAll(mectTokenKey []byte) bool {
 	mectMetadata, err := e.getGlobalMetadata(mectTokenKey)
@@ -177,9 +475,64 @@ func (e *mectGlobalSettings) IsBurnForAll(mectTokenKey []byte) bool {
 	return mectMetadata.BurnRoleForAll
 }
 
+// IsRoyaltiesEnforced returns true if the mectTokenKey (prefixed) is with royalties enforced on transfers
+func (e *mectGlobalSettings) IsRoyaltiesEnforced(mectTokenKey []byte) bool {
+	mectMetadata, err := e.getGlobalMetadata(mectTokenKey)
+	if err != nil {
+		return false
+	}
+
+	return mectMetadata.RoyaltiesEnforced
+}
+
+// IsMetadataFrozen returns true if the attributes and URIs of the NFTs of mectTokenKey (prefixed) can not be changed
+func (e *mectGlobalSettings) IsMetadataFrozen(mectTokenKey []byte) bool {
+	mectMetadata, err := e.getGlobalMetadata(mectTokenKey)
+	if err != nil {
+		return false
+	}
+
+	return mectMetadata.MetadataFrozen
+}
+
+// IsSoulbound returns true if the mectTokenKey (prefixed) can only be created, burned and wiped, but never transferred
+func (e *mectGlobalSettings) IsSoulbound(mectTokenKey []byte) bool {
+	mectMetadata, err := e.getGlobalMetadata(mectTokenKey)
+	if err != nil {
+		return false
+	}
+
+	return mectMetadata.Soulbound
+}
+
+// GetRoyaltiesReceiver returns the address configured to receive the royalties of the collection or nil if the
+// royalties are paid to the creator of each NFT
+func (e *mectGlobalSettings) GetRoyaltiesReceiver(tokenID []byte) []byte {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return nil
+	}
+
+	receiver, _ := systemSCAccount.AccountDataHandler().RetrieveValue(append(royaltiesReceiverKeyPrefix, tokenID...))
+	return receiver
+}
+
+// GetTransferFee returns the basis points and the receiver of the fee charged on every transfer of the token or 0 if
+// the token does not have a transfer fee
+func (e *mectGlobalSettings) GetTransferFee(tokenID []byte) (uint32, []byte) {
+	systemSCAccount, err := e.getSystemAccount()
+	if err != nil {
+		return 0, nil
+	}
+
+	val, _ := systemSCAccount.AccountDataHandler().RetrieveValue(append(transferFeeKeyPrefix, tokenID...))
+	transferFee := MECTTransferFeeFromBytes(val)
+	return transferFee.BasisPoints, transferFee.Receiver
+}
+
 // IsSenderOrDestinationWithTransferRole returns true if we have transfer role on the system account
 func (e *mectGlobalSettings) IsSenderOrDestinationWithTransferRole(sender, destination, tokenID []byte) bool {
-	if !e.baseEnabled.IsActive() {
+	if !e.baseActiveHandler.IsActive() {
 		return false
 	}
 
@@ -203,6 +556,39 @@ func (e *mectGlobalSettings) IsSenderOrDestinationWithTransferRole(sender, desti
 	return false
 }
 
+// getGlobalMetadataWithScheduledSettings returns the global metadata with the scheduled settings which took effect
+// applied in epoch order, the scheduled settings still pending and whether any scheduled setting was applied
+func (e *mectGlobalSettings) getGlobalMetadataWithScheduledSettings(
+	systemSCAccount vmcommon.UserAccountHandler,
+	mectTokenKey []byte,
+) (*MECTGlobalMetadata, []*MECTScheduledSetting, bool, error) {
+	val, err := systemSCAccount.AccountDataHandler().RetrieveValue(mectTokenKey)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	mectMetaData := MECTGlobalMetadataFromBytes(val)
+	if !e.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTScheduledSettingsFlag) || len(mectTokenKey) < len(e.keyPrefix) {
+		return &mectMetaData, nil, false, nil
+	}
+
+	currentEpoch := e.enableEpochsHandler.GetCurrentEpoch()
+	scheduledSettings, err := e.getScheduledSettings(systemSCAccount, mectTokenKey[len(e.keyPrefix):])
+	if err != nil {
+		return nil, nil, false, err
+	}
+	pendingSettings := make([]*MECTScheduledSetting, 0, len(scheduledSettings))
+	for _, scheduledSetting := range scheduledSettings {
+		if scheduledSetting.Epoch > currentEpoch {
+			pendingSettings = append(pendingSettings, scheduledSetting)
+			continue
+		}
+
+		scheduledSetting.apply(&mectMetaData)
+	}
+
+	return &mectMetaData, pendingSettings, len(pendingSettings) != len(scheduledSettings), nil
+}
+
 func (e *mectGlobalSettings) getGlobalMetadata(mectTokenKey []byte) (*MECTGlobalMetadata, error) {
 	systemSCAccount, err := e.getSystemAccount()
 	if err != nil {
@@ -214,6 +600,15 @@ func (e *mectGlobalSettings) getGlobalMetadata(mectTokenKey []byte) (*MECTGlobal
 	return &mectMetaData, nil
 }
 
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectGlobalSettings) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectGlobalSettings) IsInterfaceNil() bool {
 	return e == nil
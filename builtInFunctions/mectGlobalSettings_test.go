@@ -1,6 +1,8 @@
 package builtInFunctions
 
 import (
+	"bytes"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -18,7 +20,7 @@ func TestMECTGlobalSettingsPause_ProcessBuiltInFunction(t *testing.T) {
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return acnt, nil
 		},
-	}, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTPause, 0, &mock.EpochNotifierStub{})
+	}, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTPause, &mock.EnableEpochsHandlerStub{}, trueHandler)
 	_, err := globalSettingsFunc.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -66,7 +68,7 @@ func TestMECTGlobalSettingsPause_ProcessBuiltInFunction(t *testing.T) {
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return acnt, nil
 		},
-	}, &mock.MarshalizerMock{}, false, core.BuiltInFunctionMECTUnPause, 0, &mock.EpochNotifierStub{})
+	}, &mock.MarshalizerMock{}, false, core.BuiltInFunctionMECTUnPause, &mock.EnableEpochsHandlerStub{}, trueHandler)
 
 	_, err = mectGlobalSettingsFalse.ProcessBuiltinFunction(nil, nil, input)
 	assert.Nil(t, err)
@@ -83,7 +85,7 @@ func TestMECTGlobalSettingsLimitedTransfer_ProcessBuiltInFunction(t *testing.T)
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return acnt, nil
 		},
-	}, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTSetLimitedTransfer, 0, &mock.EpochNotifierStub{})
+	}, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTSetLimitedTransfer, &mock.EnableEpochsHandlerStub{}, trueHandler)
 	_, err := globalSettingsFunc.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -131,7 +133,7 @@ func TestMECTGlobalSettingsLimitedTransfer_ProcessBuiltInFunction(t *testing.T)
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return acnt, nil
 		},
-	}, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTPause, 0, &mock.EpochNotifierStub{})
+	}, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTPause, &mock.EnableEpochsHandlerStub{}, trueHandler)
 
 	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
 	assert.Nil(t, err)
@@ -142,7 +144,7 @@ func TestMECTGlobalSettingsLimitedTransfer_ProcessBuiltInFunction(t *testing.T)
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return acnt, nil
 		},
-	}, &mock.MarshalizerMock{}, false, core.BuiltInFunctionMECTUnSetLimitedTransfer, 0, &mock.EpochNotifierStub{})
+	}, &mock.MarshalizerMock{}, false, core.BuiltInFunctionMECTUnSetLimitedTransfer, &mock.EnableEpochsHandlerStub{}, trueHandler)
 
 	_, err = mectGlobalSettingsFalse.ProcessBuiltinFunction(nil, nil, input)
 	assert.Nil(t, err)
@@ -158,7 +160,7 @@ func TestMECTGlobalSettingsBurnForAll_ProcessBuiltInFunction(t *testing.T) {
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return acnt, nil
 		},
-	}, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTSetBurnRoleForAll, 0, &mock.EpochNotifierStub{})
+	}, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTSetBurnRoleForAll, &mock.EnableEpochsHandlerStub{}, trueHandler)
 	_, err := globalSettingsFunc.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, ErrNilVmInput)
 
@@ -207,7 +209,7 @@ func TestMECTGlobalSettingsBurnForAll_ProcessBuiltInFunction(t *testing.T) {
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return acnt, nil
 		},
-	}, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTPause, 0, &mock.EpochNotifierStub{})
+	}, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTPause, &mock.EnableEpochsHandlerStub{}, trueHandler)
 
 	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
 	assert.Nil(t, err)
@@ -219,10 +221,325 @@ func TestMECTGlobalSettingsBurnForAll_ProcessBuiltInFunction(t *testing.T) {
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return acnt, nil
 		},
-	}, &mock.MarshalizerMock{}, false, vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll, 0, &mock.EpochNotifierStub{})
+	}, &mock.MarshalizerMock{}, false, vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll, &mock.EnableEpochsHandlerStub{}, trueHandler)
 
 	_, err = mectGlobalSettingsFalse.ProcessBuiltinFunction(nil, nil, input)
 	assert.Nil(t, err)
 
 	assert.False(t, globalSettingsFunc.IsLimitedTransfer(tokenID))
 }
+
+func TestMECTGlobalSettingsRoyaltiesEnforced_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+	setFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTSetRoyaltiesEnforced, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	unSetFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, false, vmcommon.BuiltInFunctionMECTUnSetRoyaltiesEnforced, &mock.EnableEpochsHandlerStub{}, trueHandler)
+
+	key := []byte("NFT-abcdef")
+	receiver := bytes.Repeat([]byte{7}, 32)
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{key, receiver, []byte("extra")},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	_, err := setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{key, receiver}
+	_, err = unSetFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	tokenKey := []byte(baseMECTKeyPrefix + string(key))
+	assert.True(t, setFunc.IsRoyaltiesEnforced(tokenKey))
+	assert.False(t, setFunc.IsPaused(tokenKey))
+	assert.Equal(t, receiver, setFunc.GetRoyaltiesReceiver(key))
+
+	input.Arguments = [][]byte{key}
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.True(t, setFunc.IsRoyaltiesEnforced(tokenKey))
+	assert.Empty(t, setFunc.GetRoyaltiesReceiver(key))
+
+	input.Arguments = [][]byte{key, receiver}
+	_, _ = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	input.Arguments = [][]byte{key}
+	_, err = unSetFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.False(t, setFunc.IsRoyaltiesEnforced(tokenKey))
+	assert.Empty(t, setFunc.GetRoyaltiesReceiver(key))
+}
+
+func TestMECTGlobalSettingsFreezeMetadata_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+
+	unFreezeFunc, err := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, false, vmcommon.BuiltInFunctionMECTFreezeMetadata, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, unFreezeFunc)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	freezeFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTFreezeMetadata, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	pauseFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTPause, &mock.EnableEpochsHandlerStub{}, trueHandler)
+
+	key := []byte("NFT-abcdef")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: []byte("owner"),
+			Arguments:  [][]byte{key},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	_, err = freezeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrAddressIsNotMECTSystemSC, err)
+
+	input.CallerAddr = core.MECTSCAddress
+	_, err = freezeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	tokenKey := []byte(baseMECTKeyPrefix + string(key))
+	assert.True(t, freezeFunc.IsMetadataFrozen(tokenKey))
+	assert.False(t, freezeFunc.IsPaused(tokenKey))
+
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.True(t, freezeFunc.IsPaused(tokenKey))
+	assert.True(t, freezeFunc.IsMetadataFrozen(tokenKey))
+}
+
+func TestMECTGlobalSettingsSoulbound_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+
+	unSetFunc, err := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, false, vmcommon.BuiltInFunctionMECTSetSoulbound, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, unSetFunc)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	setFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTSetSoulbound, &mock.EnableEpochsHandlerStub{}, trueHandler)
+
+	key := []byte("SBT-abcdef")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{key},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	tokenKey := []byte(baseMECTKeyPrefix + string(key))
+	assert.True(t, setFunc.IsSoulbound(tokenKey))
+	assert.False(t, setFunc.IsLimitedTransfer(tokenKey))
+	assert.False(t, setFunc.IsMetadataFrozen(tokenKey))
+}
+
+func TestMECTGlobalSettingsTransferFee_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+	setFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTSetTransferFee, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	unSetFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, false, vmcommon.BuiltInFunctionMECTUnSetTransferFee, &mock.EnableEpochsHandlerStub{}, trueHandler)
+
+	key := []byte("TKN-abcdef")
+	receiver := bytes.Repeat([]byte{7}, 32)
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{key, big.NewInt(100).Bytes()},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	_, err := setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{key, big.NewInt(0).Bytes(), receiver}
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidTransferFee, err)
+
+	input.Arguments = [][]byte{key, big.NewInt(10000).Bytes(), receiver}
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidTransferFee, err)
+
+	input.Arguments = [][]byte{key, big.NewInt(100).Bytes(), []byte("receiver")}
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	input.Arguments = [][]byte{key, big.NewInt(100).Bytes(), receiver}
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	basisPoints, feeReceiver := setFunc.GetTransferFee(key)
+	assert.Equal(t, uint32(100), basisPoints)
+	assert.Equal(t, receiver, feeReceiver)
+	assert.False(t, setFunc.IsPaused([]byte(baseMECTKeyPrefix+string(key))))
+
+	input.Arguments = [][]byte{key, big.NewInt(100).Bytes(), receiver}
+	_, err = unSetFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{key}
+	_, err = unSetFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	basisPoints, feeReceiver = setFunc.GetTransferFee(key)
+	assert.Equal(t, uint32(0), basisPoints)
+	assert.Empty(t, feeReceiver)
+}
+
+func TestMECTGlobalSettingsScheduledSettings_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+	enableEpochsHandler := &mock.EnableEpochsHandlerStub{IsMECTScheduledSettingsFlagEnabledField: true, CurrentEpochField: 5}
+
+	scheduleFunc, err := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTScheduleSetting, nil, trueHandler)
+	assert.Nil(t, scheduleFunc)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
+
+	scheduleFunc, _ = NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTScheduleSetting, enableEpochsHandler, trueHandler)
+	cancelFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, false, vmcommon.BuiltInFunctionMECTCancelScheduledSetting, enableEpochsHandler, trueHandler)
+	unPauseFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, false, core.BuiltInFunctionMECTUnPause, enableEpochsHandler, trueHandler)
+
+	key := []byte("TKN-abcdef")
+	tokenKey := []byte(baseMECTKeyPrefix + string(key))
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{key, []byte(core.BuiltInFunctionMECTPause)},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	_, err = scheduleFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{key, []byte(vmcommon.BuiltInFunctionMECTSetSoulbound), big.NewInt(10).Bytes()}
+	_, err = scheduleFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	input.Arguments = [][]byte{key, []byte(core.BuiltInFunctionMECTPause), big.NewInt(5).Bytes()}
+	_, err = scheduleFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	input.Arguments = [][]byte{key, []byte(core.BuiltInFunctionMECTPause), big.NewInt(10).Bytes()}
+	_, err = scheduleFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	input.Arguments = [][]byte{key, []byte(core.BuiltInFunctionMECTUnPause), big.NewInt(20).Bytes()}
+	_, err = scheduleFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	input.Arguments = [][]byte{key, []byte(core.BuiltInFunctionMECTSetLimitedTransfer), big.NewInt(8).Bytes()}
+	_, err = scheduleFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	assert.False(t, scheduleFunc.IsPaused(tokenKey))
+	assert.False(t, scheduleFunc.IsLimitedTransfer(tokenKey))
+	assert.Equal(t, []*MECTScheduledSetting{
+		{Function: core.BuiltInFunctionMECTSetLimitedTransfer, Epoch: 8},
+		{Function: core.BuiltInFunctionMECTPause, Epoch: 10},
+		{Function: core.BuiltInFunctionMECTUnPause, Epoch: 20},
+	}, scheduleFunc.GetScheduledSettings(key))
+
+	input.Arguments = [][]byte{key, []byte(core.BuiltInFunctionMECTSetLimitedTransfer), big.NewInt(9).Bytes()}
+	_, err = cancelFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrScheduledSettingNotFound, err)
+
+	input.Arguments = [][]byte{key, []byte(core.BuiltInFunctionMECTSetLimitedTransfer), big.NewInt(8).Bytes()}
+	_, err = cancelFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	enableEpochsHandler.CurrentEpochField = 10
+	assert.True(t, scheduleFunc.IsPaused(tokenKey))
+	assert.False(t, scheduleFunc.IsLimitedTransfer(tokenKey))
+	assert.Equal(t, []*MECTScheduledSetting{
+		{Function: core.BuiltInFunctionMECTUnPause, Epoch: 20},
+	}, scheduleFunc.GetScheduledSettings(key))
+
+	input.Arguments = [][]byte{key, []byte(core.BuiltInFunctionMECTPause), big.NewInt(10).Bytes()}
+	_, err = cancelFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrScheduledSettingNotFound, err)
+
+	input.Arguments = [][]byte{key}
+	_, err = unPauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.False(t, scheduleFunc.IsPaused(tokenKey))
+
+	enableEpochsHandler.CurrentEpochField = 20
+	assert.False(t, scheduleFunc.IsPaused(tokenKey))
+	assert.Empty(t, scheduleFunc.GetScheduledSettings(key))
+}
+
+func TestMECTGlobalSettingsScheduledSettings_ReadErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	retrieveErr := errors.New("retrieve error")
+	systemAcc := createAccountWithRetrieveValueError(retrieveErr)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAcc, nil
+		},
+	}
+	enableEpochsHandler := &mock.EnableEpochsHandlerStub{IsMECTScheduledSettingsFlagEnabledField: true, CurrentEpochField: 5}
+	pauseFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, core.BuiltInFunctionMECTPause, enableEpochsHandler, trueHandler)
+	scheduleFunc, _ := NewMECTGlobalSettingsFunc(accounts, &mock.MarshalizerMock{}, true, vmcommon.BuiltInFunctionMECTScheduleSetting, enableEpochsHandler, trueHandler)
+
+	key := []byte("TKN-abcdef")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{key},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+	_, err := pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, retrieveErr, err)
+
+	input.Arguments = [][]byte{key, []byte(core.BuiltInFunctionMECTPause), big.NewInt(7).Bytes()}
+	_, err = scheduleFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, retrieveErr, err)
+
+	_, _, _, err = scheduleFunc.getGlobalMetadataWithScheduledSettings(systemAcc, append([]byte(baseMECTKeyPrefix), key...))
+	assert.Equal(t, retrieveErr, err)
+	_, err = scheduleFunc.getScheduledSettings(systemAcc, key)
+	assert.Equal(t, retrieveErr, err)
+	assert.False(t, pauseFunc.IsPaused(append([]byte(baseMECTKeyPrefix), key...)))
+	assert.Nil(t, pauseFunc.GetScheduledSettings(key))
+}
@@ -12,6 +12,7 @@ import (
 
 type mectLocalBurn struct {
 	baseAlwaysActive
+	baseSupplyLedgerHolder
 	keyPrefix             []byte
 	marshaller            vmcommon.Marshalizer
 	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
@@ -38,12 +39,13 @@ func NewMECTLocalBurnFunc(
 	}
 
 	e := &mectLocalBurn{
-		keyPrefix:             []byte(baseMECTKeyPrefix),
-		marshaller:            marshaller,
-		globalSettingsHandler: globalSettingsHandler,
-		rolesHandler:          rolesHandler,
-		funcGasCost:           funcGasCost,
-		mutExecution:          sync.RWMutex{},
+		baseSupplyLedgerHolder: newBaseSupplyLedgerHolder(),
+		keyPrefix:              []byte(baseMECTKeyPrefix),
+		marshaller:             marshaller,
+		globalSettingsHandler:  globalSettingsHandler,
+		rolesHandler:           rolesHandler,
+		funcGasCost:            funcGasCost,
+		mutExecution:           sync.RWMutex{},
 	}
 
 	return e, nil
@@ -85,6 +87,10 @@ func (e *mectLocalBurn) ProcessBuiltinFunction(
 	if err != nil {
 		return nil, err
 	}
+	err = e.supplyLedger.AddBurned(tokenID, 0, value)
+	if err != nil {
+		return nil, err
+	}
 
 	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
 
@@ -103,6 +109,18 @@ func (e *mectLocalBurn) isAllowedToBurn(acntSnd vmcommon.UserAccountHandler, tok
 	return e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.MECTRoleLocalBurn))
 }
 
+// EstimateGas returns the gas consumed by the MECT local burn function
+func (e *mectLocalBurn) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return e.funcGasCost, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectLocalBurn) IsInterfaceNil() bool {
 	return e == nil
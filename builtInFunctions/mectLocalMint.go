@@ -12,10 +12,12 @@ import (
 
 type mectLocalMint struct {
 	baseAlwaysActive
+	baseSupplyLedgerHolder
 	keyPrefix             []byte
 	marshaller            vmcommon.Marshalizer
 	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler
 	rolesHandler          vmcommon.MECTRoleHandler
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
 	funcGasCost           uint64
 	mutExecution          sync.RWMutex
 }
@@ -26,6 +28,7 @@ func NewMECTLocalMintFunc(
 	marshaller vmcommon.Marshalizer,
 	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler,
 	rolesHandler vmcommon.MECTRoleHandler,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
 ) (*mectLocalMint, error) {
 	if check.IfNil(marshaller) {
 		return nil, ErrNilMarshalizer
@@ -36,14 +39,19 @@ func NewMECTLocalMintFunc(
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
 
 	e := &mectLocalMint{
-		keyPrefix:             []byte(baseMECTKeyPrefix),
-		marshaller:            marshaller,
-		globalSettingsHandler: globalSettingsHandler,
-		rolesHandler:          rolesHandler,
-		funcGasCost:           funcGasCost,
-		mutExecution:          sync.RWMutex{},
+		baseSupplyLedgerHolder: newBaseSupplyLedgerHolder(),
+		keyPrefix:              []byte(baseMECTKeyPrefix),
+		marshaller:             marshaller,
+		globalSettingsHandler:  globalSettingsHandler,
+		rolesHandler:           rolesHandler,
+		enableEpochsHandler:    enableEpochsHandler,
+		funcGasCost:            funcGasCost,
+		mutExecution:           sync.RWMutex{},
 	}
 
 	return e, nil
@@ -60,7 +68,8 @@ func (e *mectLocalMint) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 	e.mutExecution.Unlock()
 }
 
-// ProcessBuiltinFunction resolves MECT local mint function call
+// ProcessBuiltinFunction resolves MECT local mint function call. The minted value is recorded against the mint quota
+// set by the MECT system SC for the caller, if there is one
 func (e *mectLocalMint) ProcessBuiltinFunction(
 	acntSnd, _ vmcommon.UserAccountHandler,
 	vmInput *vmcommon.ContractCallInput,
@@ -84,11 +93,22 @@ func (e *mectLocalMint) ProcessBuiltinFunction(
 	}
 
 	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if e.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTMintQuotaFlag) {
+		err = useMintQuota(acntSnd, tokenID, value, e.enableEpochsHandler.GetCurrentEpoch())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	mectTokenKey := append(e.keyPrefix, tokenID...)
 	err = addToMECTBalance(acntSnd, mectTokenKey, big.NewInt(0).Set(value), e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
 	if err != nil {
 		return nil, err
 	}
+	err = e.supplyLedger.AddMinted(tokenID, 0, value)
+	if err != nil {
+		return nil, err
+	}
 
 	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
 
@@ -97,6 +117,18 @@ func (e *mectLocalMint) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// EstimateGas returns the gas consumed by the MECT local mint function
+func (e *mectLocalMint) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return e.funcGasCost, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectLocalMint) IsInterfaceNil() bool {
 	return e == nil
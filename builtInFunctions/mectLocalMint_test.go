@@ -18,34 +18,41 @@ func TestNewMECTLocalMintFunc(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		argsFunc func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler)
+		argsFunc func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler, h vmcommon.EnableEpochsHandler)
 		exError  error
 	}{
 		{
 			name: "NilMarshalizer",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler) {
-				return 0, nil, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler, h vmcommon.EnableEpochsHandler) {
+				return 0, nil, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{}
 			},
 			exError: ErrNilMarshalizer,
 		},
 		{
 			name: "NilGlobalSettingsHandler",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, nil, &mock.MECTRoleHandlerStub{}
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler, h vmcommon.EnableEpochsHandler) {
+				return 0, &mock.MarshalizerMock{}, nil, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{}
 			},
 			exError: ErrNilGlobalSettingsHandler,
 		},
 		{
 			name: "NilRolesHandler",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler, h vmcommon.EnableEpochsHandler) {
+				return 0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, nil, &mock.EnableEpochsHandlerStub{}
 			},
 			exError: ErrNilRolesHandler,
 		},
+		{
+			name: "NilEnableEpochsHandler",
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler, h vmcommon.EnableEpochsHandler) {
+				return 0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, nil
+			},
+			exError: ErrNilEnableEpochsHandler,
+		},
 		{
 			name: "Ok",
-			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler) {
-				return 0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}
+			argsFunc: func() (c uint64, m vmcommon.Marshalizer, p vmcommon.MECTGlobalSettingsHandler, r vmcommon.MECTRoleHandler, h vmcommon.EnableEpochsHandler) {
+				return 0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{}
 			},
 			exError: nil,
 		},
@@ -62,7 +69,7 @@ func TestNewMECTLocalMintFunc(t *testing.T) {
 func TestMectLocalMint_SetNewGasConfig(t *testing.T) {
 	t.Parallel()
 
-	mectLocalMintF, _ := NewMECTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{})
+	mectLocalMintF, _ := NewMECTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{})
 
 	mectLocalMintF.SetNewGasConfig(&vmcommon.GasCost{BuiltInCost: vmcommon.BuiltInCost{
 		MECTLocalMint: 500},
@@ -74,7 +81,7 @@ func TestMectLocalMint_SetNewGasConfig(t *testing.T) {
 func TestMectLocalMint_ProcessBuiltinFunction_CalledWithValueShouldErr(t *testing.T) {
 	t.Parallel()
 
-	mectLocalMintF, _ := NewMECTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{})
+	mectLocalMintF, _ := NewMECTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{})
 
 	_, err := mectLocalMintF.ProcessBuiltinFunction(&mock.AccountWrapMock{}, &mock.AccountWrapMock{}, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -92,7 +99,7 @@ func TestMectLocalMint_ProcessBuiltinFunction_CheckAllowToExecuteShouldErr(t *te
 		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 			return localErr
 		},
-	})
+	}, &mock.EnableEpochsHandlerStub{})
 
 	_, err := mectLocalMintF.ProcessBuiltinFunction(&mock.AccountWrapMock{}, &mock.AccountWrapMock{}, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -110,7 +117,7 @@ func TestMectLocalMint_ProcessBuiltinFunction_CannotAddToMectBalanceShouldErr(t
 		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 			return nil
 		},
-	})
+	}, &mock.EnableEpochsHandlerStub{})
 
 	localErr := errors.New("local err")
 	_, err := mectLocalMintF.ProcessBuiltinFunction(&mock.UserAccountStub{
@@ -143,7 +150,7 @@ func TestMectLocalMint_ProcessBuiltinFunction_ShouldWork(t *testing.T) {
 			return nil
 		},
 	}
-	mectLocalMintF, _ := NewMECTLocalMintFunc(50, marshaller, &mock.GlobalSettingsHandlerStub{}, mectRoleHandler)
+	mectLocalMintF, _ := NewMECTLocalMintFunc(50, marshaller, &mock.GlobalSettingsHandlerStub{}, mectRoleHandler, &mock.EnableEpochsHandlerStub{})
 
 	sndAccout := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -1,7 +1,27 @@
 package builtInFunctions
 
+import (
+	"encoding/binary"
+
+	"github.com/ME-MotherEarth/me-core/core"
+)
+
 const lengthOfMECTMetadata = 2
 
+const lengthOfTransferFeeBasisPoints = 4
+
+// lengthOfScheduledSetting is the size of the function index and of the epoch of a scheduled setting
+const lengthOfScheduledSetting = 5
+
+// schedulableFunctions are the global settings functions which can be scheduled, indexed as saved on system account.
+// The functions are grouped by two, each pair changing the same setting
+var schedulableFunctions = []string{
+	core.BuiltInFunctionMECTPause,
+	core.BuiltInFunctionMECTUnPause,
+	core.BuiltInFunctionMECTSetLimitedTransfer,
+	core.BuiltInFunctionMECTUnSetLimitedTransfer,
+}
+
 const (
 	// MetadataPaused is the location of paused flag in the mect global meta data
 	MetadataPaused = 1
@@ -9,6 +29,15 @@ const (
 	MetadataLimitedTransfer = 2
 	// BurnRoleForAll is the location of burn role for all flag in the mect global meta data
 	BurnRoleForAll = 4
+	// MetadataRoyaltiesEnforced is the location of royalties enforced flag in the mect global meta data
+	MetadataRoyaltiesEnforced = 8
+)
+
+const (
+	// MetadataImmutable is the location of the metadata frozen flag in the second byte of the mect global meta data
+	MetadataImmutable = 1
+	// MetadataSoulbound is the location of the soulbound flag in the second byte of the mect global meta data
+	MetadataSoulbound = 2
 )
 
 const (
@@ -18,9 +47,12 @@ const (
 
 // MECTGlobalMetadata represents mect global metadata saved on system account
 type MECTGlobalMetadata struct {
-	Paused          bool
-	LimitedTransfer bool
-	BurnRoleForAll  bool
+	Paused            bool
+	LimitedTransfer   bool
+	BurnRoleForAll    bool
+	RoyaltiesEnforced bool
+	MetadataFrozen    bool
+	Soulbound         bool
 }
 
 // MECTGlobalMetadataFromBytes creates a metadata object from bytes
@@ -30,9 +62,12 @@ func MECTGlobalMetadataFromBytes(bytes []byte) MECTGlobalMetadata {
 	}
 
 	return MECTGlobalMetadata{
-		Paused:          (bytes[0] & MetadataPaused) != 0,
-		LimitedTransfer: (bytes[0] & MetadataLimitedTransfer) != 0,
-		BurnRoleForAll:  (bytes[0] & BurnRoleForAll) != 0,
+		Paused:            (bytes[0] & MetadataPaused) != 0,
+		LimitedTransfer:   (bytes[0] & MetadataLimitedTransfer) != 0,
+		BurnRoleForAll:    (bytes[0] & BurnRoleForAll) != 0,
+		RoyaltiesEnforced: (bytes[0] & MetadataRoyaltiesEnforced) != 0,
+		MetadataFrozen:    (bytes[1] & MetadataImmutable) != 0,
+		Soulbound:         (bytes[1] & MetadataSoulbound) != 0,
 	}
 }
 
@@ -49,6 +84,15 @@ func (metadata *MECTGlobalMetadata) ToBytes() []byte {
 	if metadata.BurnRoleForAll {
 		bytes[0] |= BurnRoleForAll
 	}
+	if metadata.RoyaltiesEnforced {
+		bytes[0] |= MetadataRoyaltiesEnforced
+	}
+	if metadata.MetadataFrozen {
+		bytes[1] |= MetadataImmutable
+	}
+	if metadata.Soulbound {
+		bytes[1] |= MetadataSoulbound
+	}
 
 	return bytes
 }
@@ -79,3 +123,88 @@ func (metadata *MECTUserMetadata) ToBytes() []byte {
 
 	return bytes
 }
+
+// MECTTransferFee represents the fee charged on every transfer of a token, saved on system account
+type MECTTransferFee struct {
+	BasisPoints uint32
+	Receiver    []byte
+}
+
+// MECTTransferFeeFromBytes creates a transfer fee object from bytes
+func MECTTransferFeeFromBytes(bytes []byte) MECTTransferFee {
+	if len(bytes) <= lengthOfTransferFeeBasisPoints {
+		return MECTTransferFee{}
+	}
+
+	return MECTTransferFee{
+		BasisPoints: binary.BigEndian.Uint32(bytes[:lengthOfTransferFeeBasisPoints]),
+		Receiver:    bytes[lengthOfTransferFeeBasisPoints:],
+	}
+}
+
+// ToBytes converts the transfer fee to bytes
+func (transferFee *MECTTransferFee) ToBytes() []byte {
+	bytes := make([]byte, lengthOfTransferFeeBasisPoints, lengthOfTransferFeeBasisPoints+len(transferFee.Receiver))
+	binary.BigEndian.PutUint32(bytes, transferFee.BasisPoints)
+
+	return append(bytes, transferFee.Receiver...)
+}
+
+// MECTScheduledSetting represents a change of the paused or limited transfer global setting of a token, saved on
+// system account, which takes effect at the beginning of Epoch. Function is the built-in function making the change
+type MECTScheduledSetting struct {
+	Function string
+	Epoch    uint32
+}
+
+// MECTScheduledSettingsFromBytes creates the scheduled settings objects from bytes, skipping the unknown functions
+func MECTScheduledSettingsFromBytes(bytes []byte) []*MECTScheduledSetting {
+	scheduledSettings := make([]*MECTScheduledSetting, 0, len(bytes)/lengthOfScheduledSetting)
+	for ; len(bytes) >= lengthOfScheduledSetting; bytes = bytes[lengthOfScheduledSetting:] {
+		functionIndex := int(bytes[0])
+		if functionIndex >= len(schedulableFunctions) {
+			continue
+		}
+
+		scheduledSettings = append(scheduledSettings, &MECTScheduledSetting{
+			Function: schedulableFunctions[functionIndex],
+			Epoch:    binary.BigEndian.Uint32(bytes[1:lengthOfScheduledSetting]),
+		})
+	}
+
+	return scheduledSettings
+}
+
+// MECTScheduledSettingsToBytes converts the scheduled settings to bytes
+func MECTScheduledSettingsToBytes(scheduledSettings []*MECTScheduledSetting) []byte {
+	bytes := make([]byte, 0, len(scheduledSettings)*lengthOfScheduledSetting)
+	for _, scheduledSetting := range scheduledSettings {
+		entry := make([]byte, lengthOfScheduledSetting)
+		entry[0] = byte(getSchedulableFunctionIndex(scheduledSetting.Function))
+		binary.BigEndian.PutUint32(entry[1:], scheduledSetting.Epoch)
+		bytes = append(bytes, entry...)
+	}
+
+	return bytes
+}
+
+// getSchedulableFunctionIndex returns -1 if the function can not be scheduled
+func getSchedulableFunctionIndex(function string) int {
+	for i, schedulableFunction := range schedulableFunctions {
+		if schedulableFunction == function {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// apply makes the change of the scheduled setting on the global metadata
+func (scheduledSetting *MECTScheduledSetting) apply(metadata *MECTGlobalMetadata) {
+	switch scheduledSetting.Function {
+	case core.BuiltInFunctionMECTPause, core.BuiltInFunctionMECTUnPause:
+		metadata.Paused = scheduledSetting.Function == core.BuiltInFunctionMECTPause
+	case core.BuiltInFunctionMECTSetLimitedTransfer, core.BuiltInFunctionMECTUnSetLimitedTransfer:
+		metadata.LimitedTransfer = scheduledSetting.Function == core.BuiltInFunctionMECTSetLimitedTransfer
+	}
+}
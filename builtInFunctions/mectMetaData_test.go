@@ -3,6 +3,7 @@ package builtInFunctions
 import (
 	"testing"
 
+	"github.com/ME-MotherEarth/me-core/core"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,6 +47,21 @@ func TestMECTGlobalMetaData_ToBytesWhenTransferAndPause(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestMECTGlobalMetaData_ToBytesWhenRoyaltiesEnforced(t *testing.T) {
+	t.Parallel()
+
+	mectMetaData := &MECTGlobalMetadata{
+		Paused:            true,
+		RoyaltiesEnforced: true,
+	}
+
+	expected := make([]byte, lengthOfMECTMetadata)
+	expected[0] = 9
+	actual := mectMetaData.ToBytes()
+	require.Equal(t, expected, actual)
+	require.Equal(t, *mectMetaData, MECTGlobalMetadataFromBytes(actual))
+}
+
 func TestMECTGlobalMetaData_ToBytesWhenNotPaused(t *testing.T) {
 	t.Parallel()
 
@@ -157,3 +173,63 @@ func TestMECTGlobalMetadata_FromBytes(t *testing.T) {
 	require.True(t, MECTGlobalMetadataFromBytes([]byte{3, 0}).Paused)
 	require.True(t, MECTGlobalMetadataFromBytes([]byte{3, 0}).LimitedTransfer)
 }
+
+func TestMECTGlobalMetaData_ToBytesWhenMetadataFrozen(t *testing.T) {
+	t.Parallel()
+
+	mectMetaData := &MECTGlobalMetadata{
+		Paused:         true,
+		MetadataFrozen: true,
+	}
+
+	expected := make([]byte, lengthOfMECTMetadata)
+	expected[0] = 1
+	expected[1] = 1
+	actual := mectMetaData.ToBytes()
+	require.Equal(t, expected, actual)
+	require.Equal(t, *mectMetaData, MECTGlobalMetadataFromBytes(actual))
+}
+
+func TestMECTGlobalMetaData_ToBytesWhenSoulbound(t *testing.T) {
+	t.Parallel()
+
+	mectMetaData := &MECTGlobalMetadata{
+		MetadataFrozen: true,
+		Soulbound:      true,
+	}
+
+	expected := make([]byte, lengthOfMECTMetadata)
+	expected[1] = 3
+	actual := mectMetaData.ToBytes()
+	require.Equal(t, expected, actual)
+	require.Equal(t, *mectMetaData, MECTGlobalMetadataFromBytes(actual))
+}
+
+func TestMECTTransferFee_ToBytesAndFromBytes(t *testing.T) {
+	t.Parallel()
+
+	transferFee := &MECTTransferFee{
+		BasisPoints: 250,
+		Receiver:    []byte("receiver"),
+	}
+
+	actual := transferFee.ToBytes()
+	require.Equal(t, append([]byte{0, 0, 0, 250}, []byte("receiver")...), actual)
+	require.Equal(t, *transferFee, MECTTransferFeeFromBytes(actual))
+	require.Equal(t, MECTTransferFee{}, MECTTransferFeeFromBytes([]byte{0, 0, 0, 250}))
+}
+
+func TestMECTScheduledSettings_ToBytesAndFromBytes(t *testing.T) {
+	t.Parallel()
+
+	scheduledSettings := []*MECTScheduledSetting{
+		{Function: core.BuiltInFunctionMECTPause, Epoch: 10},
+		{Function: core.BuiltInFunctionMECTUnSetLimitedTransfer, Epoch: 300},
+	}
+
+	actual := MECTScheduledSettingsToBytes(scheduledSettings)
+	require.Equal(t, []byte{0, 0, 0, 0, 10, 3, 0, 0, 1, 44}, actual)
+	require.Equal(t, scheduledSettings, MECTScheduledSettingsFromBytes(actual))
+	require.Equal(t, scheduledSettings[:1], MECTScheduledSettingsFromBytes(append(actual[:5:5], 7, 0, 0, 0, 1)))
+	require.Empty(t, MECTScheduledSettingsFromBytes(nil))
+}
@@ -0,0 +1,207 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const mintQuota = "mintQuota"
+
+// mintQuotaKeyPrefix is the minter account key prefix under which the mint quota of each token is saved
+var mintQuotaKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + mintQuota + core.MECTKeyIdentifier)
+
+// mintQuotaHeaderSize is the size of the per epoch flag and of the epoch written in front of the quota values
+const mintQuotaHeaderSize = 5
+
+type mectSetMintQuota struct {
+	*baseActiveHandler
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+// NewMECTSetMintQuotaFunc returns the mect set mint quota built-in function component
+func NewMECTSetMintQuotaFunc(
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	activeHandler func() bool,
+) (*mectSetMintQuota, error) {
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
+
+	e := &mectSetMintQuota{
+		enableEpochsHandler: enableEpochsHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectSetMintQuota) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves MECT set mint quota function call
+// Requires the following arguments:
+// arg0 - token identifier
+// arg1 - quota amount, the quota of the account being removed if zero
+// arg2 - optional true if the quota is renewed every epoch
+// Setting a quota replaces the previous one of the account together with the amount minted against it
+func (e *mectSetMintQuota) ProcessBuiltinFunction(
+	_, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkBasicMECTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) > 3 {
+		return nil, ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, core.MECTSCAddress) {
+		return nil, ErrAddressIsNotMECTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, ErrNilUserAccount
+	}
+
+	if len(vmInput.Arguments[1]) > core.MaxLenForMECTIssueMint {
+		return nil, fmt.Errorf("%w max length for mint quota is %d", ErrInvalidArguments, core.MaxLenForMECTIssueMint)
+	}
+
+	tokenID := vmInput.Arguments[0]
+	mintQuotaKey := computeMintQuotaKey(tokenID)
+	quota := &vmcommon.MECTMintQuota{
+		TokenID: tokenID,
+		Amount:  big.NewInt(0).SetBytes(vmInput.Arguments[1]),
+		Minted:  big.NewInt(0),
+		Epoch:   e.enableEpochsHandler.GetCurrentEpoch(),
+	}
+	if quota.Amount.Sign() == 0 {
+		err = acntDst.AccountDataHandler().SaveKeyValue(mintQuotaKey, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.createVMOutput(vmInput, acntDst), nil
+	}
+	if len(vmInput.Arguments) == 3 {
+		quota.PerEpoch = bytes.Equal(vmInput.Arguments[2], boolToSlice(true))
+	}
+
+	err = acntDst.AccountDataHandler().SaveKeyValue(mintQuotaKey, serializeMintQuota(quota))
+	if err != nil {
+		return nil, err
+	}
+
+	return e.createVMOutput(vmInput, acntDst), nil
+}
+
+func (e *mectSetMintQuota) createVMOutput(vmInput *vmcommon.ContractCallInput, acntDst vmcommon.UserAccountHandler) *vmcommon.VMOutput {
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+
+	logData := append([][]byte{acntDst.AddressBytes()}, vmInput.Arguments[1:]...)
+	addMECTEntryInVMOutput(vmOutput, []byte(vmInput.Function), vmInput.Arguments[0], 0, big.NewInt(0), logData...)
+
+	return vmOutput
+}
+
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectSetMintQuota) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectSetMintQuota) IsInterfaceNil() bool {
+	return e == nil
+}
+
+func computeMintQuotaKey(tokenID []byte) []byte {
+	mintQuotaKey := make([]byte, 0, len(mintQuotaKeyPrefix)+len(tokenID))
+	mintQuotaKey = append(mintQuotaKey, mintQuotaKeyPrefix...)
+
+	return append(mintQuotaKey, tokenID...)
+}
+
+func serializeMintQuota(quota *vmcommon.MECTMintQuota) []byte {
+	amountBytes := quota.Amount.Bytes()
+	mintedBytes := quota.Minted.Bytes()
+	buff := make([]byte, mintQuotaHeaderSize+lengthPrefixSize, mintQuotaHeaderSize+lengthPrefixSize+len(amountBytes)+len(mintedBytes))
+	if quota.PerEpoch {
+		buff[0] = 1
+	}
+	binary.BigEndian.PutUint32(buff[1:mintQuotaHeaderSize], quota.Epoch)
+	binary.BigEndian.PutUint32(buff[mintQuotaHeaderSize:], uint32(len(amountBytes)))
+	buff = append(buff, amountBytes...)
+
+	return append(buff, mintedBytes...)
+}
+
+func deserializeMintQuota(tokenID []byte, buff []byte) (*vmcommon.MECTMintQuota, error) {
+	if len(buff) < mintQuotaHeaderSize+lengthPrefixSize {
+		return nil, ErrInvalidMintQuotaData
+	}
+
+	quota := &vmcommon.MECTMintQuota{
+		TokenID:  tokenID,
+		PerEpoch: buff[0] == 1,
+		Epoch:    binary.BigEndian.Uint32(buff[1:mintQuotaHeaderSize]),
+	}
+	buff = buff[mintQuotaHeaderSize:]
+	amountLength := int(binary.BigEndian.Uint32(buff[:lengthPrefixSize]))
+	buff = buff[lengthPrefixSize:]
+	if len(buff) < amountLength {
+		return nil, ErrInvalidMintQuotaData
+	}
+	quota.Amount = big.NewInt(0).SetBytes(buff[:amountLength])
+	quota.Minted = big.NewInt(0).SetBytes(buff[amountLength:])
+
+	return quota, nil
+}
+
+// getMintQuota returns nil if the account has no mint quota for the token
+func getMintQuota(account vmcommon.UserAccountHandler, tokenID []byte) (*vmcommon.MECTMintQuota, error) {
+	data, err := account.AccountDataHandler().RetrieveValue(computeMintQuotaKey(tokenID))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	return deserializeMintQuota(tokenID, data)
+}
+
+// useMintQuota records the minted value against the mint quota of the account, if there is one. The quota per epoch
+// is renewed when first used in a new epoch
+func useMintQuota(account vmcommon.UserAccountHandler, tokenID []byte, value *big.Int, currentEpoch uint32) error {
+	quota, err := getMintQuota(account, tokenID)
+	if err != nil || quota == nil {
+		return err
+	}
+	if quota.RemainingAt(currentEpoch).Cmp(value) < 0 {
+		return ErrMintQuotaExceeded
+	}
+
+	if quota.PerEpoch && quota.Epoch != currentEpoch {
+		quota.Epoch = currentEpoch
+		quota.Minted = big.NewInt(0)
+	}
+	quota.Minted.Add(quota.Minted, value)
+
+	return account.AccountDataHandler().SaveKeyValue(computeMintQuotaKey(tokenID), serializeMintQuota(quota))
+}
@@ -0,0 +1,182 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMECTSetMintQuotaFunc(t *testing.T) {
+	t.Parallel()
+
+	setMintQuota, err := NewMECTSetMintQuotaFunc(nil, trueHandler)
+	assert.Nil(t, setMintQuota)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
+
+	setMintQuota, err = NewMECTSetMintQuotaFunc(&mock.EnableEpochsHandlerStub{}, nil)
+	assert.Nil(t, setMintQuota)
+	assert.Equal(t, ErrNilActiveHandler, err)
+
+	setMintQuota, err = NewMECTSetMintQuotaFunc(&mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, err)
+	assert.False(t, setMintQuota.IsInterfaceNil())
+	assert.True(t, setMintQuota.IsActive())
+}
+
+func TestMectMintQuota_SerializeAndDeserialize(t *testing.T) {
+	t.Parallel()
+
+	tokenID := []byte("TKN-abcdef")
+	quota := &vmcommon.MECTMintQuota{TokenID: tokenID, Amount: big.NewInt(1000), Minted: big.NewInt(250), Epoch: 7, PerEpoch: true}
+
+	buff := serializeMintQuota(quota)
+	deserialized, err := deserializeMintQuota(tokenID, buff)
+	require.Nil(t, err)
+	assert.Equal(t, quota, deserialized)
+
+	_, err = deserializeMintQuota(tokenID, buff[:mintQuotaHeaderSize])
+	assert.Equal(t, ErrInvalidMintQuotaData, err)
+
+	_, err = deserializeMintQuota(tokenID, buff[:mintQuotaHeaderSize+lengthPrefixSize+1])
+	assert.Equal(t, ErrInvalidMintQuotaData, err)
+}
+
+func TestMectSetMintQuota_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	enableEpochsHandler := &mock.EnableEpochsHandlerStub{CurrentEpochField: 4}
+	setMintQuota, _ := NewMECTSetMintQuotaFunc(enableEpochsHandler, trueHandler)
+	minter := mock.NewUserAccount([]byte("bridge"))
+	tokenID := []byte("TKN-abcdef")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: []byte("caller"),
+			Arguments:  [][]byte{tokenID, big.NewInt(100).Bytes()},
+		},
+	}
+
+	_, err := setMintQuota.ProcessBuiltinFunction(nil, minter, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	_, err = setMintQuota.ProcessBuiltinFunction(nil, minter, input)
+	assert.Equal(t, ErrAddressIsNotMECTSystemSC, err)
+
+	input.CallerAddr = core.MECTSCAddress
+	_, err = setMintQuota.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrNilUserAccount, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(100).Bytes(), boolToSlice(true), {}}
+	_, err = setMintQuota.ProcessBuiltinFunction(nil, minter, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(100).Bytes(), boolToSlice(true)}
+	vmOutput, err := setMintQuota.ProcessBuiltinFunction(nil, minter, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	quota, err := getMintQuota(minter, tokenID)
+	require.Nil(t, err)
+	assert.Equal(t, &vmcommon.MECTMintQuota{TokenID: tokenID, Amount: big.NewInt(100), Minted: big.NewInt(0), Epoch: 4, PerEpoch: true}, quota)
+
+	input.Arguments = [][]byte{tokenID, {}}
+	_, err = setMintQuota.ProcessBuiltinFunction(nil, minter, input)
+	require.Nil(t, err)
+
+	quota, err = getMintQuota(minter, tokenID)
+	require.Nil(t, err)
+	assert.Nil(t, quota)
+}
+
+func TestMectLocalMint_ProcessBuiltinFunctionWithMintQuota(t *testing.T) {
+	t.Parallel()
+
+	enableEpochsHandler := &mock.EnableEpochsHandlerStub{IsMECTMintQuotaFlagEnabledField: true, CurrentEpochField: 4}
+	setMintQuota, _ := NewMECTSetMintQuotaFunc(enableEpochsHandler, trueHandler)
+	localMint, _ := NewMECTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, enableEpochsHandler)
+	minter := mock.NewUserAccount([]byte("bridge"))
+	tokenID := []byte("TKN-abcdef")
+
+	mintInput := func(value int64) *vmcommon.ContractCallInput {
+		return &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallValue:  big.NewInt(0),
+				CallerAddr: minter.AddressBytes(),
+				Arguments:  [][]byte{tokenID, big.NewInt(value).Bytes()},
+			},
+			RecipientAddr: minter.AddressBytes(),
+		}
+	}
+	setQuota := func(arguments ...[]byte) {
+		_, err := setMintQuota.ProcessBuiltinFunction(nil, minter, &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallValue:  big.NewInt(0),
+				CallerAddr: core.MECTSCAddress,
+				Arguments:  append([][]byte{tokenID}, arguments...),
+			},
+		})
+		require.Nil(t, err)
+	}
+
+	t.Run("without quota", func(t *testing.T) {
+		_, err := localMint.ProcessBuiltinFunction(minter, nil, mintInput(1000))
+		require.Nil(t, err)
+	})
+	t.Run("absolute quota", func(t *testing.T) {
+		setQuota(big.NewInt(100).Bytes())
+
+		_, err := localMint.ProcessBuiltinFunction(minter, nil, mintInput(60))
+		require.Nil(t, err)
+
+		_, err = localMint.ProcessBuiltinFunction(minter, nil, mintInput(41))
+		assert.Equal(t, ErrMintQuotaExceeded, err)
+
+		enableEpochsHandler.CurrentEpochField = 5
+		_, err = localMint.ProcessBuiltinFunction(minter, nil, mintInput(41))
+		assert.Equal(t, ErrMintQuotaExceeded, err)
+
+		_, err = localMint.ProcessBuiltinFunction(minter, nil, mintInput(40))
+		require.Nil(t, err)
+
+		quota, _ := getMintQuota(minter, tokenID)
+		assert.Equal(t, 0, quota.RemainingAt(5).Sign())
+	})
+	t.Run("quota per epoch", func(t *testing.T) {
+		enableEpochsHandler.CurrentEpochField = 6
+		setQuota(big.NewInt(100).Bytes(), boolToSlice(true))
+
+		_, err := localMint.ProcessBuiltinFunction(minter, nil, mintInput(100))
+		require.Nil(t, err)
+
+		_, err = localMint.ProcessBuiltinFunction(minter, nil, mintInput(1))
+		assert.Equal(t, ErrMintQuotaExceeded, err)
+
+		enableEpochsHandler.CurrentEpochField = 7
+		_, err = localMint.ProcessBuiltinFunction(minter, nil, mintInput(30))
+		require.Nil(t, err)
+
+		quota, _ := getMintQuota(minter, tokenID)
+		assert.Equal(t, uint32(7), quota.Epoch)
+		assert.Equal(t, big.NewInt(70), quota.RemainingAt(7))
+		assert.Equal(t, big.NewInt(100), quota.RemainingAt(8))
+	})
+}
+
+func TestGetMintQuota_ReadErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	quota, err := getMintQuota(mock.NewUserAccount([]byte("minter")), []byte("TKN-abcdef"))
+	assert.Nil(t, err)
+	assert.Nil(t, quota)
+
+	retrieveErr := errors.New("retrieve error")
+	quota, err = getMintQuota(createAccountWithRetrieveValueError(retrieveErr), []byte("TKN-abcdef"))
+	assert.Nil(t, quota)
+	assert.Equal(t, retrieveErr, err)
+}
@@ -6,7 +6,6 @@ import (
 	"sync"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
@@ -15,15 +14,14 @@ const maxLenForAddNFTQuantity = 32
 
 type mectNFTAddQuantity struct {
 	baseAlwaysActive
+	baseSupplyLedgerHolder
 	keyPrefix             []byte
 	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler
 	rolesHandler          vmcommon.MECTRoleHandler
 	mectStorageHandler    vmcommon.MECTNFTStorageHandler
 	funcGasCost           uint64
 	mutExecution          sync.RWMutex
-
-	valueLengthCheckEnableEpoch uint32
-	flagValueLengthCheck        atomic.Flag
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
 }
 
 // NewMECTNFTAddQuantityFunc returns the mect NFT add quantity built-in function component
@@ -32,8 +30,7 @@ func NewMECTNFTAddQuantityFunc(
 	mectStorageHandler vmcommon.MECTNFTStorageHandler,
 	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler,
 	rolesHandler vmcommon.MECTRoleHandler,
-	valueLengthCheckEnableEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
 ) (*mectNFTAddQuantity, error) {
 	if check.IfNil(mectStorageHandler) {
 		return nil, ErrNilMECTNFTStorageHandler
@@ -44,31 +41,24 @@ func NewMECTNFTAddQuantityFunc(
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
 	}
 
 	e := &mectNFTAddQuantity{
-		keyPrefix:                   []byte(baseMECTKeyPrefix),
-		globalSettingsHandler:       globalSettingsHandler,
-		rolesHandler:                rolesHandler,
-		funcGasCost:                 funcGasCost,
-		mutExecution:                sync.RWMutex{},
-		mectStorageHandler:          mectStorageHandler,
-		valueLengthCheckEnableEpoch: valueLengthCheckEnableEpoch,
+		baseSupplyLedgerHolder: newBaseSupplyLedgerHolder(),
+		keyPrefix:              []byte(baseMECTKeyPrefix),
+		globalSettingsHandler:  globalSettingsHandler,
+		rolesHandler:           rolesHandler,
+		funcGasCost:            funcGasCost,
+		mutExecution:           sync.RWMutex{},
+		mectStorageHandler:     mectStorageHandler,
+		enableEpochsHandler:    enableEpochsHandler,
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (e *mectNFTAddQuantity) EpochConfirmed(epoch uint32, _ uint64) {
-	e.flagValueLengthCheck.SetValue(epoch >= e.valueLengthCheckEnableEpoch)
-	log.Debug("MECT Add Quantity value length check", "enabled", e.flagValueLengthCheck.IsSet())
-}
-
 // SetNewGasConfig is called whenever gas cost is changed
 func (e *mectNFTAddQuantity) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 	if gasCost == nil {
@@ -115,7 +105,7 @@ func (e *mectNFTAddQuantity) ProcessBuiltinFunction(
 		return nil, ErrNFTDoesNotHaveMetadata
 	}
 
-	if e.flagValueLengthCheck.IsSet() && len(vmInput.Arguments[2]) > maxLenForAddNFTQuantity {
+	if e.enableEpochsHandler.IsValueLengthCheckFlagEnabled() && len(vmInput.Arguments[2]) > maxLenForAddNFTQuantity {
 		return nil, fmt.Errorf("%w max length for add nft quantity is %d", ErrInvalidArguments, maxLenForAddNFTQuantity)
 	}
 
@@ -130,6 +120,10 @@ func (e *mectNFTAddQuantity) ProcessBuiltinFunction(
 	if err != nil {
 		return nil, err
 	}
+	err = e.supplyLedger.AddMinted(vmInput.Arguments[0], nonce, value)
+	if err != nil {
+		return nil, err
+	}
 
 	vmOutput := &vmcommon.VMOutput{
 		ReturnCode:   vmcommon.Ok,
@@ -141,6 +135,18 @@ func (e *mectNFTAddQuantity) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// EstimateGas returns the gas consumed by the MECT NFT add quantity function
+func (e *mectNFTAddQuantity) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return e.funcGasCost, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectNFTAddQuantity) IsInterfaceNil() bool {
 	return e == nil
@@ -18,27 +18,27 @@ func TestNewMECTNFTAddQuantityFunc(t *testing.T) {
 	t.Parallel()
 
 	// nil marshaller
-	eqf, err := NewMECTNFTAddQuantityFunc(10, nil, nil, nil, 0, nil)
+	eqf, err := NewMECTNFTAddQuantityFunc(10, nil, nil, nil, &mock.EnableEpochsHandlerStub{})
 	require.True(t, check.IfNil(eqf))
 	require.Equal(t, ErrNilMECTNFTStorageHandler, err)
 
 	// nil pause handler
-	eqf, err = NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), nil, nil, 0, nil)
+	eqf, err = NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), nil, nil, &mock.EnableEpochsHandlerStub{})
 	require.True(t, check.IfNil(eqf))
 	require.Equal(t, ErrNilGlobalSettingsHandler, err)
 
 	// nil roles handler
-	eqf, err = NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, nil, 0, nil)
+	eqf, err = NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, nil, &mock.EnableEpochsHandlerStub{})
 	require.True(t, check.IfNil(eqf))
 	require.Equal(t, ErrNilRolesHandler, err)
 
-	// nil epoch handler
-	eqf, err = NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, nil)
+	// nil enable epochs handler
+	eqf, err = NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, nil)
 	require.True(t, check.IfNil(eqf))
-	require.Equal(t, ErrNilEpochHandler, err)
+	require.Equal(t, ErrNilEnableEpochsHandler, err)
 
 	// should work
-	eqf, err = NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	eqf, err = NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 	require.False(t, check.IfNil(eqf))
 	require.NoError(t, err)
 }
@@ -47,7 +47,7 @@ func TestMectNFTAddQuantity_SetNewGasConfig_NilGasCost(t *testing.T) {
 	t.Parallel()
 
 	defaultGasCost := uint64(10)
-	eqf, _ := NewMECTNFTAddQuantityFunc(defaultGasCost, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(defaultGasCost, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 
 	eqf.SetNewGasConfig(nil)
 	require.Equal(t, defaultGasCost, eqf.funcGasCost)
@@ -58,7 +58,7 @@ func TestMectNFTAddQuantity_SetNewGasConfig_ShouldWork(t *testing.T) {
 
 	defaultGasCost := uint64(10)
 	newGasCost := uint64(37)
-	eqf, _ := NewMECTNFTAddQuantityFunc(defaultGasCost, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(defaultGasCost, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 
 	eqf.SetNewGasConfig(
 		&vmcommon.GasCost{
@@ -74,7 +74,7 @@ func TestMectNFTAddQuantity_SetNewGasConfig_ShouldWork(t *testing.T) {
 func TestMectNFTAddQuantity_ProcessBuiltinFunctionErrorOnCheckMECTNFTCreateBurnAddInput(t *testing.T) {
 	t.Parallel()
 
-	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 
 	// nil vm input
 	output, err := eqf.ProcessBuiltinFunction(mock.NewAccountWrapMock([]byte("addr")), nil, nil)
@@ -175,7 +175,7 @@ func TestMectNFTAddQuantity_ProcessBuiltinFunctionErrorOnCheckMECTNFTCreateBurnA
 func TestMectNFTAddQuantity_ProcessBuiltinFunctionInvalidNumberOfArguments(t *testing.T) {
 	t.Parallel()
 
-	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 	output, err := eqf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -202,7 +202,7 @@ func TestMectNFTAddQuantity_ProcessBuiltinFunctionCheckAllowedToExecuteError(t *
 			return localErr
 		},
 	}
-	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, rolesHandler, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, rolesHandler, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 	output, err := eqf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -224,7 +224,7 @@ func TestMectNFTAddQuantity_ProcessBuiltinFunctionCheckAllowedToExecuteError(t *
 func TestMectNFTAddQuantity_ProcessBuiltinFunctionNewSenderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 	output, err := eqf.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -248,7 +248,7 @@ func TestMectNFTAddQuantity_ProcessBuiltinFunctionMetaDataMissing(t *testing.T)
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	mectData := &mect.MECToken{}
@@ -282,7 +282,7 @@ func TestMectNFTAddQuantity_ProcessBuiltinFunctionShouldErrOnSaveBecauseTokenIsP
 		},
 	}
 
-	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandlerWithArgs(globalSettingsHandler, &mock.AccountsStub{}), globalSettingsHandler, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandlerWithArgs(globalSettingsHandler, &mock.AccountsStub{}), globalSettingsHandler, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	mectData := &mect.MECToken{
@@ -330,7 +330,7 @@ func TestMectNFTAddQuantity_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 			return nil
 		},
 	}
-	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, mectRoleHandler, 0, &mock.EpochNotifierStub{})
+	eqf, _ := NewMECTNFTAddQuantityFunc(10, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, mectRoleHandler, &mock.EnableEpochsHandlerStub{IsValueLengthCheckFlagEnabledField: true})
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	mectData := &mect.MECToken{
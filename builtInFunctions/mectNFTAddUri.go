@@ -5,16 +5,15 @@ import (
 	"sync"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
 
 type mectNFTAddUri struct {
-	*baseEnabled
+	*baseActiveHandler
 	keyPrefix             []byte
 	mectStorageHandler    vmcommon.MECTNFTStorageHandler
-	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
 	rolesHandler          vmcommon.MECTRoleHandler
 	gasConfig             vmcommon.BaseOperationCost
 	funcGasCost           uint64
@@ -26,10 +25,9 @@ func NewMECTNFTAddUriFunc(
 	funcGasCost uint64,
 	gasConfig vmcommon.BaseOperationCost,
 	mectStorageHandler vmcommon.MECTNFTStorageHandler,
-	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler,
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler,
 	rolesHandler vmcommon.MECTRoleHandler,
-	activationEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	activeHandler func() bool,
 ) (*mectNFTAddUri, error) {
 	if check.IfNil(mectStorageHandler) {
 		return nil, ErrNilMECTNFTStorageHandler
@@ -40,8 +38,8 @@ func NewMECTNFTAddUriFunc(
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
 	}
 
 	e := &mectNFTAddUri{
@@ -54,14 +52,10 @@ func NewMECTNFTAddUriFunc(
 		rolesHandler:          rolesHandler,
 	}
 
-	e.baseEnabled = &baseEnabled{
-		function:        core.BuiltInFunctionMECTNFTAddURI,
-		activationEpoch: activationEpoch,
-		flagActivated:   atomic.Flag{},
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
@@ -108,6 +102,9 @@ func (e *mectNFTAddUri) ProcessBuiltinFunction(
 	}
 
 	mectTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	if e.globalSettingsHandler.IsMetadataFrozen(mectTokenKey) {
+		return nil, ErrMECTMetadataIsFrozen
+	}
 	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
 	if nonce == 0 {
 		return nil, ErrNFTDoesNotHaveMetadata
@@ -143,6 +140,21 @@ func (e *mectNFTAddUri) getGasCostForURIStore(vmInput *vmcommon.ContractCallInpu
 	return uint64(lenURIs) * e.gasConfig.StorePerByte
 }
 
+// EstimateGas returns the gas consumed by the MECT NFT add uris function
+func (e *mectNFTAddUri) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+	if len(vmInput.Arguments) < 3 {
+		return 0, ErrInvalidArguments
+	}
+
+	return e.funcGasCost + e.getGasCostForURIStore(vmInput), nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectNFTAddUri) IsInterfaceNil() bool {
 	return e == nil
@@ -11,6 +11,7 @@ import (
 
 type mectNFTBurn struct {
 	baseAlwaysActive
+	baseSupplyLedgerHolder
 	keyPrefix             []byte
 	mectStorageHandler    vmcommon.MECTNFTStorageHandler
 	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
@@ -37,12 +38,13 @@ func NewMECTNFTBurnFunc(
 	}
 
 	e := &mectNFTBurn{
-		keyPrefix:             []byte(baseMECTKeyPrefix),
-		mectStorageHandler:    mectStorageHandler,
-		globalSettingsHandler: globalSettingsHandler,
-		rolesHandler:          rolesHandler,
-		funcGasCost:           funcGasCost,
-		mutExecution:          sync.RWMutex{},
+		baseSupplyLedgerHolder: newBaseSupplyLedgerHolder(),
+		keyPrefix:              []byte(baseMECTKeyPrefix),
+		mectStorageHandler:     mectStorageHandler,
+		globalSettingsHandler:  globalSettingsHandler,
+		rolesHandler:           rolesHandler,
+		funcGasCost:            funcGasCost,
+		mutExecution:           sync.RWMutex{},
 	}
 
 	return e, nil
@@ -110,6 +112,10 @@ func (e *mectNFTBurn) ProcessBuiltinFunction(
 	if err != nil {
 		return nil, err
 	}
+	err = e.supplyLedger.AddBurned(vmInput.Arguments[0], nonce, quantityToBurn)
+	if err != nil {
+		return nil, err
+	}
 
 	vmOutput := &vmcommon.VMOutput{
 		ReturnCode:   vmcommon.Ok,
@@ -131,6 +137,18 @@ func (e *mectNFTBurn) isAllowedToBurn(acntSnd vmcommon.UserAccountHandler, token
 	return e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.MECTRoleNFTBurn))
 }
 
+// EstimateGas returns the gas consumed by the MECT NFT burn function
+func (e *mectNFTBurn) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return e.funcGasCost, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectNFTBurn) IsInterfaceNil() bool {
 	return e == nil
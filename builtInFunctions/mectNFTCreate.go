@@ -7,7 +7,6 @@ import (
 	"sync"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/data/mect"
 	"github.com/ME-MotherEarth/me-core/data/vm"
@@ -18,6 +17,7 @@ var noncePrefix = []byte(core.MotherEarthProtectedKeyPrefix + core.MECTNFTLatest
 
 type mectNFTCreate struct {
 	baseAlwaysActive
+	baseSupplyLedgerHolder
 	keyPrefix             []byte
 	accounts              vmcommon.AccountsAdapter
 	marshaller            vmcommon.Marshalizer
@@ -27,9 +27,7 @@ type mectNFTCreate struct {
 	gasConfig             vmcommon.BaseOperationCost
 	mectStorageHandler    vmcommon.MECTNFTStorageHandler
 	mutExecution          sync.RWMutex
-
-	valueLengthCheckEnableEpoch uint32
-	flagValueLengthCheck        atomic.Flag
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
 }
 
 // NewMECTNFTCreateFunc returns the mect NFT create built-in function component
@@ -41,8 +39,7 @@ func NewMECTNFTCreateFunc(
 	rolesHandler vmcommon.MECTRoleHandler,
 	mectStorageHandler vmcommon.MECTNFTStorageHandler,
 	accounts vmcommon.AccountsAdapter,
-	valueLengthCheckEnableEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
 ) (*mectNFTCreate, error) {
 	if check.IfNil(marshaller) {
 		return nil, ErrNilMarshalizer
@@ -56,37 +53,30 @@ func NewMECTNFTCreateFunc(
 	if check.IfNil(mectStorageHandler) {
 		return nil, ErrNilMECTNFTStorageHandler
 	}
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
 	}
 	if check.IfNil(accounts) {
 		return nil, ErrNilAccountsAdapter
 	}
 
 	e := &mectNFTCreate{
-		keyPrefix:                   []byte(baseMECTKeyPrefix),
-		marshaller:                  marshaller,
-		globalSettingsHandler:       globalSettingsHandler,
-		rolesHandler:                rolesHandler,
-		funcGasCost:                 funcGasCost,
-		gasConfig:                   gasConfig,
-		mectStorageHandler:          mectStorageHandler,
-		mutExecution:                sync.RWMutex{},
-		valueLengthCheckEnableEpoch: valueLengthCheckEnableEpoch,
-		accounts:                    accounts,
+		baseSupplyLedgerHolder: newBaseSupplyLedgerHolder(),
+		keyPrefix:              []byte(baseMECTKeyPrefix),
+		marshaller:             marshaller,
+		globalSettingsHandler:  globalSettingsHandler,
+		rolesHandler:           rolesHandler,
+		funcGasCost:            funcGasCost,
+		gasConfig:              gasConfig,
+		mectStorageHandler:     mectStorageHandler,
+		mutExecution:           sync.RWMutex{},
+		enableEpochsHandler:    enableEpochsHandler,
+		accounts:               accounts,
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (e *mectNFTCreate) EpochConfirmed(epoch uint32, _ uint64) {
-	e.flagValueLengthCheck.SetValue(epoch >= e.valueLengthCheckEnableEpoch)
-	log.Debug("MECT NFT Create quantity value length check", "enabled", e.flagValueLengthCheck.IsSet())
-}
-
 // SetNewGasConfig is called whenever gas cost is changed
 func (e *mectNFTCreate) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 	if gasCost == nil {
@@ -159,11 +149,7 @@ func (e *mectNFTCreate) ProcessBuiltinFunction(
 		return nil, err
 	}
 
-	totalLength := uint64(0)
-	for _, arg := range vmInput.Arguments {
-		totalLength += uint64(len(arg))
-	}
-	gasToUse := totalLength*e.gasConfig.StorePerByte + e.funcGasCost
+	gasToUse := e.computeGasToUse(vmInput)
 	if vmInput.GasProvided < gasToUse {
 		return nil, ErrNotEnoughGas
 	}
@@ -184,7 +170,7 @@ func (e *mectNFTCreate) ProcessBuiltinFunction(
 			return nil, err
 		}
 	}
-	if e.flagValueLengthCheck.IsSet() && len(vmInput.Arguments[1]) > maxLenForAddNFTQuantity {
+	if e.enableEpochsHandler.IsValueLengthCheckFlagEnabled() && len(vmInput.Arguments[1]) > maxLenForAddNFTQuantity {
 		return nil, fmt.Errorf("%w max length for quantity in nft create is %d", ErrInvalidArguments, maxLenForAddNFTQuantity)
 	}
 
@@ -211,6 +197,10 @@ func (e *mectNFTCreate) ProcessBuiltinFunction(
 	if err != nil {
 		return nil, err
 	}
+	err = e.supplyLedger.AddMinted(vmInput.Arguments[0], nextNonce, quantity)
+	if err != nil {
+		return nil, err
+	}
 
 	err = saveLatestNonce(accountWithRoles, tokenID, nextNonce)
 	if err != nil {
@@ -302,6 +292,27 @@ func getNonceKey(tokenID []byte) []byte {
 	return append(noncePrefix, tokenID...)
 }
 
+// EstimateGas returns the gas consumed by the MECT NFT create function
+func (e *mectNFTCreate) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return e.computeGasToUse(vmInput), nil
+}
+
+func (e *mectNFTCreate) computeGasToUse(vmInput *vmcommon.ContractCallInput) uint64 {
+	totalLength := uint64(0)
+	for _, arg := range vmInput.Arguments {
+		totalLength += uint64(len(arg))
+	}
+
+	return totalLength*e.gasConfig.StorePerByte + e.funcGasCost
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectNFTCreate) IsInterfaceNil() bool {
 	return e == nil
@@ -0,0 +1,349 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	"github.com/ME-MotherEarth/me-core/data/vm"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const (
+	minArgsMECTNFTCreateBatch = 8
+	argumentsPerBatchItem     = 6
+)
+
+type mectNFTCreateBatch struct {
+	*baseActiveHandler
+	baseSupplyLedgerHolder
+	keyPrefix             []byte
+	accounts              vmcommon.AccountsAdapter
+	marshaller            vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler
+	rolesHandler          vmcommon.MECTRoleHandler
+	funcGasCost           uint64
+	gasConfig             vmcommon.BaseOperationCost
+	mectStorageHandler    vmcommon.MECTNFTStorageHandler
+	mutExecution          sync.RWMutex
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
+}
+
+// NewMECTNFTCreateBatchFunc returns the mect NFT create batch built-in function component
+func NewMECTNFTCreateBatchFunc(
+	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
+	marshaller vmcommon.Marshalizer,
+	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler,
+	rolesHandler vmcommon.MECTRoleHandler,
+	mectStorageHandler vmcommon.MECTNFTStorageHandler,
+	accounts vmcommon.AccountsAdapter,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	activeHandler func() bool,
+) (*mectNFTCreateBatch, error) {
+	if check.IfNil(marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if check.IfNil(mectStorageHandler) {
+		return nil, ErrNilMECTNFTStorageHandler
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
+
+	e := &mectNFTCreateBatch{
+		baseSupplyLedgerHolder: newBaseSupplyLedgerHolder(),
+		keyPrefix:              []byte(baseMECTKeyPrefix),
+		marshaller:             marshaller,
+		globalSettingsHandler:  globalSettingsHandler,
+		rolesHandler:           rolesHandler,
+		funcGasCost:            funcGasCost,
+		gasConfig:              gasConfig,
+		mectStorageHandler:     mectStorageHandler,
+		mutExecution:           sync.RWMutex{},
+		enableEpochsHandler:    enableEpochsHandler,
+		accounts:               accounts,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectNFTCreateBatch) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.MECTNFTCreateBatch
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves MECT NFT create batch function call
+// Requires at least 8 arguments:
+// arg0 - token identifier
+// arg1 - number of NFTs to create
+// list of (initial quantity - NFT name - royalties - hash - attributes - number of URIs - URIs (minimum 1))
+// if called with ExecOnDestByCaller, the last argument is the address which holds the roles
+// The role checks and the latest nonce update are done once for the whole batch and the range of created nonces is
+// returned as (first nonce - last nonce).
+func (e *mectNFTCreateBatch) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkMECTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+
+	minNumOfArgs := minArgsMECTNFTCreateBatch
+	if vmInput.CallType == vm.ExecOnDestByCaller {
+		minNumOfArgs++
+	}
+	lenArgs := len(vmInput.Arguments)
+	if lenArgs < minNumOfArgs {
+		return nil, fmt.Errorf("%w, wrong number of arguments", ErrInvalidArguments)
+	}
+
+	accountWithRoles := acntSnd
+	itemsArguments := vmInput.Arguments[2:]
+	if vmInput.CallType == vm.ExecOnDestByCaller {
+		scAddressWithRoles := vmInput.Arguments[lenArgs-1]
+		itemsArguments = vmInput.Arguments[2 : lenArgs-1]
+
+		if len(scAddressWithRoles) != len(vmInput.CallerAddr) {
+			return nil, ErrInvalidAddressLength
+		}
+		if bytes.Equal(scAddressWithRoles, vmInput.CallerAddr) {
+			return nil, ErrInvalidRcvAddr
+		}
+
+		accountWithRoles, err = e.getAccount(scAddressWithRoles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	numOfItems := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if numOfItems == 0 {
+		return nil, fmt.Errorf("%w, 0 NFTs to create", ErrInvalidArguments)
+	}
+	if numOfItems > uint64(len(itemsArguments)/argumentsPerBatchItem) {
+		return nil, fmt.Errorf("%w, invalid number of arguments", ErrInvalidArguments)
+	}
+
+	gasToUse := e.computeGasToUse(vmInput, numOfItems)
+	if vmInput.GasProvided < gasToUse {
+		return nil, ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = e.rolesHandler.CheckAllowedToExecute(accountWithRoles, tokenID, []byte(core.MECTRoleNFTCreate))
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := getLatestNonce(accountWithRoles, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	listMectData, err := e.parseBatchItems(itemsArguments, numOfItems, nonce, vmInput.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.checkAddQuantityRole(accountWithRoles, tokenID, listMectData)
+	if err != nil {
+		return nil, err
+	}
+
+	mectTokenKey := append(e.keyPrefix, tokenID...)
+	totalQuantity := big.NewInt(0)
+	logTopics := make([][]byte, 0, len(listMectData))
+	for _, mectData := range listMectData {
+		nextNonce := mectData.TokenMetaData.Nonce
+		quantity := big.NewInt(0).Set(mectData.Value)
+		_, err = e.mectStorageHandler.SaveMECTNFTToken(accountWithRoles.AddressBytes(), accountWithRoles, mectTokenKey, nextNonce, mectData, true, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+		err = e.mectStorageHandler.AddToLiquiditySystemAcc(mectTokenKey, nextNonce, quantity)
+		if err != nil {
+			return nil, err
+		}
+		err = e.supplyLedger.AddMinted(tokenID, nextNonce, quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		totalQuantity.Add(totalQuantity, quantity)
+		mectDataBytes, errMarshal := e.marshaller.Marshal(mectData)
+		if errMarshal != nil {
+			log.Warn("mectNFTCreateBatch.ProcessBuiltinFunction: cannot marshall mect data for log", "error", errMarshal)
+		}
+		logTopics = append(logTopics, mectDataBytes)
+	}
+
+	firstNonce := nonce + 1
+	lastNonce := nonce + numOfItems
+	err = saveLatestNonce(accountWithRoles, tokenID, lastNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if vmInput.CallType == vm.ExecOnDestByCaller {
+		err = e.accounts.SaveAccount(accountWithRoles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	firstNonceBytes := big.NewInt(0).SetUint64(firstNonce).Bytes()
+	lastNonceBytes := big.NewInt(0).SetUint64(lastNonce).Bytes()
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		ReturnData:   [][]byte{firstNonceBytes, lastNonceBytes},
+	}
+
+	logArgs := append([][]byte{vmInput.CallerAddr, lastNonceBytes}, logTopics...)
+	addMECTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionMECTNFTCreateBatch), tokenID, firstNonce, totalQuantity, logArgs...)
+
+	return vmOutput, nil
+}
+
+func (e *mectNFTCreateBatch) parseBatchItems(
+	arguments [][]byte,
+	numOfItems uint64,
+	latestNonce uint64,
+	creator []byte,
+) ([]*mect.MECToken, error) {
+	listMectData := make([]*mect.MECToken, 0, numOfItems)
+	index := 0
+	for i := uint64(0); i < numOfItems; i++ {
+		if index+argumentsPerBatchItem > len(arguments) {
+			return nil, fmt.Errorf("%w, invalid number of arguments", ErrInvalidArguments)
+		}
+
+		quantity := big.NewInt(0).SetBytes(arguments[index])
+		if quantity.Cmp(zero) <= 0 {
+			return nil, fmt.Errorf("%w, invalid quantity for item %d", ErrInvalidArguments, i)
+		}
+		if e.enableEpochsHandler.IsValueLengthCheckFlagEnabled() && len(arguments[index]) > maxLenForAddNFTQuantity {
+			return nil, fmt.Errorf("%w max length for quantity in nft create is %d", ErrInvalidArguments, maxLenForAddNFTQuantity)
+		}
+		royalties := big.NewInt(0).SetBytes(arguments[index+2]).Uint64()
+		if royalties > uint64(core.MaxRoyalty) {
+			return nil, fmt.Errorf("%w, invalid max royality value for item %d", ErrInvalidArguments, i)
+		}
+		numOfURIs := big.NewInt(0).SetBytes(arguments[index+5]).Uint64()
+		urisStartIndex := index + argumentsPerBatchItem
+		if numOfURIs == 0 || numOfURIs > uint64(len(arguments)-urisStartIndex) {
+			return nil, fmt.Errorf("%w, invalid number of URIs for item %d", ErrInvalidArguments, i)
+		}
+
+		listMectData = append(listMectData, &mect.MECToken{
+			Type:  uint32(core.NonFungible),
+			Value: quantity,
+			TokenMetaData: &mect.MetaData{
+				Nonce:      latestNonce + i + 1,
+				Name:       arguments[index+1],
+				Creator:    creator,
+				Royalties:  uint32(royalties),
+				Hash:       arguments[index+3],
+				Attributes: arguments[index+4],
+				URIs:       arguments[urisStartIndex : urisStartIndex+int(numOfURIs)],
+			},
+		})
+		index = urisStartIndex + int(numOfURIs)
+	}
+	if index != len(arguments) {
+		return nil, fmt.Errorf("%w, invalid number of arguments", ErrInvalidArguments)
+	}
+
+	return listMectData, nil
+}
+
+func (e *mectNFTCreateBatch) checkAddQuantityRole(accountWithRoles vmcommon.UserAccountHandler, tokenID []byte, listMectData []*mect.MECToken) error {
+	for _, mectData := range listMectData {
+		if mectData.Value.Cmp(big.NewInt(1)) > 0 {
+			return e.rolesHandler.CheckAllowedToExecute(accountWithRoles, tokenID, []byte(core.MECTRoleNFTAddQuantity))
+		}
+	}
+
+	return nil
+}
+
+func (e *mectNFTCreateBatch) getAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	account, err := e.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+// EstimateGas returns the gas consumed by the MECT NFT create batch function
+func (e *mectNFTCreateBatch) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+	if len(vmInput.Arguments) < 2 {
+		return 0, ErrInvalidArguments
+	}
+
+	numOfItems := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if numOfItems > uint64(len(vmInput.Arguments)/argumentsPerBatchItem) {
+		return 0, ErrInvalidArguments
+	}
+
+	return e.computeGasToUse(vmInput, numOfItems), nil
+}
+
+// computeGasToUse charges the function cost for every created NFT and the storage of all the arguments per byte
+func (e *mectNFTCreateBatch) computeGasToUse(vmInput *vmcommon.ContractCallInput, numOfItems uint64) uint64 {
+	totalLength := uint64(0)
+	for _, arg := range vmInput.Arguments {
+		totalLength += uint64(len(arg))
+	}
+
+	return totalLength*e.gasConfig.StorePerByte + numOfItems*e.funcGasCost
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectNFTCreateBatch) IsInterfaceNil() bool {
+	return e == nil
+}
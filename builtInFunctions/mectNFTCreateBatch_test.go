@@ -0,0 +1,289 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	"github.com/ME-MotherEarth/me-core/data/vm"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNftCreateBatch(mectDataStorage *mectDataStorage, rolesHandler vmcommon.MECTRoleHandler) *mectNFTCreateBatch {
+	nftCreateBatch, _ := NewMECTNFTCreateBatchFunc(
+		10,
+		vmcommon.BaseOperationCost{StorePerByte: 1},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		rolesHandler,
+		mectDataStorage,
+		mectDataStorage.accounts,
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
+		trueHandler,
+	)
+
+	return nftCreateBatch
+}
+
+func createNftCreateBatchInput(caller []byte, token string, items ...[][]byte) *vmcommon.ContractCallInput {
+	arguments := [][]byte{[]byte(token), big.NewInt(int64(len(items))).Bytes()}
+	for _, item := range items {
+		arguments = append(arguments, item...)
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 10000,
+			Arguments:   arguments,
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func createNftCreateBatchItem(quantity int64, name string, royalties int64, uris ...string) [][]byte {
+	item := [][]byte{
+		big.NewInt(quantity).Bytes(),
+		[]byte(name),
+		big.NewInt(royalties).Bytes(),
+		[]byte("hash-" + name),
+		[]byte("attributes-" + name),
+		big.NewInt(int64(len(uris))).Bytes(),
+	}
+	for _, uri := range uris {
+		item = append(item, []byte(uri))
+	}
+
+	return item
+}
+
+func TestNewMECTNFTCreateBatchFunc(t *testing.T) {
+	t.Parallel()
+
+	mectDataStorage := createNewMECTDataStorageHandler()
+	nftCreateBatch, err := NewMECTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, nil, &mock.GlobalSettingsHandlerStub{},
+		&mock.MECTRoleHandlerStub{}, mectDataStorage, mectDataStorage.accounts, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	nftCreateBatch, err = NewMECTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{},
+		nil, mectDataStorage, mectDataStorage.accounts, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilRolesHandler, err)
+
+	nftCreateBatch, err = NewMECTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{},
+		&mock.MECTRoleHandlerStub{}, nil, mectDataStorage.accounts, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilMECTNFTStorageHandler, err)
+
+	nftCreateBatch, err = NewMECTNFTCreateBatchFunc(0, vmcommon.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{},
+		&mock.MECTRoleHandlerStub{}, mectDataStorage, mectDataStorage.accounts, &mock.EnableEpochsHandlerStub{}, nil)
+	assert.True(t, check.IfNil(nftCreateBatch))
+	assert.Equal(t, ErrNilActiveHandler, err)
+
+	nftCreateBatch = createNftCreateBatch(mectDataStorage, &mock.MECTRoleHandlerStub{})
+	assert.False(t, check.IfNil(nftCreateBatch))
+	assert.True(t, nftCreateBatch.IsActive())
+
+	gasCost := createMockGasCost()
+	nftCreateBatch.SetNewGasConfig(&gasCost)
+	assert.Equal(t, gasCost.BuiltInCost.MECTNFTCreateBatch, nftCreateBatch.funcGasCost)
+}
+
+func TestMectNFTCreateBatch_ProcessBuiltinFunctionInvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	mectDataStorage := createNewMECTDataStorageHandler()
+	nftCreateBatch := createNftCreateBatch(mectDataStorage, &mock.MECTRoleHandlerStub{})
+	sender := mock.NewUserAccount(bytes.Repeat([]byte{1}, 32))
+	_ = sender.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+
+	_, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	vmInput := createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(1, "a", 0, "uri"))
+	vmInput.RecipientAddr = bytes.Repeat([]byte{2}, 32)
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+
+	vmInput = createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(1, "a", 0, "uri"))
+	vmInput.Arguments = vmInput.Arguments[:minArgsMECTNFTCreateBatch-1]
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(1, "a", 0, "uri"))
+	vmInput.Arguments[1] = big.NewInt(0).Bytes()
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput.Arguments[1] = big.NewInt(2).Bytes()
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(1, "a", 0, "uri"))
+	vmInput.GasProvided = 20
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Equal(t, ErrNotEnoughGas, err)
+
+	vmInput = createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(1, "a", 0))
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(0, "a", 0, "uri"))
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(1, "a", int64(core.MaxRoyalty)+1, "uri"))
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(1, "a", 0, "uri"))
+	vmInput.Arguments = append(vmInput.Arguments, []byte("extra"))
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+
+	vmInput = createNftCreateBatchInput(sender.AddressBytes(), "token", createNftCreateBatchItem(1, "a", 0, "uri"))
+	vmInput.Arguments[len(vmInput.Arguments)-2] = big.NewInt(5).Bytes()
+	_, err = nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, ErrInvalidArguments))
+}
+
+func TestMectNFTCreateBatch_ProcessBuiltinFunctionNotAllowedToExecute(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	mectDataStorage := createNewMECTDataStorageHandler()
+	nftCreateBatch := createNftCreateBatch(mectDataStorage, &mock.MECTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
+			if bytes.Equal(action, []byte(core.MECTRoleNFTAddQuantity)) {
+				return expectedErr
+			}
+			return nil
+		},
+	})
+	sender := mock.NewUserAccount(bytes.Repeat([]byte{1}, 32))
+	_ = sender.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+
+	vmInput := createNftCreateBatchInput(sender.AddressBytes(), "token",
+		createNftCreateBatchItem(1, "a", 0, "uri"),
+		createNftCreateBatchItem(2, "b", 0, "uri"),
+	)
+	_, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.Equal(t, expectedErr, err)
+
+	latestNonce, _ := getLatestNonce(sender, []byte("token"))
+	assert.Zero(t, latestNonce)
+}
+
+func TestMectNFTCreateBatch_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mectDataStorage := createNewMECTDataStorageHandler()
+	numRoleChecks := make(map[string]int)
+	nftCreateBatch := createNftCreateBatch(mectDataStorage, &mock.MECTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
+			numRoleChecks[string(action)]++
+			return nil
+		},
+	})
+	supplyLedger := &mock.SupplyLedgerStub{}
+	minted := big.NewInt(0)
+	supplyLedger.AddMintedCalled = func(tokenID []byte, nonce uint64, value *big.Int) error {
+		minted.Add(minted, value)
+		return nil
+	}
+	require.Nil(t, nftCreateBatch.SetSupplyLedger(supplyLedger))
+
+	address := bytes.Repeat([]byte{1}, 32)
+	sender := mock.NewUserAccount(address)
+	token := "token"
+	_ = saveLatestNonce(sender, []byte(token), 4)
+
+	items := [][][]byte{
+		createNftCreateBatchItem(1, "first", 100, "uri1"),
+		createNftCreateBatchItem(5, "second", 200, "uri2", "uri3"),
+		createNftCreateBatchItem(1, "third", 0, "uri4"),
+	}
+	vmInput := createNftCreateBatchInput(address, token, items...)
+	expectedGas, err := nftCreateBatch.EstimateGas(sender, nil, vmInput)
+	require.Nil(t, err)
+
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(sender, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmInput.GasProvided-expectedGas, vmOutput.GasRemaining)
+	assert.Equal(t, [][]byte{big.NewInt(5).Bytes(), big.NewInt(7).Bytes()}, vmOutput.ReturnData)
+	assert.Equal(t, map[string]int{core.MECTRoleNFTCreate: 1, core.MECTRoleNFTAddQuantity: 1}, numRoleChecks)
+	assert.Equal(t, big.NewInt(7), minted)
+
+	createdMect, latestNonce := readNFTData(t, sender, nftCreateBatch.marshaller, []byte(token), 6, address)
+	assert.Equal(t, uint64(7), latestNonce)
+	assert.Equal(t, &mect.MECToken{Type: uint32(core.NonFungible), Value: big.NewInt(5)}, createdMect)
+
+	tokenKey := computeMECTNFTTokenKey([]byte(baseMECTKeyPrefix+token), 6)
+	mectData, _, _ := mectDataStorage.getMECTDigitalTokenDataFromSystemAccount(tokenKey)
+	assert.Equal(t, &mect.MetaData{
+		Nonce:      6,
+		Name:       []byte("second"),
+		Creator:    address,
+		Royalties:  200,
+		Hash:       []byte("hash-second"),
+		Attributes: []byte("attributes-second"),
+		URIs:       [][]byte{[]byte("uri2"), []byte("uri3")},
+	}, mectData.TokenMetaData)
+
+	require.Equal(t, 1, len(vmOutput.Logs))
+	logEntry := vmOutput.Logs[0]
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionMECTNFTCreateBatch), logEntry.Identifier)
+	assert.Equal(t, address, logEntry.Address)
+	require.Equal(t, 7, len(logEntry.Topics))
+	assert.Equal(t, [][]byte{[]byte(token), big.NewInt(5).Bytes(), big.NewInt(7).Bytes(), big.NewInt(7).Bytes()}, logEntry.Topics[:4])
+	var mectDataFromLog mect.MECToken
+	_ = nftCreateBatch.marshaller.Unmarshal(&mectDataFromLog, logEntry.Topics[6])
+	assert.Equal(t, []byte("third"), mectDataFromLog.TokenMetaData.Name)
+}
+
+func TestMectNFTCreateBatch_ProcessBuiltinFunctionWithExecByCaller(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsAdapterWithMap()
+	mectDataStorage := createNewMECTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, accounts)
+	mectDataStorage.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSaveToSystemAccountFlagEnabledField = true
+	nftCreateBatch := createNftCreateBatch(mectDataStorage, &mock.MECTRoleHandlerStub{})
+
+	address := bytes.Repeat([]byte{1}, 32)
+	userAddress := bytes.Repeat([]byte{2}, 32)
+	vmInput := createNftCreateBatchInput(userAddress, "token",
+		createNftCreateBatchItem(1, "first", 100, "uri1"),
+		createNftCreateBatchItem(1, "second", 100, "uri2"),
+	)
+	vmInput.CallType = vm.ExecOnDestByCaller
+	vmInput.Arguments = append(vmInput.Arguments, address)
+
+	vmOutput, err := nftCreateBatch.ProcessBuiltinFunction(nil, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(1).Bytes(), big.NewInt(2).Bytes()}, vmOutput.ReturnData)
+
+	roleAcc, _ := nftCreateBatch.getAccount(address)
+	createdMect, latestNonce := readNFTData(t, roleAcc, nftCreateBatch.marshaller, []byte("token"), 2, address)
+	assert.Equal(t, uint64(2), latestNonce)
+	assert.Equal(t, big.NewInt(1), createdMect.Value)
+
+	tokenKey := computeMECTNFTTokenKey([]byte(baseMECTKeyPrefix+"token"), 2)
+	metaData, _ := mectDataStorage.getMECTMetaDataFromSystemAccount(tokenKey)
+	assert.Equal(t, userAddress, metaData.Creator)
+	assert.Equal(t, []byte("second"), metaData.Name)
+
+	vmInput.Arguments[len(vmInput.Arguments)-1] = userAddress
+	_, err = nftCreateBatch.ProcessBuiltinFunction(nil, nil, vmInput)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+}
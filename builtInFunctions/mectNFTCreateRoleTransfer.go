@@ -167,32 +167,31 @@ func (e *mectNFTCreateRoleTransfer) deleteCreateRoleFromAccount(
 	acntDst vmcommon.UserAccountHandler,
 	mectTokenRoleKey []byte,
 ) error {
-	roles, _, err := getMECTRolesForAcnt(e.marshaller, acntDst, mectTokenRoleKey)
+	roles, _, err := getMECTRolesWithExpiryForAcnt(e.marshaller, acntDst, mectTokenRoleKey)
 	if err != nil {
 		return err
 	}
 
-	deleteRoles(roles, [][]byte{[]byte(core.MECTRoleNFTCreate)})
-	return saveRolesToAccount(acntDst, mectTokenRoleKey, roles, e.marshaller)
+	roles.deleteRoles([][]byte{[]byte(core.MECTRoleNFTCreate)})
+	return saveRolesWithExpiryToAccount(acntDst, mectTokenRoleKey, roles, e.marshaller)
 }
 
 func (e *mectNFTCreateRoleTransfer) addCreateRoleToAccount(
 	acntDst vmcommon.UserAccountHandler,
 	mectTokenRoleKey []byte,
 ) error {
-	roles, _, err := getMECTRolesForAcnt(e.marshaller, acntDst, mectTokenRoleKey)
+	roles, _, err := getMECTRolesWithExpiryForAcnt(e.marshaller, acntDst, mectTokenRoleKey)
 	if err != nil {
 		return err
 	}
 
-	for _, role := range roles.Roles {
-		if bytes.Equal(role, []byte(core.MECTRoleNFTCreate)) {
-			return nil
-		}
+	index, exist := doesRoleExist(roles.roles, []byte(core.MECTRoleNFTCreate))
+	if exist && roles.expiryEpochs[index] == noRoleExpiry {
+		return nil
 	}
 
-	roles.Roles = append(roles.Roles, []byte(core.MECTRoleNFTCreate))
-	return saveRolesToAccount(acntDst, mectTokenRoleKey, roles, e.marshaller)
+	roles.setRole([]byte(core.MECTRoleNFTCreate), noRoleExpiry)
+	return saveRolesWithExpiryToAccount(acntDst, mectTokenRoleKey, roles, e.marshaller)
 }
 
 func saveRolesToAccount(
@@ -242,6 +241,15 @@ func (e *mectNFTCreateRoleTransfer) executeTransferNFTCreateChangeAtNextOwner(
 	return nil
 }
 
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectNFTCreateRoleTransfer) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectNFTCreateRoleTransfer) IsInterfaceNil() bool {
 	return e == nil
@@ -25,8 +25,9 @@ func createNftCreateWithStubArguments() *mectNFTCreate {
 		&mock.MECTRoleHandlerStub{},
 		createNewMECTDataStorageHandler(),
 		&mock.AccountsStub{},
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 
 	return nftCreate
@@ -43,8 +44,9 @@ func TestNewMECTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.MECTRoleHandlerStub{},
 		createNewMECTDataStorageHandler(),
 		&mock.AccountsStub{},
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftCreate))
 	assert.Equal(t, ErrNilMarshalizer, err)
@@ -57,8 +59,9 @@ func TestNewMECTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.MECTRoleHandlerStub{},
 		createNewMECTDataStorageHandler(),
 		&mock.AccountsStub{},
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftCreate))
 	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
@@ -71,8 +74,9 @@ func TestNewMECTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
 		nil,
 		createNewMECTDataStorageHandler(),
 		&mock.AccountsStub{},
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftCreate))
 	assert.Equal(t, ErrNilRolesHandler, err)
@@ -85,8 +89,9 @@ func TestNewMECTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.MECTRoleHandlerStub{},
 		nil,
 		&mock.AccountsStub{},
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftCreate))
 	assert.Equal(t, ErrNilMECTNFTStorageHandler, err)
@@ -99,11 +104,10 @@ func TestNewMECTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.MECTRoleHandlerStub{},
 		createNewMECTDataStorageHandler(),
 		&mock.AccountsStub{},
-		0,
 		nil,
 	)
 	assert.True(t, check.IfNil(nftCreate))
-	assert.Equal(t, ErrNilEpochHandler, err)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
 }
 
 func TestNewMECTNFTCreateFunc(t *testing.T) {
@@ -117,8 +121,9 @@ func TestNewMECTNFTCreateFunc(t *testing.T) {
 		&mock.MECTRoleHandlerStub{},
 		createNewMECTDataStorageHandler(),
 		&mock.AccountsStub{},
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 	assert.False(t, check.IfNil(nftCreate))
 	assert.Nil(t, err)
@@ -214,8 +219,9 @@ func TestMectNFTCreate_ProcessBuiltinFunctionNotAllowedToExecute(t *testing.T) {
 		},
 		mectDataStorage,
 		mectDataStorage.accounts,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 	sender := mock.NewAccountWrapMock([]byte("address"))
 	vmInput := &vmcommon.ContractCallInput{
@@ -255,8 +261,9 @@ func TestMectNFTCreate_ProcessBuiltinFunctionShouldWork(t *testing.T) {
 		mectRoleHandler,
 		mectDataStorage,
 		mectDataStorage.accounts,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 	address := bytes.Repeat([]byte{1}, 32)
 	sender := mock.NewUserAccount(address)
@@ -328,7 +335,7 @@ func TestMectNFTCreate_ProcessBuiltinFunctionWithExecByCaller(t *testing.T) {
 
 	accounts := createAccountsAdapterWithMap()
 	mectDataStorage := createNewMECTDataStorageHandlerWithArgs(&mock.GlobalSettingsHandlerStub{}, accounts)
-	_ = mectDataStorage.flagSaveToSystemAccount.SetReturningPrevious()
+	mectDataStorage.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSaveToSystemAccountFlagEnabledField = true
 	nftCreate, _ := NewMECTNFTCreateFunc(
 		0,
 		vmcommon.BaseOperationCost{},
@@ -337,8 +344,9 @@ func TestMectNFTCreate_ProcessBuiltinFunctionWithExecByCaller(t *testing.T) {
 		&mock.MECTRoleHandlerStub{},
 		mectDataStorage,
 		mectDataStorage.accounts,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
 	)
 	address := bytes.Repeat([]byte{1}, 32)
 	userAddress := bytes.Repeat([]byte{2}, 32)
@@ -417,3 +425,51 @@ func readNFTData(t *testing.T, account vmcommon.UserAccountHandler, marshaller v
 
 	return mectData, latestNonce
 }
+
+func TestMectNFTCreate_EstimateGasShouldMatchConsumedGas(t *testing.T) {
+	t.Parallel()
+
+	mectDataStorage := createNewMECTDataStorageHandler()
+	nftCreate, _ := NewMECTNFTCreateFunc(
+		7,
+		vmcommon.BaseOperationCost{StorePerByte: 3},
+		&mock.MarshalizerMock{},
+		&mock.GlobalSettingsHandlerStub{},
+		&mock.MECTRoleHandlerStub{},
+		mectDataStorage,
+		mectDataStorage.accounts,
+		&mock.EnableEpochsHandlerStub{
+			IsValueLengthCheckFlagEnabledField: true,
+		},
+	)
+	sender := mock.NewUserAccount(bytes.Repeat([]byte{1}, 32))
+	_ = sender.AccountDataHandler().SaveKeyValue([]byte("key"), []byte("value"))
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  sender.AddressBytes(),
+			CallValue:   big.NewInt(0),
+			GasProvided: 10000,
+			Arguments: [][]byte{
+				[]byte("token"),
+				big.NewInt(2).Bytes(),
+				[]byte("name"),
+				big.NewInt(100).Bytes(),
+				[]byte("12345678901234567890123456789012"),
+				[]byte("attributes"),
+				[]byte("uri"),
+			},
+		},
+		RecipientAddr: sender.AddressBytes(),
+	}
+
+	_, err := nftCreate.EstimateGas(nil, nil, nil)
+	require.Equal(t, ErrNilVmInput, err)
+
+	estimatedGas, err := nftCreate.EstimateGas(sender, nil, vmInput)
+	require.Nil(t, err)
+
+	vmOutput, err := nftCreate.ProcessBuiltinFunction(sender, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmInput.GasProvided-vmOutput.GasRemaining, estimatedGas)
+}
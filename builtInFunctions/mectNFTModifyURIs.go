@@ -0,0 +1,272 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const (
+	removeURIByIndex = 0
+	removeURIByValue = 1
+)
+
+type mectNFTModifyURIs struct {
+	*baseActiveHandler
+	function              string
+	keyPrefix             []byte
+	mectStorageHandler    vmcommon.MECTNFTStorageHandler
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	rolesHandler          vmcommon.MECTRoleHandler
+	gasConfig             vmcommon.BaseOperationCost
+	funcGasCost           uint64
+	mutExecution          sync.RWMutex
+}
+
+// NewMECTNFTModifyURIsFunc returns the mect NFT set URIs/remove URI built-in function component
+func NewMECTNFTModifyURIsFunc(
+	funcGasCost uint64,
+	gasConfig vmcommon.BaseOperationCost,
+	mectStorageHandler vmcommon.MECTNFTStorageHandler,
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler,
+	rolesHandler vmcommon.MECTRoleHandler,
+	function string,
+	activeHandler func() bool,
+) (*mectNFTModifyURIs, error) {
+	if check.IfNil(mectStorageHandler) {
+		return nil, ErrNilMECTNFTStorageHandler
+	}
+	if check.IfNil(globalSettingsHandler) {
+		return nil, ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, ErrNilRolesHandler
+	}
+	if function != vmcommon.BuiltInFunctionMECTNFTSetURIs && function != vmcommon.BuiltInFunctionMECTNFTRemoveURI {
+		return nil, ErrInvalidArguments
+	}
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
+
+	e := &mectNFTModifyURIs{
+		function:              function,
+		keyPrefix:             []byte(baseMECTKeyPrefix),
+		mectStorageHandler:    mectStorageHandler,
+		funcGasCost:           funcGasCost,
+		mutExecution:          sync.RWMutex{},
+		globalSettingsHandler: globalSettingsHandler,
+		gasConfig:             gasConfig,
+		rolesHandler:          rolesHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectNFTModifyURIs) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.MECTNFTSetURIs
+	if e.function == vmcommon.BuiltInFunctionMECTNFTRemoveURI {
+		e.funcGasCost = gasCost.BuiltInCost.MECTNFTRemoveURI
+	}
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves MECT NFT set URIs and remove URI function calls
+// MECTNFTSetURIs requires at least 3 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg[2:] - the new list of uris
+// MECTNFTRemoveURI requires 4 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - 0 to remove by index, 1 to remove by value
+// arg3 - index or value of the uri to remove
+// The growth of the URIs list is charged per stored byte, while the shrinkage is refunded per released byte.
+func (e *mectNFTModifyURIs) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkMECTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	err = e.checkNumberOfArguments(vmInput.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.rolesHandler.CheckAllowedToExecute(acntSnd, vmInput.Arguments[0], []byte(core.MECTRoleNFTAddURI))
+	if err != nil {
+		return nil, err
+	}
+
+	mectTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	if e.globalSettingsHandler.IsMetadataFrozen(mectTokenKey) {
+		return nil, ErrMECTMetadataIsFrozen
+	}
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if nonce == 0 {
+		return nil, ErrNFTDoesNotHaveMetadata
+	}
+	mectData, err := e.mectStorageHandler.GetMECTNFTTokenOnSender(acntSnd, mectTokenKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if mectData.TokenMetaData == nil {
+		return nil, ErrNFTDoesNotHaveMetadata
+	}
+
+	oldURIs := mectData.TokenMetaData.URIs
+	newURIs, err := e.computeNewURIs(oldURIs, vmInput.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	gasToUse, gasRefund := e.computeGasForURIsChange(oldURIs, newURIs)
+	if vmInput.GasProvided < gasToUse {
+		return nil, ErrNotEnoughGas
+	}
+
+	mectData.TokenMetaData.URIs = newURIs
+	_, err = e.mectStorageHandler.SaveMECTNFTToken(acntSnd.AddressBytes(), acntSnd, mectTokenKey, nonce, mectData, true, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		GasRefund:    big.NewInt(0).SetUint64(gasRefund),
+	}
+
+	extraTopics := append([][]byte{vmInput.CallerAddr}, newURIs...)
+	addMECTEntryInVMOutput(vmOutput, []byte(e.function), vmInput.Arguments[0], nonce, big.NewInt(0), extraTopics...)
+
+	return vmOutput, nil
+}
+
+func (e *mectNFTModifyURIs) checkNumberOfArguments(arguments [][]byte) error {
+	if e.function == vmcommon.BuiltInFunctionMECTNFTSetURIs {
+		if len(arguments) < 3 {
+			return ErrInvalidArguments
+		}
+		return nil
+	}
+
+	if len(arguments) != 4 {
+		return ErrInvalidArguments
+	}
+	return nil
+}
+
+func (e *mectNFTModifyURIs) computeNewURIs(oldURIs [][]byte, arguments [][]byte) ([][]byte, error) {
+	if e.function == vmcommon.BuiltInFunctionMECTNFTSetURIs {
+		return arguments[2:], nil
+	}
+
+	indexToRemove, err := findURIToRemove(oldURIs, arguments[2], arguments[3])
+	if err != nil {
+		return nil, err
+	}
+	if len(oldURIs) == 1 {
+		return nil, fmt.Errorf("%w, can not remove the last uri", ErrInvalidArguments)
+	}
+
+	newURIs := make([][]byte, 0, len(oldURIs)-1)
+	newURIs = append(newURIs, oldURIs[:indexToRemove]...)
+	newURIs = append(newURIs, oldURIs[indexToRemove+1:]...)
+
+	return newURIs, nil
+}
+
+func findURIToRemove(uris [][]byte, mode []byte, uriToRemove []byte) (int, error) {
+	switch big.NewInt(0).SetBytes(mode).Uint64() {
+	case removeURIByIndex:
+		index := big.NewInt(0).SetBytes(uriToRemove)
+		if !index.IsUint64() || index.Uint64() >= uint64(len(uris)) {
+			return 0, fmt.Errorf("%w, uri index out of range", ErrInvalidArguments)
+		}
+		return int(index.Uint64()), nil
+	case removeURIByValue:
+		for i, uri := range uris {
+			if bytes.Equal(uri, uriToRemove) {
+				return i, nil
+			}
+		}
+		return 0, ErrURINotFound
+	default:
+		return 0, fmt.Errorf("%w, invalid remove uri mode", ErrInvalidArguments)
+	}
+}
+
+// computeGasForURIsChange returns the gas to be consumed and the gas to be refunded for replacing the old list of
+// URIs with the new one
+func (e *mectNFTModifyURIs) computeGasForURIsChange(oldURIs [][]byte, newURIs [][]byte) (uint64, uint64) {
+	oldLength := computeURIsLength(oldURIs)
+	newLength := computeURIsLength(newURIs)
+	if newLength >= oldLength {
+		return e.funcGasCost + (newLength-oldLength)*e.gasConfig.StorePerByte, 0
+	}
+
+	return e.funcGasCost, (oldLength - newLength) * e.gasConfig.ReleasePerByte
+}
+
+func computeURIsLength(uris [][]byte) uint64 {
+	length := uint64(0)
+	for _, uri := range uris {
+		length += uint64(len(uri))
+	}
+
+	return length
+}
+
+// EstimateGas returns the gas consumed by the MECT NFT set URIs and remove URI functions
+func (e *mectNFTModifyURIs) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+	err := e.checkNumberOfArguments(vmInput.Arguments)
+	if err != nil {
+		return 0, err
+	}
+	if e.function == vmcommon.BuiltInFunctionMECTNFTRemoveURI || check.IfNil(acntSnd) {
+		return e.funcGasCost, nil
+	}
+
+	mectTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	mectData, err := e.mectStorageHandler.GetMECTNFTTokenOnSender(acntSnd, mectTokenKey, nonce)
+	if err != nil || mectData.TokenMetaData == nil {
+		return e.funcGasCost + computeURIsLength(vmInput.Arguments[2:])*e.gasConfig.StorePerByte, nil
+	}
+
+	gasToUse, _ := e.computeGasForURIsChange(mectData.TokenMetaData.URIs, vmInput.Arguments[2:])
+	return gasToUse, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectNFTModifyURIs) IsInterfaceNil() bool {
+	return e == nil
+}
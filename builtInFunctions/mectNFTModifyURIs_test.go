@@ -0,0 +1,281 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTWithURIs(account vmcommon.UserAccountHandler, tokenIdentifier string, nonce uint64, uris [][]byte) []byte {
+	marshaller := &mock.MarshalizerMock{}
+	mectData := &mect.MECToken{
+		TokenMetaData: &mect.MetaData{
+			Nonce: nonce,
+			Name:  []byte("test"),
+			URIs:  uris,
+		},
+		Value: big.NewInt(1),
+	}
+	mectDataBytes, _ := marshaller.Marshal(mectData)
+	tokenKey := computeMECTNFTTokenKey([]byte(baseMECTKeyPrefix+tokenIdentifier), nonce)
+	_ = account.AccountDataHandler().SaveKeyValue(tokenKey, mectDataBytes)
+
+	return tokenKey
+}
+
+func createModifyURIsInput(arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			Arguments:   arguments,
+			CallerAddr:  []byte("addr"),
+			GasProvided: 1000,
+		},
+		RecipientAddr: []byte("addr"),
+	}
+}
+
+func TestNewMECTNFTModifyURIsFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, nil, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+	require.Nil(t, e)
+	require.Equal(t, ErrNilMECTNFTStorageHandler, err)
+
+	e, err = NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), nil, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+	require.Nil(t, e)
+	require.Equal(t, ErrNilGlobalSettingsHandler, err)
+
+	e, err = NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, nil, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+	require.Nil(t, e)
+	require.Equal(t, ErrNilRolesHandler, err)
+
+	e, err = NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, core.BuiltInFunctionMECTNFTAddURI, trueHandler)
+	require.Nil(t, e)
+	require.Equal(t, ErrInvalidArguments, err)
+
+	e, err = NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTSetURIs, nil)
+	require.Nil(t, e)
+	require.Equal(t, ErrNilActiveHandler, err)
+
+	e, err = NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+	require.NoError(t, err)
+	require.False(t, e.IsInterfaceNil())
+	require.True(t, e.IsActive())
+}
+
+func TestMECTNFTModifyURIs_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	gasCost := &vmcommon.GasCost{
+		BuiltInCost:       vmcommon.BuiltInCost{MECTNFTAddURI: 36, MECTNFTSetURIs: 37, MECTNFTRemoveURI: 38},
+		BaseOperationCost: vmcommon.BaseOperationCost{StorePerByte: 3},
+	}
+
+	e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+	e.SetNewGasConfig(gasCost)
+	require.Equal(t, uint64(37), e.funcGasCost)
+	require.Equal(t, uint64(3), e.gasConfig.StorePerByte)
+
+	e, _ = NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+	e.SetNewGasConfig(gasCost)
+	require.Equal(t, uint64(38), e.funcGasCost)
+}
+
+func TestMECTNFTModifyURIs_ProcessBuiltinFunctionInvalidNumberOfArguments(t *testing.T) {
+	t.Parallel()
+
+	setURIs, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+	output, err := setURIs.ProcessBuiltinFunction(mock.NewAccountWrapMock([]byte("addr")), nil, createModifyURIsInput([]byte("token"), []byte{1}))
+	require.Nil(t, output)
+	require.Equal(t, ErrInvalidArguments, err)
+
+	removeURI, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+	output, err = removeURI.ProcessBuiltinFunction(mock.NewAccountWrapMock([]byte("addr")), nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte{0}))
+	require.Nil(t, output)
+	require.Equal(t, ErrInvalidArguments, err)
+}
+
+func TestMECTNFTModifyURIs_ProcessBuiltinFunctionShouldErrOnCheckAllowedToExecute(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	rolesHandler := &mock.MECTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ vmcommon.UserAccountHandler, _ []byte, action []byte) error {
+			require.Equal(t, core.MECTRoleNFTAddURI, string(action))
+			return expectedErr
+		},
+	}
+	e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, rolesHandler, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+
+	output, err := e.ProcessBuiltinFunction(mock.NewAccountWrapMock([]byte("addr")), nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte("uri")))
+	require.Nil(t, output)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestMECTNFTModifyURIs_ProcessBuiltinFunctionShouldErrOnZeroNonce(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+
+	output, err := e.ProcessBuiltinFunction(mock.NewAccountWrapMock([]byte("addr")), nil, createModifyURIsInput([]byte("token"), []byte{0}, []byte("uri")))
+	require.Nil(t, output)
+	require.Equal(t, ErrNFTDoesNotHaveMetadata, err)
+}
+
+func TestMECTNFTModifyURIs_ProcessBuiltinFunctionShouldErrBecauseMetadataIsFrozen(t *testing.T) {
+	t.Parallel()
+
+	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{
+		IsMetadataFrozenCalled: func(token []byte) bool {
+			return true
+		},
+	}
+	e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), globalSettingsHandler, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+
+	userAcc := mock.NewAccountWrapMock([]byte("addr"))
+	_ = createNFTWithURIs(userAcc, "token", 1, [][]byte{[]byte("uri0"), []byte("uri1")})
+
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte{removeURIByIndex}, []byte{0}))
+	require.Nil(t, output)
+	require.Equal(t, ErrMECTMetadataIsFrozen, err)
+}
+
+func TestMECTNFTModifyURIs_SetURIsShouldChargeForGrowth(t *testing.T) {
+	t.Parallel()
+
+	mectDataStorage := createNewMECTDataStorageHandler()
+	gasConfig := vmcommon.BaseOperationCost{StorePerByte: 2, ReleasePerByte: 1}
+	e, _ := NewMECTNFTModifyURIsFunc(10, gasConfig, mectDataStorage, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+
+	userAcc := mock.NewAccountWrapMock([]byte("addr"))
+	tokenKey := createNFTWithURIs(userAcc, "token", 1, [][]byte{[]byte("uri")})
+
+	vmInput := createModifyURIsInput([]byte("token"), []byte{1}, []byte("uri1"), []byte("uri2"))
+	vmInput.GasProvided = 19
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, vmInput)
+	require.Nil(t, output)
+	require.Equal(t, ErrNotEnoughGas, err)
+
+	estimatedGas, err := e.EstimateGas(userAcc, nil, vmInput)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10+5*2), estimatedGas)
+
+	vmInput.GasProvided = 100
+	output, err = e.ProcessBuiltinFunction(userAcc, nil, vmInput)
+	require.NoError(t, err)
+	require.Equal(t, vmcommon.Ok, output.ReturnCode)
+	require.Equal(t, uint64(100-estimatedGas), output.GasRemaining)
+	require.Equal(t, big.NewInt(0), output.GasRefund)
+	require.Len(t, output.Logs, 1)
+	require.Equal(t, []byte(vmcommon.BuiltInFunctionMECTNFTSetURIs), output.Logs[0].Identifier)
+
+	metaData, _ := mectDataStorage.getMECTMetaDataFromSystemAccount(tokenKey)
+	require.Equal(t, [][]byte{[]byte("uri1"), []byte("uri2")}, metaData.URIs)
+}
+
+func TestMECTNFTModifyURIs_SetURIsShouldRefundForShrinkage(t *testing.T) {
+	t.Parallel()
+
+	mectDataStorage := createNewMECTDataStorageHandler()
+	gasConfig := vmcommon.BaseOperationCost{StorePerByte: 2, ReleasePerByte: 1}
+	e, _ := NewMECTNFTModifyURIsFunc(10, gasConfig, mectDataStorage, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTSetURIs, trueHandler)
+
+	userAcc := mock.NewAccountWrapMock([]byte("addr"))
+	tokenKey := createNFTWithURIs(userAcc, "token", 1, [][]byte{[]byte("long uri 1"), []byte("long uri 2")})
+
+	output, err := e.ProcessBuiltinFunction(userAcc, nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte("uri")))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000-10), output.GasRemaining)
+	require.Equal(t, big.NewInt(17), output.GasRefund)
+
+	metaData, _ := mectDataStorage.getMECTMetaDataFromSystemAccount(tokenKey)
+	require.Equal(t, [][]byte{[]byte("uri")}, metaData.URIs)
+}
+
+func TestMECTNFTModifyURIs_RemoveURI(t *testing.T) {
+	t.Parallel()
+
+	uris := [][]byte{[]byte("uri0"), []byte("uri1"), []byte("uri2")}
+
+	t.Run("by index should work", func(t *testing.T) {
+		t.Parallel()
+
+		mectDataStorage := createNewMECTDataStorageHandler()
+		e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{ReleasePerByte: 1}, mectDataStorage, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+		userAcc := mock.NewAccountWrapMock([]byte("addr"))
+		tokenKey := createNFTWithURIs(userAcc, "token", 1, uris)
+
+		output, err := e.ProcessBuiltinFunction(userAcc, nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte{removeURIByIndex}, []byte{1}))
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(4), output.GasRefund)
+		require.Equal(t, []byte(vmcommon.BuiltInFunctionMECTNFTRemoveURI), output.Logs[0].Identifier)
+
+		metaData, _ := mectDataStorage.getMECTMetaDataFromSystemAccount(tokenKey)
+		require.Equal(t, [][]byte{[]byte("uri0"), []byte("uri2")}, metaData.URIs)
+	})
+	t.Run("by value should work", func(t *testing.T) {
+		t.Parallel()
+
+		mectDataStorage := createNewMECTDataStorageHandler()
+		e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, mectDataStorage, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+		userAcc := mock.NewAccountWrapMock([]byte("addr"))
+		tokenKey := createNFTWithURIs(userAcc, "token", 1, uris)
+
+		_, err := e.ProcessBuiltinFunction(userAcc, nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte{removeURIByValue}, []byte("uri2")))
+		require.NoError(t, err)
+
+		metaData, _ := mectDataStorage.getMECTMetaDataFromSystemAccount(tokenKey)
+		require.Equal(t, [][]byte{[]byte("uri0"), []byte("uri1")}, metaData.URIs)
+	})
+	t.Run("value not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+		userAcc := mock.NewAccountWrapMock([]byte("addr"))
+		_ = createNFTWithURIs(userAcc, "token", 1, uris)
+
+		output, err := e.ProcessBuiltinFunction(userAcc, nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte{removeURIByValue}, []byte("uri3")))
+		require.Nil(t, output)
+		require.Equal(t, ErrURINotFound, err)
+	})
+	t.Run("index out of range should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+		userAcc := mock.NewAccountWrapMock([]byte("addr"))
+		_ = createNFTWithURIs(userAcc, "token", 1, uris)
+
+		output, err := e.ProcessBuiltinFunction(userAcc, nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte{removeURIByIndex}, []byte{3}))
+		require.Nil(t, output)
+		require.True(t, errors.Is(err, ErrInvalidArguments))
+	})
+	t.Run("invalid mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+		userAcc := mock.NewAccountWrapMock([]byte("addr"))
+		_ = createNFTWithURIs(userAcc, "token", 1, uris)
+
+		output, err := e.ProcessBuiltinFunction(userAcc, nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte{2}, []byte{0}))
+		require.Nil(t, output)
+		require.True(t, errors.Is(err, ErrInvalidArguments))
+	})
+	t.Run("last uri should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, _ := NewMECTNFTModifyURIsFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, vmcommon.BuiltInFunctionMECTNFTRemoveURI, trueHandler)
+		userAcc := mock.NewAccountWrapMock([]byte("addr"))
+		_ = createNFTWithURIs(userAcc, "token", 1, [][]byte{[]byte("uri0")})
+
+		output, err := e.ProcessBuiltinFunction(userAcc, nil, createModifyURIsInput([]byte("token"), []byte{1}, []byte{removeURIByIndex}, []byte{0}))
+		require.Nil(t, output)
+		require.True(t, errors.Is(err, ErrInvalidArguments))
+	})
+}
@@ -9,7 +9,6 @@ import (
 	"sync"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/data/mect"
 	"github.com/ME-MotherEarth/me-core/data/vm"
@@ -23,23 +22,18 @@ var zeroByteArray = []byte{0}
 
 type mectNFTTransfer struct {
 	baseAlwaysActive
-	keyPrefix                      []byte
-	marshaller                     vmcommon.Marshalizer
-	globalSettingsHandler          vmcommon.ExtendedMECTGlobalSettingsHandler
-	payableHandler                 vmcommon.PayableChecker
-	funcGasCost                    uint64
-	accounts                       vmcommon.AccountsAdapter
-	shardCoordinator               vmcommon.Coordinator
-	gasConfig                      vmcommon.BaseOperationCost
-	mutExecution                   sync.RWMutex
-	rolesHandler                   vmcommon.MECTRoleHandler
-	mectStorageHandler             vmcommon.MECTNFTStorageHandler
-	transferToMetaEnableEpoch      uint32
-	flagTransferToMeta             atomic.Flag
-	check0TransferEnableEpoch      uint32
-	flagCheck0Transfer             atomic.Flag
-	checkCorrectTokenIDEnableEpoch uint32
-	flagCheckCorrectTokenID        atomic.Flag
+	keyPrefix             []byte
+	marshaller            vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	payableHandler        vmcommon.PayableChecker
+	funcGasCost           uint64
+	accounts              vmcommon.AccountsAdapter
+	shardCoordinator      vmcommon.Coordinator
+	gasConfig             vmcommon.BaseOperationCost
+	mutExecution          sync.RWMutex
+	rolesHandler          vmcommon.MECTRoleHandler
+	mectStorageHandler    vmcommon.MECTNFTStorageHandler
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
 }
 
 // NewMECTNFTTransferFunc returns the mect NFT transfer built-in function component
@@ -51,11 +45,8 @@ func NewMECTNFTTransferFunc(
 	shardCoordinator vmcommon.Coordinator,
 	gasConfig vmcommon.BaseOperationCost,
 	rolesHandler vmcommon.MECTRoleHandler,
-	transferToMetaEnableEpoch uint32,
-	checkZeroTransferEnableEpoch uint32,
-	checkCorrectTokenIDEnableEpoch uint32,
 	mectStorageHandler vmcommon.MECTNFTStorageHandler,
-	epochNotifier vmcommon.EpochNotifier,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
 ) (*mectNFTTransfer, error) {
 	if check.IfNil(marshaller) {
 		return nil, ErrNilMarshalizer
@@ -72,45 +63,31 @@ func NewMECTNFTTransferFunc(
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
 	}
 	if check.IfNil(mectStorageHandler) {
 		return nil, ErrNilMECTNFTStorageHandler
 	}
 
 	e := &mectNFTTransfer{
-		keyPrefix:                      []byte(baseMECTKeyPrefix),
-		marshaller:                     marshaller,
-		globalSettingsHandler:          globalSettingsHandler,
-		funcGasCost:                    funcGasCost,
-		accounts:                       accounts,
-		shardCoordinator:               shardCoordinator,
-		gasConfig:                      gasConfig,
-		mutExecution:                   sync.RWMutex{},
-		payableHandler:                 &disabledPayableHandler{},
-		rolesHandler:                   rolesHandler,
-		transferToMetaEnableEpoch:      transferToMetaEnableEpoch,
-		check0TransferEnableEpoch:      checkZeroTransferEnableEpoch,
-		checkCorrectTokenIDEnableEpoch: checkCorrectTokenIDEnableEpoch,
-		mectStorageHandler:             mectStorageHandler,
-	}
-
-	epochNotifier.RegisterNotifyHandler(e)
+		keyPrefix:             []byte(baseMECTKeyPrefix),
+		marshaller:            marshaller,
+		globalSettingsHandler: globalSettingsHandler,
+		funcGasCost:           funcGasCost,
+		accounts:              accounts,
+		shardCoordinator:      shardCoordinator,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
+		payableHandler:        &disabledPayableHandler{},
+		rolesHandler:          rolesHandler,
+		mectStorageHandler:    mectStorageHandler,
+		enableEpochsHandler:   enableEpochsHandler,
+	}
 
 	return e, nil
 }
 
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (e *mectNFTTransfer) EpochConfirmed(epoch uint32, _ uint64) {
-	e.flagTransferToMeta.SetValue(epoch >= e.transferToMetaEnableEpoch)
-	log.Debug("MECT NFT transfer to metachain flag", "enabled", e.flagTransferToMeta.IsSet())
-	e.flagCheck0Transfer.SetValue(epoch >= e.check0TransferEnableEpoch)
-	log.Debug("MECT NFT transfer check zero transfer", "enabled", e.flagCheck0Transfer.IsSet())
-	e.flagCheckCorrectTokenID.SetValue(epoch >= e.checkCorrectTokenIDEnableEpoch)
-	log.Debug("MECT NFT transfer check correct tokenID for transfer role", "enabled", e.flagCheckCorrectTokenID.IsSet())
-}
-
 // SetPayableChecker will set the payableCheck handler to the function
 func (e *mectNFTTransfer) SetPayableChecker(payableHandler vmcommon.PayableChecker) error {
 	if check.IfNil(payableHandler) {
@@ -227,7 +204,7 @@ func (e *mectNFTTransfer) processNFTTransferOnSenderShard(
 	if bytes.Equal(dstAddress, vmInput.CallerAddr) {
 		return nil, fmt.Errorf("%w, can not transfer to self", ErrInvalidArguments)
 	}
-	isInvalidTransferToMeta := e.shardCoordinator.ComputeId(dstAddress) == core.MetachainShardId && !e.flagTransferToMeta.IsSet()
+	isInvalidTransferToMeta := e.shardCoordinator.ComputeId(dstAddress) == core.MetachainShardId && !e.enableEpochsHandler.IsTransferToMetaFlagEnabled()
 	if isInvalidTransferToMeta {
 		return nil, ErrInvalidRcvAddr
 	}
@@ -250,7 +227,7 @@ func (e *mectNFTTransfer) processNFTTransferOnSenderShard(
 	if mectData.Value.Cmp(quantityToTransfer) < 0 {
 		return nil, ErrInvalidNFTQuantity
 	}
-	if e.flagCheck0Transfer.IsSet() && quantityToTransfer.Cmp(zero) <= 0 {
+	if e.enableEpochsHandler.IsCheckTransferFlagEnabled() && quantityToTransfer.Cmp(zero) <= 0 {
 		return nil, ErrInvalidNFTQuantity
 	}
 	mectData.Value.Sub(mectData.Value, quantityToTransfer)
@@ -296,7 +273,7 @@ func (e *mectNFTTransfer) processNFTTransferOnSenderShard(
 	}
 
 	tokenID := mectTokenKey
-	if e.flagCheckCorrectTokenID.IsSet() {
+	if e.enableEpochsHandler.IsCheckCorrectTokenIDEnabled() {
 		tokenID = tickerID
 	}
 
@@ -304,6 +281,10 @@ func (e *mectNFTTransfer) processNFTTransferOnSenderShard(
 	if err != nil {
 		return nil, err
 	}
+	err = checkIfTransferCanHappenWithSoulbound(mectTokenKey, e.globalSettingsHandler, acntSnd, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
 
 	vmOutput := &vmcommon.VMOutput{
 		ReturnCode:   vmcommon.Ok,
@@ -341,7 +322,7 @@ func (e *mectNFTTransfer) createNFTOutputTransfers(
 			return err
 		}
 
-		gasForTransfer := uint64(len(marshaledNFTTransfer)) * e.gasConfig.DataCopyPerByte
+		gasForTransfer := computeGasForDataCopy(marshaledNFTTransfer, e.gasConfig)
 		if gasForTransfer > vmOutput.GasRemaining {
 			return ErrNotEnoughGas
 		}
@@ -461,7 +442,76 @@ func addNFTTransferToVMOutput(
 	}
 }
 
+// EstimateGas returns the gas consumed on the sender shard by the MECT NFT transfer, including the cost of sending the
+// token metadata to the destination. On the destination shard no gas is consumed as the sender already paid for it.
+func (e *mectNFTTransfer) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicMECTArguments(vmInput)
+	if err != nil {
+		return 0, err
+	}
+	if len(vmInput.Arguments) < 4 {
+		return 0, ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) || check.IfNil(acntSnd) {
+		return 0, nil
+	}
+
+	tickerID := vmInput.Arguments[0]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if nonce == 0 {
+		return 0, ErrNFTDoesNotHaveMetadata
+	}
+	quantityToTransfer := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	dstAddress := vmInput.Arguments[3]
+
+	wasAlreadySent, err := wasMetadataAlreadySent(e.mectStorageHandler, tickerID, nonce, dstAddress)
+	if err != nil {
+		return 0, err
+	}
+	if wasAlreadySent && quantityToTransfer.Cmp(oneValue) != 0 {
+		return e.funcGasCost, nil
+	}
+
+	mectTokenKey := append(e.keyPrefix, tickerID...)
+	mectData, err := e.mectStorageHandler.GetMECTNFTTokenOnSender(acntSnd, mectTokenKey, nonce)
+	if err != nil {
+		return 0, err
+	}
+	mectData.Value = quantityToTransfer
+
+	marshaledNFTTransfer, err := e.marshaller.Marshal(mectData)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.funcGasCost + computeGasForDataCopy(marshaledNFTTransfer, e.gasConfig), nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectNFTTransfer) IsInterfaceNil() bool {
 	return e == nil
 }
+
+// sentMetadataChecker is implemented by the NFT storage handlers able to tell, without altering the state, whether the
+// token metadata was already sent to the destination shard
+type sentMetadataChecker interface {
+	WasAlreadySentToDestinationShard(tickerID []byte, nonce uint64, dstAddress []byte) (bool, error)
+}
+
+// wasMetadataAlreadySent returns false when the storage handler can not tell, so the estimation includes the cost
+// of sending the metadata
+func wasMetadataAlreadySent(storageHandler vmcommon.MECTNFTStorageHandler, tickerID []byte, nonce uint64, dstAddress []byte) (bool, error) {
+	checker, ok := storageHandler.(sentMetadataChecker)
+	if !ok {
+		return false, nil
+	}
+
+	return checker.WasAlreadySentToDestinationShard(tickerID, nonce, dstAddress)
+}
+
+func computeGasForDataCopy(data []byte, gasConfig vmcommon.BaseOperationCost) uint64 {
+	return uint64(len(data)) * gasConfig.DataCopyPerByte
+}
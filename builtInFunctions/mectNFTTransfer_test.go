@@ -27,11 +27,11 @@ func createNftTransferWithStubArguments() *mectNFTTransfer {
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		0,
 		createNewMECTDataStorageHandler(),
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 
 	return nftTransfer
@@ -79,11 +79,11 @@ func createNFTTransferAndStorageHandler(selfShard, numShards uint32, globalSetti
 				return nil
 			},
 		},
-		1000,
-		0,
-		0,
 		mectStorageHandler,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 
 	return nftTransfer, mectStorageHandler
@@ -121,6 +121,11 @@ func createMockGasCost() vmcommon.GasCost {
 			MECTNFTUpdateAttributes:  200,
 			MECTNFTAddURI:            210,
 			MECTNFTMultiTransfer:     220,
+			MECTApprove:              230,
+			MECTTransferFrom:         240,
+			MECTVestedTransfer:       250,
+			MECTClaimVested:          260,
+			MECTNFTCreateBatch:       270,
 		},
 	}
 }
@@ -180,11 +185,11 @@ func TestNewMECTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		0,
 		createNewMECTDataStorageHandler(),
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilMarshalizer, err)
@@ -197,11 +202,11 @@ func TestNewMECTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		0,
 		createNewMECTDataStorageHandler(),
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilGlobalSettingsHandler, err)
@@ -214,11 +219,11 @@ func TestNewMECTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		0,
 		createNewMECTDataStorageHandler(),
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilAccountsAdapter, err)
@@ -231,11 +236,11 @@ func TestNewMECTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		nil,
 		vmcommon.BaseOperationCost{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		0,
 		createNewMECTDataStorageHandler(),
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilShardCoordinator, err)
@@ -248,11 +253,11 @@ func TestNewMECTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
 		nil,
-		1000,
-		0,
-		0,
 		createNewMECTDataStorageHandler(),
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilRolesHandler, err)
@@ -265,14 +270,11 @@ func TestNewMECTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		0,
 		createNewMECTDataStorageHandler(),
 		nil,
 	)
 	assert.True(t, check.IfNil(nftTransfer))
-	assert.Equal(t, ErrNilEpochHandler, err)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
 
 	nftTransfer, err = NewMECTNFTTransferFunc(
 		0,
@@ -282,11 +284,11 @@ func TestNewMECTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		0,
 		nil,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	assert.True(t, check.IfNil(nftTransfer))
 	assert.Equal(t, ErrNilMECTNFTStorageHandler, err)
@@ -303,11 +305,11 @@ func TestNewMECTNFTTransferFunc(t *testing.T) {
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		0,
 		createNewMECTDataStorageHandler(),
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckTransferFlagEnabledField:   true,
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	assert.False(t, check.IfNil(nftTransfer))
 	assert.Nil(t, err)
@@ -472,7 +474,12 @@ func TestMectNFTTransfer_ProcessBuiltinFunctionOnSameShardWithScCall(t *testing.
 			IsPayableCalled: func(address []byte) (bool, error) {
 				return true, nil
 			},
-		}, 0, 0, &mock.EpochNotifierStub{})
+		},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckFunctionArgumentFlagEnabledField: true,
+			IsFixAsyncCallbackCheckFlagEnabledField: true,
+		},
+	)
 
 	_ = nftTransfer.SetPayableChecker(payableChecker)
 	senderAddress := bytes.Repeat([]byte{2}, 32)
@@ -822,6 +829,59 @@ func TestMECTNFTTransfer_WithLimitedTransfer(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMECTNFTTransfer_WithSoulbound(t *testing.T) {
+	t.Parallel()
+
+	globalSettings := &mock.GlobalSettingsHandlerStub{}
+	transferFunc := createNftTransferWithMockArguments(0, 1, globalSettings)
+	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
+
+	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
+	destinationAddress := bytes.Repeat([]byte{1}, 32)
+	destinationAddress[31] = 0
+	sender, err := transferFunc.accounts.LoadAccount(senderAddress)
+	require.Nil(t, err)
+
+	tokenName := []byte("token")
+	tokenNonce := uint64(1)
+
+	initialTokens := big.NewInt(3)
+	createMECTNFTToken(tokenName, core.NonFungible, tokenNonce, initialTokens, transferFunc.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	_ = transferFunc.accounts.SaveAccount(sender)
+	_, _ = transferFunc.accounts.Commit()
+	// reload sender account
+	sender, err = transferFunc.accounts.LoadAccount(senderAddress)
+	require.Nil(t, err)
+
+	nonceBytes := big.NewInt(int64(tokenNonce)).Bytes()
+	quantityBytes := big.NewInt(1).Bytes()
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			Arguments:   [][]byte{tokenName, nonceBytes, quantityBytes, destinationAddress},
+			GasProvided: 1,
+		},
+		RecipientAddr: senderAddress,
+	}
+
+	destination, _ := transferFunc.accounts.LoadAccount(destinationAddress)
+	globalSettings.IsSoulboundCalled = func(token []byte) bool {
+		assert.Equal(t, append(keyPrefix, tokenName...), token)
+		return true
+	}
+	globalSettings.IsSenderOrDestinationWithTransferRoleCalled = func(_, _, _ []byte) bool {
+		return true
+	}
+	_, err = transferFunc.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), destination.(vmcommon.UserAccountHandler), vmInput)
+	assert.Equal(t, ErrMECTTokenIsSoulbound, err)
+
+	vmInput.ReturnCallAfterError = true
+	_, err = transferFunc.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), destination.(vmcommon.UserAccountHandler), vmInput)
+	assert.Nil(t, err)
+}
+
 func TestMECTNFTTransfer_NotEnoughGas(t *testing.T) {
 	t.Parallel()
 
@@ -892,7 +952,7 @@ func TestMECTNFTTransfer_SndDstFreezeCollection(t *testing.T) {
 
 	globalSettings := &mock.GlobalSettingsHandlerStub{}
 	transferFunc, mectStorageHandler := createNFTTransferAndStorageHandler(0, 1, globalSettings)
-	mectStorageHandler.flagCheckFrozenCollection.SetValue(true)
+	mectStorageHandler.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsCheckFrozenCollectionFlagEnabledField = true
 
 	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
 
@@ -943,67 +1003,12 @@ func TestMECTNFTTransfer_SndDstFreezeCollection(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-func TestMECTNFTTransfer_EpochChange(t *testing.T) {
-	t.Parallel()
-
-	var functionHandler vmcommon.EpochSubscriberHandler
-	notifier := &mock.EpochNotifierStub{
-		RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
-			functionHandler = handler
-		},
-	}
-	transferFunc, _ := NewMECTNFTTransferFunc(
-		0,
-		&mock.MarshalizerMock{},
-		&mock.GlobalSettingsHandlerStub{},
-		&mock.AccountsStub{},
-		&mock.ShardCoordinatorStub{},
-		vmcommon.BaseOperationCost{},
-		&mock.MECTRoleHandlerStub{},
-		1,
-		2,
-		3,
-		createNewMECTDataStorageHandler(),
-		notifier,
-	)
-
-	functionHandler.EpochConfirmed(0, 0)
-	assert.False(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.False(t, transferFunc.flagCheck0Transfer.IsSet())
-	assert.False(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-
-	functionHandler.EpochConfirmed(1, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.False(t, transferFunc.flagCheck0Transfer.IsSet())
-	assert.False(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-
-	functionHandler.EpochConfirmed(2, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheck0Transfer.IsSet())
-	assert.False(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-
-	functionHandler.EpochConfirmed(3, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheck0Transfer.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-
-	functionHandler.EpochConfirmed(4, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheck0Transfer.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-
-	functionHandler.EpochConfirmed(5, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheck0Transfer.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-}
-
 func TestMectNFTTransfer_ProcessBuiltinFunctionCrossShardsFixOldLiquidityIssue(t *testing.T) {
 	t.Parallel()
 
 	vmInput, sender, nftTransferSenderShard, mectDataStorageHandler, tokenName, tokenNonce := createSetupToSendNFTCrossShard(t)
 
-	mectDataStorageHandler.flagFixOldTokenLiquidity.SetValue(true)
+	mectDataStorageHandler.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsFixOldTokenLiquidityEnabledField = true
 	vmOutput, err := nftTransferSenderShard.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
 	require.Nil(t, err)
 	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
@@ -1023,7 +1028,7 @@ func TestMectNFTTransfer_ProcessBuiltinFunctionCrossShardsFixOldLiquidityIssueWi
 
 	vmInput, sender, nftTransferSenderShard, mectDataStorageHandler, _, _ := createSetupToSendNFTCrossShard(t)
 
-	mectDataStorageHandler.flagFixOldTokenLiquidity.SetValue(false)
+	mectDataStorageHandler.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsFixOldTokenLiquidityEnabledField = false
 	_, err := nftTransferSenderShard.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
 	require.Equal(t, err, ErrInvalidLiquidityForMECT)
 }
@@ -1037,9 +1042,9 @@ func createSetupToSendNFTCrossShard(t *testing.T) (*vmcommon.ContractCallInput,
 
 	nftTransferSenderShard, mectDataStorageHandler := createNFTTransferAndStorageHandler(1, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = nftTransferSenderShard.SetPayableChecker(payableHandler)
-	mectDataStorageHandler.flagSendAlwaysEnableEpoch.SetValue(true)
-	mectDataStorageHandler.flagSaveToSystemAccount.SetValue(true)
-	mectDataStorageHandler.flagCheckFrozenCollection.SetValue(true)
+	mectDataStorageHandler.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSendAlwaysFlagEnabledField = true
+	mectDataStorageHandler.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsSaveToSystemAccountFlagEnabledField = true
+	mectDataStorageHandler.enableEpochsHandler.(*mock.EnableEpochsHandlerStub).IsCheckFrozenCollectionFlagEnabledField = true
 
 	senderAddress := bytes.Repeat([]byte{1}, 32)
 	destinationAddress := bytes.Repeat([]byte{2}, 32)
@@ -1076,3 +1081,43 @@ func createSetupToSendNFTCrossShard(t *testing.T) (*vmcommon.ContractCallInput,
 
 	return vmInput, sender, nftTransferSenderShard, mectDataStorageHandler, tokenName, tokenNonce
 }
+
+func TestMectNFTTransfer_EstimateGasShouldMatchConsumedGas(t *testing.T) {
+	t.Parallel()
+
+	nftTransfer := createNftTransferWithMockArguments(0, 2, &mock.GlobalSettingsHandlerStub{})
+	gasCost := createMockGasCost()
+	nftTransfer.SetNewGasConfig(&gasCost)
+	_ = nftTransfer.SetPayableChecker(&mock.PayableHandlerStub{})
+
+	senderAddress := bytes.Repeat([]byte{2}, 32)
+	destinationAddress := bytes.Repeat([]byte{1}, 32)
+	sender, _ := nftTransfer.accounts.LoadAccount(senderAddress)
+
+	tokenName := []byte("token")
+	tokenNonce := uint64(1)
+	createMECTNFTToken(tokenName, core.NonFungible, tokenNonce, big.NewInt(3), nftTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			Arguments:   [][]byte{tokenName, big.NewInt(int64(tokenNonce)).Bytes(), big.NewInt(2).Bytes(), destinationAddress},
+			GasProvided: 100000,
+		},
+		RecipientAddr: senderAddress,
+	}
+
+	estimatedGas, err := nftTransfer.EstimateGas(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	assert.True(t, estimatedGas > gasCost.BuiltInCost.MECTNFTTransfer)
+
+	vmOutput, err := nftTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmInput.GasProvided-vmOutput.GasRemaining, estimatedGas)
+
+	vmInput.RecipientAddr = destinationAddress
+	estimatedGas, err = nftTransfer.EstimateGas(nil, nil, vmInput)
+	require.Nil(t, err)
+	assert.Zero(t, estimatedGas)
+}
@@ -0,0 +1,381 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ME-MotherEarth/me-core/core/check"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// maxNonceBytesLength is the length of the biggest nonce suffix of a MECT token key
+const maxNonceBytesLength = 8
+
+// ArgsNewMECTPortfolioReader defines the arguments needed to create the MECT portfolio reader
+type ArgsNewMECTPortfolioReader struct {
+	Accounts            vmcommon.AccountsAdapter
+	Marshaller          vmcommon.Marshalizer
+	EnableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+type mectPortfolioReader struct {
+	accounts            vmcommon.AccountsAdapter
+	marshaller          vmcommon.Marshalizer
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+type mectTokenEntry struct {
+	tokenID []byte
+	nonce   uint64
+	data    *mect.MECToken
+}
+
+// NewMECTPortfolioReader creates a component which enumerates the MECT tokens and roles of an account
+func NewMECTPortfolioReader(args ArgsNewMECTPortfolioReader) (*mectPortfolioReader, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+
+	return &mectPortfolioReader{
+		accounts:            args.Accounts,
+		marshaller:          args.Marshaller,
+		enableEpochsHandler: args.EnableEpochsHandler,
+	}, nil
+}
+
+// GetMECTPortfolio returns the fungible balances, the NFT holdings, the locked balances, the roles and the mint quotas
+// saved on the account. The account data handler has to implement vmcommon.AccountDataIterator
+func (r *mectPortfolioReader) GetMECTPortfolio(account vmcommon.UserAccountHandler) (*vmcommon.MECTPortfolio, error) {
+	if check.IfNil(account) {
+		return nil, ErrNilUserAccount
+	}
+	iterator, ok := account.AccountDataHandler().(vmcommon.AccountDataIterator)
+	if !ok {
+		return nil, ErrAccountDataIterationNotSupported
+	}
+
+	entries, err := r.readTokenEntries(iterator)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio := &vmcommon.MECTPortfolio{
+		Fungible: make([]*vmcommon.MECTFungibleBalance, 0),
+		NFTs:     make([]*vmcommon.MECTNFTHolding, 0),
+	}
+	err = r.addTokenEntries(portfolio, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio.Locked, err = r.readLockedBalances(iterator)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio.Roles, err = r.readRoles(iterator)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio.MintQuotas, err = r.readMintQuotas(iterator)
+	if err != nil {
+		return nil, err
+	}
+
+	return portfolio, nil
+}
+
+func (r *mectPortfolioReader) readTokenEntries(iterator vmcommon.AccountDataIterator) ([]*mectTokenEntry, error) {
+	entries := make([]*mectTokenEntry, 0)
+	var errUnmarshal error
+	err := iterator.IterateKeysWithPrefix([]byte(baseMECTKeyPrefix), func(key []byte, value []byte) bool {
+		tokenID, nonce, isTokenKey := splitMECTTokenKey(key[len(baseMECTKeyPrefix):])
+		if !isTokenKey || len(value) == 0 {
+			return true
+		}
+
+		mectData := &mect.MECToken{Value: big.NewInt(0)}
+		errUnmarshal = r.marshaller.Unmarshal(mectData, value)
+		if errUnmarshal != nil {
+			return false
+		}
+
+		entries = append(entries, &mectTokenEntry{
+			tokenID: append([]byte{}, tokenID...),
+			nonce:   nonce,
+			data:    mectData,
+		})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if errUnmarshal != nil {
+		return nil, errUnmarshal
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		compare := bytes.Compare(entries[i].tokenID, entries[j].tokenID)
+		if compare != 0 {
+			return compare < 0
+		}
+		return entries[i].nonce < entries[j].nonce
+	})
+
+	return entries, nil
+}
+
+func (r *mectPortfolioReader) addTokenEntries(portfolio *vmcommon.MECTPortfolio, entries []*mectTokenEntry) error {
+	frozenCollections := make(map[string]bool)
+	collections := make(map[string]struct{})
+	for _, entry := range entries {
+		if entry.nonce == 0 {
+			frozenCollections[string(entry.tokenID)] = MECTUserMetadataFromBytes(entry.data.Properties).Frozen
+			continue
+		}
+		collections[string(entry.tokenID)] = struct{}{}
+	}
+
+	var systemAcc vmcommon.UserAccountHandler
+	for _, entry := range entries {
+		isFrozen := MECTUserMetadataFromBytes(entry.data.Properties).Frozen
+		if entry.nonce == 0 {
+			_, isCollection := collections[string(entry.tokenID)]
+			if isCollection {
+				// the collection entry only holds the collection wide properties
+				continue
+			}
+
+			portfolio.Fungible = append(portfolio.Fungible, &vmcommon.MECTFungibleBalance{
+				TokenID: entry.tokenID,
+				Value:   entry.data.Value,
+				Frozen:  isFrozen,
+			})
+			continue
+		}
+
+		metaData := entry.data.TokenMetaData
+		if metaData == nil {
+			var err error
+			if systemAcc == nil {
+				systemAcc, err = r.getSystemAccount()
+				if err != nil {
+					return err
+				}
+			}
+			metaData, err = r.getMetaDataFromSystemAccount(systemAcc, entry.tokenID, entry.nonce)
+			if err != nil {
+				return err
+			}
+		}
+
+		portfolio.NFTs = append(portfolio.NFTs, &vmcommon.MECTNFTHolding{
+			TokenID:  entry.tokenID,
+			Nonce:    entry.nonce,
+			Type:     entry.data.Type,
+			Value:    entry.data.Value,
+			Frozen:   isFrozen || frozenCollections[string(entry.tokenID)],
+			MetaData: metaData,
+		})
+	}
+
+	return nil
+}
+
+func (r *mectPortfolioReader) getMetaDataFromSystemAccount(
+	systemAcc vmcommon.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+) (*mect.MetaData, error) {
+	mectTokenKey := append([]byte(baseMECTKeyPrefix), tokenID...)
+	marshaledData, err := systemAcc.AccountDataHandler().RetrieveValue(computeMECTNFTTokenKey(mectTokenKey, nonce))
+	if err != nil || len(marshaledData) == 0 {
+		return nil, nil
+	}
+
+	mectData := &mect.MECToken{}
+	err = r.marshaller.Unmarshal(mectData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return mectData.TokenMetaData, nil
+}
+
+func (r *mectPortfolioReader) readLockedBalances(iterator vmcommon.AccountDataIterator) ([]*vmcommon.MECTLockedBalance, error) {
+	lockedBalances := make([]*vmcommon.MECTLockedBalance, 0)
+	var errDecode error
+	err := iterator.IterateKeysWithPrefix(vestingKeyPrefix, func(key []byte, value []byte) bool {
+		tokenID, nonce, isTokenKey := splitMECTTokenKey(key[len(vestingKeyPrefix):])
+		if !isTokenKey || len(value) == 0 {
+			return true
+		}
+
+		var schedules []*vmcommon.MECTVestingSchedule
+		schedules, errDecode = deserializeVestingSchedules(value)
+		if errDecode != nil {
+			return false
+		}
+
+		lockedBalances = append(lockedBalances, newLockedBalance(append([]byte{}, tokenID...), nonce, schedules))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if errDecode != nil {
+		return nil, errDecode
+	}
+
+	sort.SliceStable(lockedBalances, func(i, j int) bool {
+		compare := bytes.Compare(lockedBalances[i].TokenID, lockedBalances[j].TokenID)
+		if compare != 0 {
+			return compare < 0
+		}
+		return lockedBalances[i].Nonce < lockedBalances[j].Nonce
+	})
+
+	return lockedBalances, nil
+}
+
+// readRoles returns the roles saved on the account, including the expired roles which were not dropped yet
+func (r *mectPortfolioReader) readRoles(iterator vmcommon.AccountDataIterator) ([]*vmcommon.MECTHeldRoles, error) {
+	heldRoles := make([]*vmcommon.MECTHeldRoles, 0)
+	var errUnmarshal error
+	err := iterator.IterateKeysWithPrefix(roleKeyPrefix, func(key []byte, value []byte) bool {
+		if len(value) == 0 {
+			return true
+		}
+
+		var rolesWithExpiry *mectRolesWithExpiry
+		rolesWithExpiry, errUnmarshal = decodeMECTRoles(r.marshaller, value)
+		if errUnmarshal != nil {
+			return false
+		}
+		if len(rolesWithExpiry.roles.Roles) == 0 {
+			return true
+		}
+
+		heldRoles = append(heldRoles, &vmcommon.MECTHeldRoles{
+			TokenID: append([]byte{}, key[len(roleKeyPrefix):]...),
+			Roles:   rolesWithExpiry.roles.Roles,
+		})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if errUnmarshal != nil {
+		return nil, errUnmarshal
+	}
+
+	sort.SliceStable(heldRoles, func(i, j int) bool {
+		return bytes.Compare(heldRoles[i].TokenID, heldRoles[j].TokenID) < 0
+	})
+
+	return heldRoles, nil
+}
+
+// readMintQuotas returns the mint quotas saved on the account, the remaining amounts depending on the epoch they are
+// queried in, as provided by vmcommon.MECTMintQuota.RemainingAt
+func (r *mectPortfolioReader) readMintQuotas(iterator vmcommon.AccountDataIterator) ([]*vmcommon.MECTMintQuota, error) {
+	mintQuotas := make([]*vmcommon.MECTMintQuota, 0)
+	var errDecode error
+	err := iterator.IterateKeysWithPrefix(mintQuotaKeyPrefix, func(key []byte, value []byte) bool {
+		if len(value) == 0 {
+			return true
+		}
+
+		var quota *vmcommon.MECTMintQuota
+		quota, errDecode = deserializeMintQuota(append([]byte{}, key[len(mintQuotaKeyPrefix):]...), value)
+		if errDecode != nil {
+			return false
+		}
+
+		mintQuotas = append(mintQuotas, quota)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if errDecode != nil {
+		return nil, errDecode
+	}
+
+	sort.SliceStable(mintQuotas, func(i, j int) bool {
+		return bytes.Compare(mintQuotas[i].TokenID, mintQuotas[j].TokenID) < 0
+	})
+
+	return mintQuotas, nil
+}
+
+// GetRemainingMintQuota returns the amount of the token the account can still mint in the current epoch, or nil if
+// the mint quotas are not active or the account does not have a mint quota for the token
+func (r *mectPortfolioReader) GetRemainingMintQuota(address []byte, tokenID []byte) (*big.Int, error) {
+	if !r.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTMintQuotaFlag) {
+		return nil, nil
+	}
+
+	account, err := r.loadUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := getMintQuota(account, tokenID)
+	if err != nil || quota == nil {
+		return nil, err
+	}
+
+	return quota.RemainingAt(r.enableEpochsHandler.GetCurrentEpoch()), nil
+}
+
+func (r *mectPortfolioReader) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	return r.loadUserAccount(vmcommon.SystemAccountAddress)
+}
+
+func (r *mectPortfolioReader) loadUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	account, err := r.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+// splitMECTTokenKey separates the token identifier from the nonce suffix of a MECT token key.
+// The nonce bytes can contain the separator so the identifier ends after the random chars of the first separator
+func splitMECTTokenKey(keySuffix []byte) ([]byte, uint64, bool) {
+	separatorIndex := bytes.Index(keySuffix, []byte(mectIdentifierSeparator))
+	if separatorIndex < 0 {
+		return nil, 0, false
+	}
+
+	tokenIDLen := separatorIndex + len(mectIdentifierSeparator) + mectRandomSequenceLength
+	if len(keySuffix) < tokenIDLen || len(keySuffix)-tokenIDLen > maxNonceBytesLength {
+		return nil, 0, false
+	}
+
+	tokenID := keySuffix[:tokenIDLen]
+	nonce := big.NewInt(0).SetBytes(keySuffix[tokenIDLen:]).Uint64()
+
+	return tokenID, nonce, true
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (r *mectPortfolioReader) IsInterfaceNil() bool {
+	return r == nil
+}
@@ -0,0 +1,250 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPortfolioReaderArgs(systemAcc vmcommon.UserAccountHandler) ArgsNewMECTPortfolioReader {
+	return ArgsNewMECTPortfolioReader{
+		Accounts: &mock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return systemAcc, nil
+			},
+		},
+		Marshaller:          &mock.MarshalizerMock{},
+		EnableEpochsHandler: &mock.EnableEpochsHandlerStub{IsMECTMintQuotaFlagEnabledField: true},
+	}
+}
+
+func saveMECTokenForPortfolio(t *testing.T, account vmcommon.UserAccountHandler, tokenID []byte, nonce uint64, mectData *mect.MECToken) {
+	marshaledData, err := (&mock.MarshalizerMock{}).Marshal(mectData)
+	require.Nil(t, err)
+
+	mectTokenKey := append([]byte(baseMECTKeyPrefix), tokenID...)
+	err = account.AccountDataHandler().SaveKeyValue(computeMECTNFTTokenKey(mectTokenKey, nonce), marshaledData)
+	require.Nil(t, err)
+}
+
+func TestNewMECTPortfolioReader(t *testing.T) {
+	t.Parallel()
+
+	args := createPortfolioReaderArgs(nil)
+	args.Accounts = nil
+	reader, err := NewMECTPortfolioReader(args)
+	assert.Nil(t, reader)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	args = createPortfolioReaderArgs(nil)
+	args.Marshaller = nil
+	reader, err = NewMECTPortfolioReader(args)
+	assert.Nil(t, reader)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createPortfolioReaderArgs(nil)
+	args.EnableEpochsHandler = nil
+	reader, err = NewMECTPortfolioReader(args)
+	assert.Nil(t, reader)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
+
+	reader, err = NewMECTPortfolioReader(createPortfolioReaderArgs(nil))
+	assert.Nil(t, err)
+	assert.False(t, reader.IsInterfaceNil())
+}
+
+func TestMectPortfolioReader_GetMECTPortfolioIterationNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	reader, _ := NewMECTPortfolioReader(createPortfolioReaderArgs(nil))
+	account := &mock.UserAccountStub{
+		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
+			return &iterationNotSupportedDataHandler{}
+		},
+	}
+
+	portfolio, err := reader.GetMECTPortfolio(account)
+	assert.Nil(t, portfolio)
+	assert.Equal(t, ErrAccountDataIterationNotSupported, err)
+
+	portfolio, err = reader.GetMECTPortfolio(nil)
+	assert.Nil(t, portfolio)
+	assert.Equal(t, ErrNilUserAccount, err)
+}
+
+func TestMectPortfolioReader_GetMECTPortfolioIterationErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	reader, _ := NewMECTPortfolioReader(createPortfolioReaderArgs(nil))
+	account := &mock.UserAccountStub{
+		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
+			return &mock.DataTrieTrackerStub{
+				IterateKeysWithPrefixCalled: func(prefix []byte, handler func(key []byte, value []byte) bool) error {
+					return expectedErr
+				},
+			}
+		},
+	}
+
+	portfolio, err := reader.GetMECTPortfolio(account)
+	assert.Nil(t, portfolio)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestMectPortfolioReader_GetMECTPortfolioShouldWork(t *testing.T) {
+	t.Parallel()
+
+	systemAcc := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	reader, _ := NewMECTPortfolioReader(createPortfolioReaderArgs(systemAcc))
+	account := mock.NewUserAccount([]byte("holder"))
+
+	fungibleToken := []byte("FNG-abcdef")
+	frozenToken := []byte("FRZ-abcdef")
+	nftToken := []byte("NFT-abcdef")
+	sftToken := []byte("SFT-abcdef")
+	frozenMetadata := MECTUserMetadata{Frozen: true}
+
+	saveMECTokenForPortfolio(t, account, fungibleToken, 0, &mect.MECToken{Value: big.NewInt(100)})
+	saveMECTokenForPortfolio(t, account, frozenToken, 0, &mect.MECToken{Value: big.NewInt(5), Properties: frozenMetadata.ToBytes()})
+
+	// nonce 45 is the separator char and must not break the identifier parsing
+	nftMetaData := &mect.MetaData{Nonce: 45, Name: []byte("nft")}
+	saveMECTokenForPortfolio(t, account, nftToken, 45, &mect.MECToken{
+		Type:          uint32(core.NonFungible),
+		Value:         big.NewInt(1),
+		TokenMetaData: nftMetaData,
+	})
+
+	sftMetaData := &mect.MetaData{Nonce: 300, Name: []byte("sft")}
+	saveMECTokenForPortfolio(t, account, sftToken, 0, &mect.MECToken{Value: big.NewInt(0), Properties: frozenMetadata.ToBytes()})
+	saveMECTokenForPortfolio(t, account, sftToken, 300, &mect.MECToken{Type: uint32(core.NonFungible), Value: big.NewInt(7)})
+	saveMECTokenForPortfolio(t, systemAcc, sftToken, 300, &mect.MECToken{Type: uint32(core.NonFungible), TokenMetaData: sftMetaData})
+
+	rolesData, _ := (&mock.MarshalizerMock{}).Marshal(&mect.MECTRoles{Roles: [][]byte{[]byte(core.MECTRoleLocalMint)}})
+	_ = account.AccountDataHandler().SaveKeyValue(append(roleKeyPrefix, fungibleToken...), rolesData)
+	_ = account.AccountDataHandler().SaveKeyValue(append(noncePrefix, nftToken...), big.NewInt(45).Bytes())
+
+	lockedSchedule := &vmcommon.MECTVestingSchedule{Amount: big.NewInt(40), Claimed: big.NewInt(10), CliffEpoch: 2, EndEpoch: 8}
+	_ = saveVestingSchedules(account, fungibleToken, 0, []*vmcommon.MECTVestingSchedule{lockedSchedule})
+
+	mintQuota := &vmcommon.MECTMintQuota{TokenID: fungibleToken, Amount: big.NewInt(500), Minted: big.NewInt(20), Epoch: 3, PerEpoch: true}
+	_ = account.AccountDataHandler().SaveKeyValue(computeMintQuotaKey(fungibleToken), serializeMintQuota(mintQuota))
+
+	portfolio, err := reader.GetMECTPortfolio(account)
+	require.Nil(t, err)
+
+	assert.Equal(t, []*vmcommon.MECTLockedBalance{
+		{TokenID: fungibleToken, Nonce: 0, Locked: big.NewInt(30), Schedules: []*vmcommon.MECTVestingSchedule{lockedSchedule}},
+	}, portfolio.Locked)
+	assert.Equal(t, []*vmcommon.MECTFungibleBalance{
+		{TokenID: fungibleToken, Value: big.NewInt(100), Frozen: false},
+		{TokenID: frozenToken, Value: big.NewInt(5), Frozen: true},
+	}, portfolio.Fungible)
+	assert.Equal(t, []*vmcommon.MECTNFTHolding{
+		{TokenID: nftToken, Nonce: 45, Type: uint32(core.NonFungible), Value: big.NewInt(1), Frozen: false, MetaData: nftMetaData},
+		{TokenID: sftToken, Nonce: 300, Type: uint32(core.NonFungible), Value: big.NewInt(7), Frozen: true, MetaData: sftMetaData},
+	}, portfolio.NFTs)
+	assert.Equal(t, []*vmcommon.MECTHeldRoles{
+		{TokenID: fungibleToken, Roles: [][]byte{[]byte(core.MECTRoleLocalMint)}},
+	}, portfolio.Roles)
+	assert.Equal(t, []*vmcommon.MECTMintQuota{mintQuota}, portfolio.MintQuotas)
+}
+
+func TestMectPortfolioReader_GetRemainingMintQuota(t *testing.T) {
+	t.Parallel()
+
+	tokenID := []byte("TKN-abcdef")
+	minter := mock.NewUserAccount([]byte("minter"))
+	args := createPortfolioReaderArgs(minter)
+	enableEpochsHandler := &mock.EnableEpochsHandlerStub{IsMECTMintQuotaFlagEnabledField: true, CurrentEpochField: 3}
+	args.EnableEpochsHandler = enableEpochsHandler
+	reader, _ := NewMECTPortfolioReader(args)
+
+	remaining, err := reader.GetRemainingMintQuota(minter.AddressBytes(), tokenID)
+	assert.Nil(t, err)
+	assert.Nil(t, remaining)
+
+	mintQuota := &vmcommon.MECTMintQuota{TokenID: tokenID, Amount: big.NewInt(500), Minted: big.NewInt(20), Epoch: 3, PerEpoch: true}
+	_ = minter.AccountDataHandler().SaveKeyValue(computeMintQuotaKey(tokenID), serializeMintQuota(mintQuota))
+	remaining, err = reader.GetRemainingMintQuota(minter.AddressBytes(), tokenID)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(480), remaining)
+
+	enableEpochsHandler.CurrentEpochField = 4
+	remaining, err = reader.GetRemainingMintQuota(minter.AddressBytes(), tokenID)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(500), remaining)
+
+	enableEpochsHandler.IsMECTMintQuotaFlagEnabledField = false
+	remaining, err = reader.GetRemainingMintQuota(minter.AddressBytes(), tokenID)
+	assert.Nil(t, err)
+	assert.Nil(t, remaining)
+}
+
+func TestMectPortfolioReader_GetRemainingMintQuotaErrors(t *testing.T) {
+	t.Parallel()
+
+	loadErr := errors.New("load error")
+	args := createPortfolioReaderArgs(nil)
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return nil, loadErr
+		},
+	}
+	reader, _ := NewMECTPortfolioReader(args)
+	remaining, err := reader.GetRemainingMintQuota([]byte("minter"), []byte("TKN-abcdef"))
+	assert.Nil(t, remaining)
+	assert.Equal(t, loadErr, err)
+
+	retrieveErr := errors.New("retrieve error")
+	reader, _ = NewMECTPortfolioReader(createPortfolioReaderArgs(createAccountWithRetrieveValueError(retrieveErr)))
+	remaining, err = reader.GetRemainingMintQuota([]byte("minter"), []byte("TKN-abcdef"))
+	assert.Nil(t, remaining)
+	assert.Equal(t, retrieveErr, err)
+}
+
+func TestSplitMECTTokenKey(t *testing.T) {
+	t.Parallel()
+
+	tokenID, nonce, ok := splitMECTTokenKey([]byte("TKN-abcdef"))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("TKN-abcdef"), tokenID)
+	assert.Equal(t, uint64(0), nonce)
+
+	tokenID, nonce, ok = splitMECTTokenKey(append([]byte("TKN-abcdef"), '-', 1))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("TKN-abcdef"), tokenID)
+	assert.Equal(t, uint64(0x2d01), nonce)
+
+	_, _, ok = splitMECTTokenKey([]byte("TKNabcdef"))
+	assert.False(t, ok)
+
+	_, _, ok = splitMECTTokenKey([]byte("TKN-abc"))
+	assert.False(t, ok)
+
+	_, _, ok = splitMECTTokenKey(append([]byte("TKN-abcdef"), make([]byte, maxNonceBytesLength+1)...))
+	assert.False(t, ok)
+}
+
+type iterationNotSupportedDataHandler struct {
+}
+
+func (handler *iterationNotSupportedDataHandler) RetrieveValue(_ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (handler *iterationNotSupportedDataHandler) SaveKeyValue(_ []byte, _ []byte) error {
+	return nil
+}
+
+func (handler *iterationNotSupportedDataHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
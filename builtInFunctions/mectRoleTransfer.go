@@ -0,0 +1,313 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+type mectRoleTransfer struct {
+	*baseActiveHandler
+	marshaller          vmcommon.Marshalizer
+	accounts            vmcommon.AccountsAdapter
+	shardCoordinator    vmcommon.Coordinator
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+// NewMECTRoleTransferFunc returns the mect role transfer built-in function component
+func NewMECTRoleTransferFunc(
+	marshaller vmcommon.Marshalizer,
+	accounts vmcommon.AccountsAdapter,
+	shardCoordinator vmcommon.Coordinator,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	activeHandler func() bool,
+) (*mectRoleTransfer, error) {
+	if check.IfNil(marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
+
+	e := &mectRoleTransfer{
+		marshaller:          marshaller,
+		accounts:            accounts,
+		shardCoordinator:    shardCoordinator,
+		enableEpochsHandler: enableEpochsHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectRoleTransfer) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves MECT role transfer function call
+// When called by the MECT system SC on the current owner it requires the following arguments:
+// arg0 - token identifier
+// arg1 - address of the next owner
+// arg2... - roles to be moved, the NFTCreate role being moved by MECTNFTCreateRoleTransfer together with its nonce
+// The roles are granted directly to a next owner in the same shard. A next owner in another shard receives them from
+// the current owner through an output transfer, which holds the roles followed by their expiry epochs:
+// arg0 - token identifier
+// arg1, arg2... - pairs of role and expiry epoch
+// That call is only accepted from a user account located in another shard. A user account can not send it otherwise, as
+// its own shard executes the built-in function first and refuses it, while smart contracts may emit any call data.
+func (e *mectRoleTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkBasicMECTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if !check.IfNil(acntSnd) {
+		return nil, ErrInvalidArguments
+	}
+	if check.IfNil(acntDst) {
+		return nil, ErrNilUserAccount
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	if bytes.Equal(vmInput.CallerAddr, core.MECTSCAddress) {
+		outAcc, errExec := e.executeRoleTransferAtCurrentOwner(vmOutput, acntDst, vmInput)
+		if errExec != nil {
+			return nil, errExec
+		}
+		if outAcc != nil {
+			vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+			vmOutput.OutputAccounts[string(outAcc.Address)] = outAcc
+		}
+	} else {
+		err = e.executeRoleTransferAtNextOwner(vmOutput, acntDst, vmInput)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vmOutput, nil
+}
+
+func (e *mectRoleTransfer) executeRoleTransferAtCurrentOwner(
+	vmOutput *vmcommon.VMOutput,
+	acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.OutputAccount, error) {
+	if len(vmInput.Arguments) < 3 {
+		return nil, ErrInvalidArguments
+	}
+	destAddress := vmInput.Arguments[1]
+	if len(destAddress) != len(vmInput.CallerAddr) {
+		return nil, ErrInvalidArguments
+	}
+	if bytes.Equal(destAddress, acntDst.AddressBytes()) {
+		return nil, fmt.Errorf("%w, the roles can not be transferred to the current owner", ErrInvalidArguments)
+	}
+	if !e.isInShard(destAddress) && vmcommon.IsSmartContractAddress(acntDst.AddressBytes()) {
+		return nil, fmt.Errorf("%w, the roles of a smart contract can only be transferred in its shard", ErrActionNotAllowed)
+	}
+
+	tokenID := vmInput.Arguments[0]
+	roles := vmInput.Arguments[2:]
+	err := checkTransferableRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+
+	mectTokenRoleKey := append(roleKeyPrefix, tokenID...)
+	currentRoles, _, err := getMECTRolesWithExpiryForAcnt(e.marshaller, acntDst, mectTokenRoleKey)
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch := e.enableEpochsHandler.GetCurrentEpoch()
+	currentRoles.deleteExpiredRoles(currentEpoch)
+	expiryEpochs := make([]uint32, 0, len(roles))
+	for _, role := range roles {
+		index, exist := doesRoleExist(currentRoles.roles, role)
+		if !exist {
+			return nil, fmt.Errorf("%w, the current owner does not hold the role %s", ErrActionNotAllowed, role)
+		}
+		expiryEpochs = append(expiryEpochs, currentRoles.expiryEpochs[index])
+	}
+
+	currentRoles.deleteRoles(roles)
+	err = saveRolesWithExpiryToAccount(acntDst, mectTokenRoleKey, currentRoles, e.marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	logData := append([][]byte{acntDst.AddressBytes(), boolToSlice(false)}, roles...)
+	addMECTEntryInVMOutput(vmOutput, []byte(vmInput.Function), tokenID, 0, big.NewInt(0), logData...)
+
+	if e.isInShard(destAddress) {
+		newDestinationAcc, errLoad := e.accounts.LoadAccount(destAddress)
+		if errLoad != nil {
+			return nil, errLoad
+		}
+		newDestUserAcc, ok := newDestinationAcc.(vmcommon.UserAccountHandler)
+		if !ok {
+			return nil, ErrWrongTypeAssertion
+		}
+
+		err = e.addRolesToAccount(newDestUserAcc, mectTokenRoleKey, roles, expiryEpochs, currentEpoch)
+		if err != nil {
+			return nil, err
+		}
+
+		err = e.accounts.SaveAccount(newDestUserAcc)
+		if err != nil {
+			return nil, err
+		}
+
+		logData = append([][]byte{destAddress, boolToSlice(true)}, roles...)
+		addMECTEntryInVMOutput(vmOutput, []byte(vmInput.Function), tokenID, 0, big.NewInt(0), logData...)
+
+		return nil, nil
+	}
+
+	callData := vmcommon.BuiltInFunctionMECTRoleTransfer + "@" + hex.EncodeToString(tokenID)
+	for i, role := range roles {
+		callData += "@" + hex.EncodeToString(role) + "@" + hex.EncodeToString(big.NewInt(0).SetUint64(uint64(expiryEpochs[i])).Bytes())
+	}
+
+	outAcc := &vmcommon.OutputAccount{
+		Address:         destAddress,
+		Balance:         big.NewInt(0),
+		BalanceDelta:    big.NewInt(0),
+		OutputTransfers: make([]vmcommon.OutputTransfer, 0),
+	}
+	outTransfer := vmcommon.OutputTransfer{
+		Value:         big.NewInt(0),
+		Data:          []byte(callData),
+		SenderAddress: acntDst.AddressBytes(),
+	}
+	outAcc.OutputTransfers = append(outAcc.OutputTransfers, outTransfer)
+
+	return outAcc, nil
+}
+
+func (e *mectRoleTransfer) isInShard(address []byte) bool {
+	return e.shardCoordinator.ComputeId(address) == e.shardCoordinator.SelfId()
+}
+
+func checkTransferableRoles(roles [][]byte) error {
+	for i, role := range roles {
+		if bytes.Equal(role, []byte(core.MECTRoleNFTCreate)) {
+			return fmt.Errorf("%w, the %s role is transferred by %s", ErrInvalidArguments, core.MECTRoleNFTCreate, core.BuiltInFunctionMECTNFTCreateRoleTransfer)
+		}
+		for _, previousRole := range roles[:i] {
+			if bytes.Equal(role, previousRole) {
+				return fmt.Errorf("%w, duplicated role %s", ErrInvalidArguments, role)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *mectRoleTransfer) executeRoleTransferAtNextOwner(
+	vmOutput *vmcommon.VMOutput,
+	acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) error {
+	if len(vmInput.Arguments) < 3 || len(vmInput.Arguments)%2 == 0 {
+		return ErrInvalidArguments
+	}
+	if e.isInShard(vmInput.CallerAddr) || vmcommon.IsSmartContractAddress(vmInput.CallerAddr) {
+		return fmt.Errorf("%w, the roles can only be received from a user account in another shard", ErrActionNotAllowed)
+	}
+
+	tokenID := vmInput.Arguments[0]
+	numRoles := (len(vmInput.Arguments) - 1) / 2
+	roles := make([][]byte, 0, numRoles)
+	expiryEpochs := make([]uint32, 0, numRoles)
+	for i := 1; i < len(vmInput.Arguments); i += 2 {
+		expiryEpoch := big.NewInt(0).SetBytes(vmInput.Arguments[i+1])
+		if !expiryEpoch.IsUint64() || expiryEpoch.Uint64() > math.MaxUint32 {
+			return ErrInvalidArguments
+		}
+
+		roles = append(roles, vmInput.Arguments[i])
+		expiryEpochs = append(expiryEpochs, uint32(expiryEpoch.Uint64()))
+	}
+	err := checkTransferableRoles(roles)
+	if err != nil {
+		return err
+	}
+
+	mectTokenRoleKey := append(roleKeyPrefix, tokenID...)
+	err = e.addRolesToAccount(acntDst, mectTokenRoleKey, roles, expiryEpochs, e.enableEpochsHandler.GetCurrentEpoch())
+	if err != nil {
+		return err
+	}
+
+	logData := append([][]byte{acntDst.AddressBytes(), boolToSlice(true)}, roles...)
+	addMECTEntryInVMOutput(vmOutput, []byte(vmInput.Function), tokenID, 0, big.NewInt(0), logData...)
+
+	return nil
+}
+
+// addRolesToAccount grants the moved roles with their expiry epochs. A role already held without expiry is not granted
+// twice, and the roles which expired while being transferred cross shard are dropped.
+func (e *mectRoleTransfer) addRolesToAccount(
+	acntDst vmcommon.UserAccountHandler,
+	mectTokenRoleKey []byte,
+	roles [][]byte,
+	expiryEpochs []uint32,
+	currentEpoch uint32,
+) error {
+	rolesWithExpiry, _, err := getMECTRolesWithExpiryForAcnt(e.marshaller, acntDst, mectTokenRoleKey)
+	if err != nil {
+		return err
+	}
+
+	rolesWithExpiry.deleteExpiredRoles(currentEpoch)
+	for i, role := range roles {
+		if isRoleExpired(expiryEpochs[i], currentEpoch) {
+			continue
+		}
+		index, exist := doesRoleExist(rolesWithExpiry.roles, role)
+		if exist && rolesWithExpiry.expiryEpochs[index] == noRoleExpiry {
+			continue
+		}
+
+		rolesWithExpiry.setRole(role, expiryEpochs[i])
+	}
+
+	return saveRolesWithExpiryToAccount(acntDst, mectTokenRoleKey, rolesWithExpiry, e.marshaller)
+}
+
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectRoleTransfer) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectRoleTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
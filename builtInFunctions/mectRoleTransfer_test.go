@@ -0,0 +1,255 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMECTRoleTransferComponent(t *testing.T, shardCoordinator vmcommon.Coordinator, currentEpoch uint32) *mectRoleTransfer {
+	mapAccounts := make(map[string]vmcommon.UserAccountHandler)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			_, ok := mapAccounts[string(address)]
+			if !ok {
+				mapAccounts[string(address)] = mock.NewUserAccount(address)
+			}
+			return mapAccounts[string(address)], nil
+		},
+	}
+
+	e, err := NewMECTRoleTransferFunc(&mock.MarshalizerMock{}, accounts, shardCoordinator, &mock.EnableEpochsHandlerStub{CurrentEpochField: currentEpoch}, trueHandler)
+	require.Nil(t, err)
+	return e
+}
+
+func getRolesWithExpiryOfAddress(t *testing.T, e *mectRoleTransfer, address []byte, tokenID []byte) *mectRolesWithExpiry {
+	acc, _ := e.accounts.LoadAccount(address)
+	roles, _, err := getMECTRolesWithExpiryForAcnt(e.marshaller, acc.(vmcommon.UserAccountHandler), append(roleKeyPrefix, tokenID...))
+	require.Nil(t, err)
+	return roles
+}
+
+func TestNewMECTRoleTransferFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewMECTRoleTransferFunc(nil, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2), &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, e)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	e, err = NewMECTRoleTransferFunc(&mock.MarshalizerMock{}, nil, mock.NewMultiShardsCoordinatorMock(2), &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, e)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	e, err = NewMECTRoleTransferFunc(&mock.MarshalizerMock{}, &mock.AccountsStub{}, nil, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, e)
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	e, err = NewMECTRoleTransferFunc(&mock.MarshalizerMock{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2), nil, trueHandler)
+	assert.Nil(t, e)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
+
+	e, err = NewMECTRoleTransferFunc(&mock.MarshalizerMock{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2), &mock.EnableEpochsHandlerStub{}, nil)
+	assert.Nil(t, e)
+	assert.Equal(t, ErrNilActiveHandler, err)
+
+	e, err = NewMECTRoleTransferFunc(&mock.MarshalizerMock{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2), &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, err)
+	assert.False(t, e.IsInterfaceNil())
+	assert.True(t, e.IsActive())
+}
+
+func TestMECTRoleTransfer_ProcessWithErrors(t *testing.T) {
+	t.Parallel()
+
+	e := createMECTRoleTransferComponent(t, mock.NewMultiShardsCoordinatorMock(2), 0)
+	tokenID := []byte("TKN-abcdef")
+	currentOwner := bytes.Repeat([]byte{1}, 32)
+	acc, _ := e.accounts.LoadAccount(currentOwner)
+	userAcc := acc.(vmcommon.UserAccountHandler)
+
+	_, err := e.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	vmInput := &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{CallValue: big.NewInt(0)}}
+	vmInput.Arguments = [][]byte{tokenID, []byte(core.MECTRoleLocalMint), {}}
+	_, err = e.ProcessBuiltinFunction(userAcc, userAcc, vmInput)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	_, err = e.ProcessBuiltinFunction(nil, nil, vmInput)
+	assert.Equal(t, ErrNilUserAccount, err)
+
+	vmInput.Arguments = [][]byte{tokenID, []byte(core.MECTRoleLocalMint)}
+	_, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	vmInput.CallerAddr = core.MECTSCAddress
+	vmInput.Arguments = [][]byte{tokenID, []byte("short"), []byte(core.MECTRoleLocalMint)}
+	_, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	vmInput.Arguments = [][]byte{tokenID, currentOwner, []byte(core.MECTRoleLocalMint)}
+	_, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	newOwner := bytes.Repeat([]byte{2}, 32)
+	vmInput.Arguments = [][]byte{tokenID, newOwner, []byte(core.MECTRoleNFTCreate)}
+	_, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	vmInput.Arguments = [][]byte{tokenID, newOwner, []byte(core.MECTRoleLocalMint), []byte(core.MECTRoleLocalMint)}
+	_, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	vmInput.Arguments = [][]byte{tokenID, newOwner, []byte(core.MECTRoleLocalMint)}
+	_, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	assert.ErrorIs(t, err, ErrActionNotAllowed)
+}
+
+func TestMECTRoleTransfer_ProcessAtCurrentShard(t *testing.T) {
+	t.Parallel()
+
+	e := createMECTRoleTransferComponent(t, mock.NewMultiShardsCoordinatorMock(2), 5)
+	tokenID := []byte("TKN-abcdef")
+	currentOwner := bytes.Repeat([]byte{1}, 32)
+	newOwner := bytes.Repeat([]byte{2}, 32)
+
+	acc, _ := e.accounts.LoadAccount(currentOwner)
+	userAcc := acc.(vmcommon.UserAccountHandler)
+	roles := newEmptyMECTRolesWithExpiry()
+	roles.setRole([]byte(core.MECTRoleLocalMint), noRoleExpiry)
+	roles.setRole([]byte(core.MECTRoleLocalBurn), 10)
+	roles.setRole([]byte(core.MECTRoleNFTAddURI), noRoleExpiry)
+	err := saveRolesWithExpiryToAccount(userAcc, append(roleKeyPrefix, tokenID...), roles, e.marshaller)
+	require.Nil(t, err)
+
+	vmInput := &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{CallValue: big.NewInt(0), CallerAddr: core.MECTSCAddress}}
+	vmInput.Function = vmcommon.BuiltInFunctionMECTRoleTransfer
+	vmInput.Arguments = [][]byte{tokenID, newOwner, []byte(core.MECTRoleLocalMint), []byte(core.MECTRoleLocalBurn)}
+	vmOutput, err := e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+	require.Equal(t, 2, len(vmOutput.Logs))
+	assert.Equal(t, currentOwner, vmOutput.Logs[0].Address)
+	assert.Equal(t, [][]byte{tokenID, {}, {}, boolToSlice(false), []byte(core.MECTRoleLocalMint), []byte(core.MECTRoleLocalBurn)}, vmOutput.Logs[0].Topics)
+	assert.Equal(t, newOwner, vmOutput.Logs[1].Address)
+	assert.Equal(t, boolToSlice(true), vmOutput.Logs[1].Topics[3])
+
+	currentOwnerRoles := getRolesWithExpiryOfAddress(t, e, currentOwner, tokenID)
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleNFTAddURI)}, currentOwnerRoles.roles.Roles)
+
+	newOwnerRoles := getRolesWithExpiryOfAddress(t, e, newOwner, tokenID)
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleLocalMint), []byte(core.MECTRoleLocalBurn)}, newOwnerRoles.roles.Roles)
+	assert.Equal(t, []uint32{noRoleExpiry, 10}, newOwnerRoles.expiryEpochs)
+}
+
+func TestMECTRoleTransfer_ProcessCrossShard(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1]) % 2
+	}
+	e := createMECTRoleTransferComponent(t, shardCoordinator, 5)
+	tokenID := []byte("TKN-abcdef")
+	currentOwner := bytes.Repeat([]byte{2}, 32)
+	newOwner := bytes.Repeat([]byte{1}, 32)
+
+	acc, _ := e.accounts.LoadAccount(currentOwner)
+	userAcc := acc.(vmcommon.UserAccountHandler)
+	roles := newEmptyMECTRolesWithExpiry()
+	roles.setRole([]byte(core.MECTRoleNFTAddQuantity), 20)
+	err := saveRolesWithExpiryToAccount(userAcc, append(roleKeyPrefix, tokenID...), roles, e.marshaller)
+	require.Nil(t, err)
+
+	vmInput := &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{CallValue: big.NewInt(0), CallerAddr: core.MECTSCAddress}}
+	vmInput.Arguments = [][]byte{tokenID, newOwner, []byte(core.MECTRoleNFTAddQuantity)}
+	vmOutput, err := e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(getRolesWithExpiryOfAddress(t, e, currentOwner, tokenID).roles.Roles))
+
+	outAcc := vmOutput.OutputAccounts[string(newOwner)]
+	require.NotNil(t, outAcc)
+	expectedData := vmcommon.BuiltInFunctionMECTRoleTransfer + "@" + hex.EncodeToString(tokenID) + "@" +
+		hex.EncodeToString([]byte(core.MECTRoleNFTAddQuantity)) + "@" + hex.EncodeToString([]byte{20})
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+	assert.Equal(t, currentOwner, outAcc.OutputTransfers[0].SenderAddress)
+
+	shardCoordinator.CurrentShard = 1
+	acc, _ = e.accounts.LoadAccount(newOwner)
+	userAcc = acc.(vmcommon.UserAccountHandler)
+	vmInput.CallerAddr = currentOwner
+	vmInput.Arguments = [][]byte{tokenID, []byte(core.MECTRoleNFTAddQuantity)}
+	_, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	vmInput.Arguments = [][]byte{tokenID, []byte(core.MECTRoleNFTAddQuantity), {20}, []byte(core.MECTRoleLocalBurn), {4}}
+	vmOutput, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+	assert.Equal(t, 1, len(vmOutput.Logs))
+
+	newOwnerRoles := getRolesWithExpiryOfAddress(t, e, newOwner, tokenID)
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleNFTAddQuantity)}, newOwnerRoles.roles.Roles)
+	assert.Equal(t, []uint32{20}, newOwnerRoles.expiryEpochs)
+}
+
+func TestMECTRoleTransfer_ProcessAtNextOwnerFromUnexpectedCallerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1]) % 2
+	}
+	shardCoordinator.CurrentShard = 1
+	e := createMECTRoleTransferComponent(t, shardCoordinator, 5)
+	tokenID := []byte("TKN-abcdef")
+	newOwner := bytes.Repeat([]byte{1}, 32)
+	acc, _ := e.accounts.LoadAccount(newOwner)
+	userAcc := acc.(vmcommon.UserAccountHandler)
+
+	crossShardContract := make([]byte, 32)
+	crossShardContract[10] = 1
+	sameShardUser := bytes.Repeat([]byte{3}, 32)
+	for _, caller := range [][]byte{crossShardContract, sameShardUser} {
+		vmInput := &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{CallValue: big.NewInt(0), CallerAddr: caller}}
+		vmInput.Arguments = [][]byte{tokenID, []byte(core.MECTRoleLocalMint), {}}
+		_, err := e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+		assert.ErrorIs(t, err, ErrActionNotAllowed)
+	}
+	assert.Equal(t, 0, len(getRolesWithExpiryOfAddress(t, e, newOwner, tokenID).roles.Roles))
+}
+
+func TestMECTRoleTransfer_ProcessCrossShardFromSmartContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1]) % 2
+	}
+	e := createMECTRoleTransferComponent(t, shardCoordinator, 5)
+	tokenID := []byte("TKN-abcdef")
+	currentOwner := make([]byte, 32)
+	currentOwner[10] = 1
+	newOwner := bytes.Repeat([]byte{1}, 32)
+
+	acc, _ := e.accounts.LoadAccount(currentOwner)
+	userAcc := acc.(vmcommon.UserAccountHandler)
+	roles := newEmptyMECTRolesWithExpiry()
+	roles.setRole([]byte(core.MECTRoleLocalMint), noRoleExpiry)
+	err := saveRolesWithExpiryToAccount(userAcc, append(roleKeyPrefix, tokenID...), roles, e.marshaller)
+	require.Nil(t, err)
+
+	vmInput := &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{CallValue: big.NewInt(0), CallerAddr: core.MECTSCAddress}}
+	vmInput.Arguments = [][]byte{tokenID, newOwner, []byte(core.MECTRoleLocalMint)}
+	_, err = e.ProcessBuiltinFunction(nil, userAcc, vmInput)
+	assert.ErrorIs(t, err, ErrActionNotAllowed)
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleLocalMint)}, getRolesWithExpiryOfAddress(t, e, currentOwner, tokenID).roles.Roles)
+}
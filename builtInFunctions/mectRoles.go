@@ -2,6 +2,7 @@ package builtInFunctions
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"math/big"
 
@@ -14,23 +15,57 @@ import (
 var roleKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + core.MECTRoleIdentifier + core.MECTKeyIdentifier)
 
 type mectRoles struct {
-	baseAlwaysActive
-	set        bool
-	marshaller vmcommon.Marshalizer
+	*baseActiveHandler
+	set                 bool
+	withExpiry          bool
+	marshaller          vmcommon.Marshalizer
+	enableEpochsHandler vmcommon.EnableEpochsHandler
 }
 
 // NewMECTRolesFunc returns the mect change roles built-in function component
 func NewMECTRolesFunc(
 	marshaller vmcommon.Marshalizer,
 	set bool,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+) (*mectRoles, error) {
+	return newMECTRolesFunc(marshaller, set, false, enableEpochsHandler, trueHandler)
+}
+
+// NewMECTRolesWithExpiryFunc returns the mect set roles with expiry built-in function component
+func NewMECTRolesWithExpiryFunc(
+	marshaller vmcommon.Marshalizer,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	activeHandler func() bool,
+) (*mectRoles, error) {
+	return newMECTRolesFunc(marshaller, true, true, enableEpochsHandler, activeHandler)
+}
+
+func newMECTRolesFunc(
+	marshaller vmcommon.Marshalizer,
+	set bool,
+	withExpiry bool,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	activeHandler func() bool,
 ) (*mectRoles, error) {
 	if check.IfNil(marshaller) {
 		return nil, ErrNilMarshalizer
 	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
 
 	e := &mectRoles{
-		set:        set,
-		marshaller: marshaller,
+		set:                 set,
+		withExpiry:          withExpiry,
+		marshaller:          marshaller,
+		enableEpochsHandler: enableEpochsHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
 	}
 
 	return e, nil
@@ -40,7 +75,8 @@ func NewMECTRolesFunc(
 func (e *mectRoles) SetNewGasConfig(_ *vmcommon.GasCost) {
 }
 
-// ProcessBuiltinFunction resolves MECT change roles function call
+// ProcessBuiltinFunction resolves MECT change roles function call. MECTSetRoleWithExpiry receives the epoch from which
+// the roles are expired as second argument. The already expired roles of the account are dropped on every change.
 func (e *mectRoles) ProcessBuiltinFunction(
 	_, acntDst vmcommon.UserAccountHandler,
 	vmInput *vmcommon.ContractCallInput,
@@ -56,20 +92,34 @@ func (e *mectRoles) ProcessBuiltinFunction(
 		return nil, ErrNilUserAccount
 	}
 
+	currentEpoch := e.enableEpochsHandler.GetCurrentEpoch()
+	rolesArguments := vmInput.Arguments[1:]
+	expiryEpoch := noRoleExpiry
+	if e.withExpiry {
+		expiryEpoch, err = getRoleExpiryEpoch(vmInput.Arguments, currentEpoch)
+		if err != nil {
+			return nil, err
+		}
+		rolesArguments = vmInput.Arguments[2:]
+	}
+
 	mectTokenRoleKey := append(roleKeyPrefix, vmInput.Arguments[0]...)
 
-	roles, _, err := getMECTRolesForAcnt(e.marshaller, acntDst, mectTokenRoleKey)
+	roles, _, err := getMECTRolesWithExpiryForAcnt(e.marshaller, acntDst, mectTokenRoleKey)
 	if err != nil {
 		return nil, err
 	}
 
+	roles.deleteExpiredRoles(currentEpoch)
 	if e.set {
-		roles.Roles = append(roles.Roles, vmInput.Arguments[1:]...)
+		for _, role := range rolesArguments {
+			roles.setRole(role, expiryEpoch)
+		}
 	} else {
-		deleteRoles(roles, vmInput.Arguments[1:])
+		roles.deleteRoles(rolesArguments)
 	}
 
-	for _, arg := range vmInput.Arguments[1:] {
+	for _, arg := range rolesArguments {
 		if !bytes.Equal(arg, []byte(core.MECTRoleNFTCreateMultiShard)) {
 			continue
 		}
@@ -82,7 +132,7 @@ func (e *mectRoles) ProcessBuiltinFunction(
 		break
 	}
 
-	err = saveRolesToAccount(acntDst, mectTokenRoleKey, roles, e.marshaller)
+	err = saveRolesWithExpiryToAccount(acntDst, mectTokenRoleKey, roles, e.marshaller)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +145,22 @@ func (e *mectRoles) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+func getRoleExpiryEpoch(arguments [][]byte, currentEpoch uint32) (uint32, error) {
+	if len(arguments) < 3 {
+		return 0, ErrInvalidArguments
+	}
+
+	expiryEpoch := big.NewInt(0).SetBytes(arguments[1])
+	if !expiryEpoch.IsUint64() || expiryEpoch.Uint64() > math.MaxUint32 {
+		return 0, fmt.Errorf("%w, invalid role expiry epoch", ErrInvalidArguments)
+	}
+	if uint32(expiryEpoch.Uint64()) <= currentEpoch {
+		return 0, fmt.Errorf("%w, role expiry epoch is not in the future", ErrInvalidArguments)
+	}
+
+	return uint32(expiryEpoch.Uint64()), nil
+}
+
 // Nonces on multi shard NFT create are from (LastByte * MaxUint64 / 256), this is in order to differentiate them
 // even like this, if one contract makes 1000 NFT create on each block, it would need 14 million years to occupy the whole space
 // 2 ^ 64 / 256 / 1000 / 14400 / 365 ~= 14 million
@@ -148,28 +214,37 @@ func getMECTRolesForAcnt(
 	return roles, false, nil
 }
 
-// CheckAllowedToExecute returns error if the account is not allowed to execute the given action
+// CheckAllowedToExecute returns error if the account is not allowed to execute the given action. The expired roles are
+// treated as absent
 func (e *mectRoles) CheckAllowedToExecute(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 	if check.IfNil(account) {
 		return ErrNilUserAccount
 	}
 
 	mectTokenRoleKey := append(roleKeyPrefix, tokenID...)
-	roles, isNew, err := getMECTRolesForAcnt(e.marshaller, account, mectTokenRoleKey)
+	roles, isNew, err := getMECTRolesWithExpiryForAcnt(e.marshaller, account, mectTokenRoleKey)
 	if err != nil {
 		return err
 	}
 	if isNew {
 		return ErrActionNotAllowed
 	}
-	_, exist := doesRoleExist(roles, action)
-	if !exist {
+	if !roles.hasActiveRole(action, e.enableEpochsHandler.GetCurrentEpoch()) {
 		return ErrActionNotAllowed
 	}
 
 	return nil
 }
 
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectRoles) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectRoles) IsInterfaceNil() bool {
 	return e == nil
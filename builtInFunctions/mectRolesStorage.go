@@ -0,0 +1,206 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// rolesWithExpiryVersion marks the role storage format which keeps an expiry epoch for each role. It is followed by the
+// length prefixed marshalled MECTRoles and by the expiry epochs of the roles, in the same order. The legacy format,
+// the marshalled MECTRoles, never starts with this byte and it is still used while no role has an expiry epoch
+const rolesWithExpiryVersion = byte(1)
+
+// noRoleExpiry is the expiry epoch of the roles which are granted until they are unset
+const noRoleExpiry = uint32(0)
+
+const lengthOfRoleExpiry = 4
+
+// mectRolesWithExpiry are the roles of an account for a token together with the epoch from which each role is expired
+type mectRolesWithExpiry struct {
+	roles        *mect.MECTRoles
+	expiryEpochs []uint32
+}
+
+func newEmptyMECTRolesWithExpiry() *mectRolesWithExpiry {
+	return &mectRolesWithExpiry{
+		roles: &mect.MECTRoles{
+			Roles: make([][]byte, 0),
+		},
+		expiryEpochs: make([]uint32, 0),
+	}
+}
+
+func getMECTRolesWithExpiryForAcnt(
+	marshaller vmcommon.Marshalizer,
+	acnt vmcommon.UserAccountHandler,
+	key []byte,
+) (*mectRolesWithExpiry, bool, error) {
+	marshaledData, err := acnt.AccountDataHandler().RetrieveValue(key)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(marshaledData) == 0 {
+		return newEmptyMECTRolesWithExpiry(), true, nil
+	}
+
+	rolesWithExpiry, err := decodeMECTRoles(marshaller, marshaledData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return rolesWithExpiry, false, nil
+}
+
+func decodeMECTRoles(marshaller vmcommon.Marshalizer, buff []byte) (*mectRolesWithExpiry, error) {
+	rolesWithExpiry := newEmptyMECTRolesWithExpiry()
+	if len(buff) == 0 || buff[0] != rolesWithExpiryVersion {
+		err := marshaller.Unmarshal(rolesWithExpiry.roles, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		rolesWithExpiry.expiryEpochs = make([]uint32, len(rolesWithExpiry.roles.Roles))
+		return rolesWithExpiry, nil
+	}
+
+	buff = buff[1:]
+	if len(buff) < lengthPrefixSize {
+		return nil, ErrInvalidRolesData
+	}
+	rolesLength := int(binary.BigEndian.Uint32(buff[:lengthPrefixSize]))
+	buff = buff[lengthPrefixSize:]
+	if len(buff) < rolesLength {
+		return nil, ErrInvalidRolesData
+	}
+
+	err := marshaller.Unmarshal(rolesWithExpiry.roles, buff[:rolesLength])
+	if err != nil {
+		return nil, err
+	}
+	buff = buff[rolesLength:]
+	if len(buff) != len(rolesWithExpiry.roles.Roles)*lengthOfRoleExpiry {
+		return nil, ErrInvalidRolesData
+	}
+
+	rolesWithExpiry.expiryEpochs = make([]uint32, len(rolesWithExpiry.roles.Roles))
+	for i := range rolesWithExpiry.expiryEpochs {
+		rolesWithExpiry.expiryEpochs[i] = binary.BigEndian.Uint32(buff[i*lengthOfRoleExpiry:])
+	}
+
+	return rolesWithExpiry, nil
+}
+
+func (r *mectRolesWithExpiry) encode(marshaller vmcommon.Marshalizer) ([]byte, error) {
+	marshaledRoles, err := marshaller.Marshal(r.roles)
+	if err != nil {
+		return nil, err
+	}
+	if !r.hasExpiringRoles() {
+		return marshaledRoles, nil
+	}
+
+	buff := make([]byte, 1+lengthPrefixSize, 1+lengthPrefixSize+len(marshaledRoles)+len(r.expiryEpochs)*lengthOfRoleExpiry)
+	buff[0] = rolesWithExpiryVersion
+	binary.BigEndian.PutUint32(buff[1:], uint32(len(marshaledRoles)))
+	buff = append(buff, marshaledRoles...)
+	for _, expiryEpoch := range r.expiryEpochs {
+		buff = binary.BigEndian.AppendUint32(buff, expiryEpoch)
+	}
+
+	return buff, nil
+}
+
+func (r *mectRolesWithExpiry) hasExpiringRoles() bool {
+	for _, expiryEpoch := range r.expiryEpochs {
+		if expiryEpoch != noRoleExpiry {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setRole grants the role until the expiry epoch. As in the legacy format, a role granted without expiry is appended
+// even if the account already holds it, unless the held role was an expiring one which becomes permanent. A role held
+// without expiry is never downgraded to an expiring one.
+func (r *mectRolesWithExpiry) setRole(role []byte, expiryEpoch uint32) {
+	found := false
+	for i, currentRole := range r.roles.Roles {
+		if !bytes.Equal(currentRole, role) {
+			continue
+		}
+		if r.expiryEpochs[i] == noRoleExpiry {
+			found = found || expiryEpoch != noRoleExpiry
+			continue
+		}
+
+		r.expiryEpochs[i] = expiryEpoch
+		found = true
+	}
+	if found {
+		return
+	}
+
+	r.roles.Roles = append(r.roles.Roles, role)
+	r.expiryEpochs = append(r.expiryEpochs, expiryEpoch)
+}
+
+func (r *mectRolesWithExpiry) deleteRoles(deleteRoles [][]byte) {
+	for _, deleteRole := range deleteRoles {
+		index, exist := doesRoleExist(r.roles, deleteRole)
+		if !exist {
+			continue
+		}
+
+		r.deleteRoleAtIndex(index)
+	}
+}
+
+func (r *mectRolesWithExpiry) deleteRoleAtIndex(index int) {
+	copy(r.roles.Roles[index:], r.roles.Roles[index+1:])
+	r.roles.Roles[len(r.roles.Roles)-1] = nil
+	r.roles.Roles = r.roles.Roles[:len(r.roles.Roles)-1]
+
+	copy(r.expiryEpochs[index:], r.expiryEpochs[index+1:])
+	r.expiryEpochs = r.expiryEpochs[:len(r.expiryEpochs)-1]
+}
+
+// deleteExpiredRoles drops the roles which are expired in the provided epoch
+func (r *mectRolesWithExpiry) deleteExpiredRoles(currentEpoch uint32) {
+	for i := len(r.expiryEpochs) - 1; i >= 0; i-- {
+		if isRoleExpired(r.expiryEpochs[i], currentEpoch) {
+			r.deleteRoleAtIndex(i)
+		}
+	}
+}
+
+func (r *mectRolesWithExpiry) hasActiveRole(role []byte, currentEpoch uint32) bool {
+	for i, currentRole := range r.roles.Roles {
+		if bytes.Equal(currentRole, role) && !isRoleExpired(r.expiryEpochs[i], currentEpoch) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRoleExpired(expiryEpoch uint32, currentEpoch uint32) bool {
+	return expiryEpoch != noRoleExpiry && currentEpoch >= expiryEpoch
+}
+
+func saveRolesWithExpiryToAccount(
+	acntDst vmcommon.UserAccountHandler,
+	mectTokenRoleKey []byte,
+	rolesWithExpiry *mectRolesWithExpiry,
+	marshaller vmcommon.Marshalizer,
+) error {
+	marshaledData, err := rolesWithExpiry.encode(marshaller)
+	if err != nil {
+		return err
+	}
+
+	return acntDst.AccountDataHandler().SaveKeyValue(mectTokenRoleKey, marshaledData)
+}
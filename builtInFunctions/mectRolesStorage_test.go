@@ -0,0 +1,125 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMectRolesWithExpiry_EncodeWithoutExpiryShouldKeepLegacyFormat(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	rolesWithExpiry := newEmptyMECTRolesWithExpiry()
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalMint), noRoleExpiry)
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalBurn), noRoleExpiry)
+
+	encoded, err := rolesWithExpiry.encode(marshaller)
+	require.Nil(t, err)
+
+	expected, _ := marshaller.Marshal(&mect.MECTRoles{Roles: [][]byte{[]byte(core.MECTRoleLocalMint), []byte(core.MECTRoleLocalBurn)}})
+	assert.Equal(t, expected, encoded)
+
+	decoded, err := decodeMECTRoles(marshaller, encoded)
+	require.Nil(t, err)
+	assert.Equal(t, rolesWithExpiry, decoded)
+}
+
+func TestMectRolesWithExpiry_EncodeWithExpiryShouldBeReversible(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	rolesWithExpiry := newEmptyMECTRolesWithExpiry()
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalMint), noRoleExpiry)
+	rolesWithExpiry.setRole([]byte(core.MECTRoleNFTCreate), 25)
+
+	encoded, err := rolesWithExpiry.encode(marshaller)
+	require.Nil(t, err)
+	assert.Equal(t, rolesWithExpiryVersion, encoded[0])
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 25}, encoded[len(encoded)-8:])
+
+	decoded, err := decodeMECTRoles(marshaller, encoded)
+	require.Nil(t, err)
+	assert.Equal(t, rolesWithExpiry, decoded)
+
+	_, err = decodeMECTRoles(marshaller, encoded[:len(encoded)-1])
+	assert.Equal(t, ErrInvalidRolesData, err)
+	_, err = decodeMECTRoles(marshaller, encoded[:3])
+	assert.Equal(t, ErrInvalidRolesData, err)
+	_, err = decodeMECTRoles(marshaller, encoded[:10])
+	assert.Equal(t, ErrInvalidRolesData, err)
+}
+
+func TestMectRolesWithExpiry_SetRole(t *testing.T) {
+	t.Parallel()
+
+	rolesWithExpiry := newEmptyMECTRolesWithExpiry()
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalMint), 10)
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalMint), 20)
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleLocalMint)}, rolesWithExpiry.roles.Roles)
+	assert.Equal(t, []uint32{20}, rolesWithExpiry.expiryEpochs)
+
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalMint), noRoleExpiry)
+	assert.Equal(t, []uint32{noRoleExpiry}, rolesWithExpiry.expiryEpochs)
+	assert.False(t, rolesWithExpiry.hasExpiringRoles())
+
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalMint), 30)
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleLocalMint)}, rolesWithExpiry.roles.Roles)
+	assert.Equal(t, []uint32{noRoleExpiry}, rolesWithExpiry.expiryEpochs)
+
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalMint), noRoleExpiry)
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleLocalMint), []byte(core.MECTRoleLocalMint)}, rolesWithExpiry.roles.Roles)
+}
+
+func TestMectRolesWithExpiry_DeleteExpiredRoles(t *testing.T) {
+	t.Parallel()
+
+	rolesWithExpiry := newEmptyMECTRolesWithExpiry()
+	rolesWithExpiry.setRole([]byte(core.MECTRoleNFTCreate), 10)
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalMint), noRoleExpiry)
+	rolesWithExpiry.setRole([]byte(core.MECTRoleLocalBurn), 11)
+
+	assert.True(t, rolesWithExpiry.hasActiveRole([]byte(core.MECTRoleNFTCreate), 9))
+	assert.False(t, rolesWithExpiry.hasActiveRole([]byte(core.MECTRoleNFTCreate), 10))
+
+	rolesWithExpiry.deleteExpiredRoles(10)
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleLocalMint), []byte(core.MECTRoleLocalBurn)}, rolesWithExpiry.roles.Roles)
+	assert.Equal(t, []uint32{noRoleExpiry, 11}, rolesWithExpiry.expiryEpochs)
+
+	rolesWithExpiry.deleteRoles([][]byte{[]byte(core.MECTRoleLocalMint)})
+	assert.Equal(t, [][]byte{[]byte(core.MECTRoleLocalBurn)}, rolesWithExpiry.roles.Roles)
+	assert.Equal(t, []uint32{11}, rolesWithExpiry.expiryEpochs)
+}
+
+func createAccountWithRetrieveValueError(retrieveErr error) vmcommon.UserAccountHandler {
+	return &mock.UserAccountStub{
+		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
+			return &mock.DataTrieTrackerStub{
+				RetrieveValueCalled: func(_ []byte) ([]byte, error) {
+					return nil, retrieveErr
+				},
+			}
+		},
+	}
+}
+
+func TestGetMECTRolesWithExpiryForAcnt(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	roles, isNew, err := getMECTRolesWithExpiryForAcnt(marshaller, mock.NewUserAccount([]byte("addr")), []byte("key"))
+	require.Nil(t, err)
+	assert.True(t, isNew)
+	assert.Empty(t, roles.roles.Roles)
+
+	retrieveErr := errors.New("retrieve error")
+	roles, _, err = getMECTRolesWithExpiryForAcnt(marshaller, createAccountWithRetrieveValueError(retrieveErr), []byte("key"))
+	assert.Nil(t, roles)
+	assert.Equal(t, retrieveErr, err)
+}
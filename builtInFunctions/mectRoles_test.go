@@ -17,7 +17,7 @@ import (
 func TestNewMECTRolesFunc_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	mectRolesF, err := NewMECTRolesFunc(nil, false)
+	mectRolesF, err := NewMECTRolesFunc(nil, false, &mock.EnableEpochsHandlerStub{})
 
 	require.Equal(t, ErrNilMarshalizer, err)
 	require.Nil(t, mectRolesF)
@@ -26,7 +26,7 @@ func TestNewMECTRolesFunc_NilMarshalizerShouldErr(t *testing.T) {
 func TestMectRoles_ProcessBuiltinFunction_NilVMInputShouldErr(t *testing.T) {
 	t.Parallel()
 
-	mectRolesF, _ := NewMECTRolesFunc(nil, false)
+	mectRolesF, _ := NewMECTRolesFunc(nil, false, &mock.EnableEpochsHandlerStub{})
 
 	_, err := mectRolesF.ProcessBuiltinFunction(nil, &mock.UserAccountStub{}, nil)
 	require.Equal(t, ErrNilVmInput, err)
@@ -35,7 +35,7 @@ func TestMectRoles_ProcessBuiltinFunction_NilVMInputShouldErr(t *testing.T) {
 func TestMectRoles_ProcessBuiltinFunction_WrongCalledShouldErr(t *testing.T) {
 	t.Parallel()
 
-	mectRolesF, _ := NewMECTRolesFunc(nil, false)
+	mectRolesF, _ := NewMECTRolesFunc(nil, false, &mock.EnableEpochsHandlerStub{})
 
 	_, err := mectRolesF.ProcessBuiltinFunction(nil, &mock.UserAccountStub{}, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -50,7 +50,7 @@ func TestMectRoles_ProcessBuiltinFunction_WrongCalledShouldErr(t *testing.T) {
 func TestMectRoles_ProcessBuiltinFunction_NilAccountDestShouldErr(t *testing.T) {
 	t.Parallel()
 
-	mectRolesF, _ := NewMECTRolesFunc(nil, false)
+	mectRolesF, _ := NewMECTRolesFunc(nil, false, &mock.EnableEpochsHandlerStub{})
 
 	_, err := mectRolesF.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -65,7 +65,7 @@ func TestMectRoles_ProcessBuiltinFunction_NilAccountDestShouldErr(t *testing.T)
 func TestMectRoles_ProcessBuiltinFunction_GetRolesFailShouldErr(t *testing.T) {
 	t.Parallel()
 
-	mectRolesF, _ := NewMECTRolesFunc(&mock.MarshalizerMock{Fail: true}, false)
+	mectRolesF, _ := NewMECTRolesFunc(&mock.MarshalizerMock{Fail: true}, false, &mock.EnableEpochsHandlerStub{})
 
 	_, err := mectRolesF.ProcessBuiltinFunction(nil, &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -89,7 +89,7 @@ func TestMectRoles_ProcessBuiltinFunction_GetRolesFailShouldWorkEvenIfAccntTrieI
 	t.Parallel()
 
 	saveKeyWasCalled := false
-	mectRolesF, _ := NewMECTRolesFunc(&mock.MarshalizerMock{}, false)
+	mectRolesF, _ := NewMECTRolesFunc(&mock.MarshalizerMock{}, false, &mock.EnableEpochsHandlerStub{})
 
 	_, err := mectRolesF.ProcessBuiltinFunction(nil, &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -118,7 +118,7 @@ func TestMectRoles_ProcessBuiltinFunction_SetRolesShouldWork(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, true)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, true, &mock.EnableEpochsHandlerStub{})
 
 	acc := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -150,7 +150,7 @@ func TestMectRoles_ProcessBuiltinFunction_SetRolesMultiNFT(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, true)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, true, &mock.EnableEpochsHandlerStub{})
 
 	tokenID := []byte("tokenID")
 	roleKey := append(roleKeyPrefix, tokenID...)
@@ -199,7 +199,7 @@ func TestMectRoles_ProcessBuiltinFunction_SaveFailedShouldErr(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, true)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, true, &mock.EnableEpochsHandlerStub{})
 
 	localErr := errors.New("local err")
 	acc := &mock.UserAccountStub{
@@ -229,7 +229,7 @@ func TestMectRoles_ProcessBuiltinFunction_UnsetRolesDoesNotExistsShouldWork(t *t
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, false)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, false, &mock.EnableEpochsHandlerStub{})
 
 	acc := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -261,7 +261,7 @@ func TestMectRoles_ProcessBuiltinFunction_UnsetRolesShouldWork(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, false)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, false, &mock.EnableEpochsHandlerStub{})
 
 	acc := &mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -295,7 +295,7 @@ func TestMectRoles_CheckAllowedToExecuteNilAccountShouldErr(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, false)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, false, &mock.EnableEpochsHandlerStub{})
 
 	err := mectRolesF.CheckAllowedToExecute(nil, []byte("ID"), []byte(core.MECTRoleLocalBurn))
 	require.Equal(t, ErrNilUserAccount, err)
@@ -305,7 +305,7 @@ func TestMectRoles_CheckAllowedToExecuteCannotGetMECTRole(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{Fail: true}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, false)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, false, &mock.EnableEpochsHandlerStub{})
 
 	err := mectRolesF.CheckAllowedToExecute(&mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -323,7 +323,7 @@ func TestMectRoles_CheckAllowedToExecuteIsNewNotAllowed(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, false)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, false, &mock.EnableEpochsHandlerStub{})
 
 	err := mectRolesF.CheckAllowedToExecute(&mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -341,7 +341,7 @@ func TestMectRoles_CheckAllowed_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, false)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, false, &mock.EnableEpochsHandlerStub{})
 
 	err := mectRolesF.CheckAllowedToExecute(&mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -362,7 +362,7 @@ func TestMectRoles_CheckAllowedToExecuteRoleNotFind(t *testing.T) {
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	mectRolesF, _ := NewMECTRolesFunc(marshaller, false)
+	mectRolesF, _ := NewMECTRolesFunc(marshaller, false, &mock.EnableEpochsHandlerStub{})
 
 	err := mectRolesF.CheckAllowedToExecute(&mock.UserAccountStub{
 		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
@@ -378,3 +378,101 @@ func TestMectRoles_CheckAllowedToExecuteRoleNotFind(t *testing.T) {
 	}, []byte("ID"), []byte(core.MECTRoleLocalMint))
 	require.Equal(t, ErrActionNotAllowed, err)
 }
+
+func TestNewMECTRolesWithExpiryFunc(t *testing.T) {
+	t.Parallel()
+
+	mectRolesF, err := NewMECTRolesWithExpiryFunc(&mock.MarshalizerMock{}, nil, trueHandler)
+	require.Nil(t, mectRolesF)
+	require.Equal(t, ErrNilEnableEpochsHandler, err)
+
+	mectRolesF, err = NewMECTRolesWithExpiryFunc(&mock.MarshalizerMock{}, &mock.EnableEpochsHandlerStub{}, nil)
+	require.Nil(t, mectRolesF)
+	require.Equal(t, ErrNilActiveHandler, err)
+
+	mectRolesF, err = NewMECTRolesWithExpiryFunc(&mock.MarshalizerMock{}, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	require.Nil(t, err)
+	require.True(t, mectRolesF.set)
+	require.True(t, mectRolesF.withExpiry)
+	require.True(t, mectRolesF.IsActive())
+}
+
+func TestMectRoles_ProcessBuiltinFunction_SetRolesWithExpiry(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	enableEpochsHandler := &mock.EnableEpochsHandlerStub{CurrentEpochField: 10}
+	setRolesWithExpiry, _ := NewMECTRolesWithExpiryFunc(marshaller, enableEpochsHandler, trueHandler)
+	setRoles, _ := NewMECTRolesFunc(marshaller, true, enableEpochsHandler)
+	acc := mock.NewUserAccount([]byte("sale contract"))
+	tokenID := []byte("NFT-abcdef")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{tokenID, big.NewInt(10).Bytes(), []byte(core.MECTRoleNFTCreate)},
+		},
+	}
+
+	_, err := setRolesWithExpiry.ProcessBuiltinFunction(nil, acc, input)
+	require.True(t, errors.Is(err, ErrInvalidArguments))
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(12).Bytes()}
+	_, err = setRolesWithExpiry.ProcessBuiltinFunction(nil, acc, input)
+	require.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(12).Bytes(), []byte(core.MECTRoleNFTCreate), []byte(core.MECTRoleLocalMint)}
+	_, err = setRolesWithExpiry.ProcessBuiltinFunction(nil, acc, input)
+	require.Nil(t, err)
+
+	input.Arguments = [][]byte{tokenID, []byte(core.MECTRoleLocalBurn)}
+	_, err = setRoles.ProcessBuiltinFunction(nil, acc, input)
+	require.Nil(t, err)
+
+	require.Nil(t, setRoles.CheckAllowedToExecute(acc, tokenID, []byte(core.MECTRoleNFTCreate)))
+	require.Nil(t, setRoles.CheckAllowedToExecute(acc, tokenID, []byte(core.MECTRoleLocalMint)))
+
+	enableEpochsHandler.CurrentEpochField = 12
+	require.Equal(t, ErrActionNotAllowed, setRoles.CheckAllowedToExecute(acc, tokenID, []byte(core.MECTRoleNFTCreate)))
+	require.Equal(t, ErrActionNotAllowed, setRoles.CheckAllowedToExecute(acc, tokenID, []byte(core.MECTRoleLocalMint)))
+	require.Nil(t, setRoles.CheckAllowedToExecute(acc, tokenID, []byte(core.MECTRoleLocalBurn)))
+
+	input.Arguments = [][]byte{tokenID, []byte(core.MECTRoleLocalMint)}
+	_, err = setRoles.ProcessBuiltinFunction(nil, acc, input)
+	require.Nil(t, err)
+
+	roles, _, _ := getMECTRolesForAcnt(marshaller, acc, append(roleKeyPrefix, tokenID...))
+	require.Equal(t, [][]byte{[]byte(core.MECTRoleLocalBurn), []byte(core.MECTRoleLocalMint)}, roles.Roles)
+	require.Nil(t, setRoles.CheckAllowedToExecute(acc, tokenID, []byte(core.MECTRoleLocalMint)))
+}
+
+func TestMectRoles_ProcessBuiltinFunction_SetRoleWithExpiryShouldNotDowngradePermanentRole(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	enableEpochsHandler := &mock.EnableEpochsHandlerStub{CurrentEpochField: 10}
+	setRolesWithExpiry, _ := NewMECTRolesWithExpiryFunc(marshaller, enableEpochsHandler, trueHandler)
+	setRoles, _ := NewMECTRolesFunc(marshaller, true, enableEpochsHandler)
+	acc := mock.NewUserAccount([]byte("sale contract"))
+	tokenID := []byte("NFT-abcdef")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{tokenID, []byte(core.MECTRoleLocalMint)},
+		},
+	}
+
+	_, err := setRoles.ProcessBuiltinFunction(nil, acc, input)
+	require.Nil(t, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(12).Bytes(), []byte(core.MECTRoleLocalMint)}
+	_, err = setRolesWithExpiry.ProcessBuiltinFunction(nil, acc, input)
+	require.Nil(t, err)
+
+	enableEpochsHandler.CurrentEpochField = 12
+	require.Nil(t, setRoles.CheckAllowedToExecute(acc, tokenID, []byte(core.MECTRoleLocalMint)))
+
+	roles, _, _ := getMECTRolesForAcnt(marshaller, acc, append(roleKeyPrefix, tokenID...))
+	require.Equal(t, [][]byte{[]byte(core.MECTRoleLocalMint)}, roles.Roles)
+}
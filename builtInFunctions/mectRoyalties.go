@@ -0,0 +1,210 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// royaltyPayment is the part of a fungible payment that is routed to the royalties receiver of an NFT moving in the
+// same multi transfer
+type royaltyPayment struct {
+	paymentIndex int
+	tokenID      []byte
+	value        *big.Int
+	receiver     []byte
+	nftTokenID   []byte
+	nftNonce     uint64
+}
+
+// computeRoyaltyPayments returns the royalties due for the NFTs of collections with enforced royalties which are
+// transferred together with fungible payments. Every NFT takes its Royalties/MaxRoyalty share out of each payment,
+// so two NFTs with 10% royalties take 20% of the payment. No royalties are paid when returning the tokens of a
+// failed call.
+func (e *mectNFTMultiTransfer) computeRoyaltyPayments(
+	acntSnd vmcommon.UserAccountHandler,
+	listTransferData []*vmcommon.MECTTransfer,
+	isReturnWithError bool,
+) ([]*royaltyPayment, error) {
+	if !e.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTRoyaltiesFlag) {
+		return nil, nil
+	}
+	if isReturnWithError {
+		return nil, nil
+	}
+
+	hasNFTs := false
+	hasPayments := false
+	for _, transferData := range listTransferData {
+		if transferData.MECTTokenNonce > 0 {
+			hasNFTs = true
+			continue
+		}
+		hasPayments = hasPayments || transferData.MECTValue.Sign() > 0
+	}
+	if !hasNFTs || !hasPayments {
+		return nil, nil
+	}
+
+	payments := make([]*royaltyPayment, 0)
+	for _, transferData := range listTransferData {
+		if transferData.MECTTokenNonce == 0 {
+			continue
+		}
+
+		royalties, receiver, err := e.getRoyaltiesForNFT(acntSnd, transferData.MECTTokenName, transferData.MECTTokenNonce)
+		if err != nil {
+			return nil, err
+		}
+		if royalties == 0 || len(receiver) == 0 {
+			continue
+		}
+
+		for paymentIndex, paymentData := range listTransferData {
+			if paymentData.MECTTokenNonce > 0 || paymentData.MECTValue.Sign() <= 0 {
+				continue
+			}
+
+			value := big.NewInt(0).Mul(paymentData.MECTValue, big.NewInt(int64(royalties)))
+			value.Div(value, big.NewInt(int64(core.MaxRoyalty)))
+			if value.Sign() == 0 {
+				continue
+			}
+
+			payments = append(payments, &royaltyPayment{
+				paymentIndex: paymentIndex,
+				tokenID:      paymentData.MECTTokenName,
+				value:        value,
+				receiver:     receiver,
+				nftTokenID:   transferData.MECTTokenName,
+				nftNonce:     transferData.MECTTokenNonce,
+			})
+		}
+	}
+
+	return payments, nil
+}
+
+func (e *mectNFTMultiTransfer) getRoyaltiesForNFT(acntSnd vmcommon.UserAccountHandler, tokenID []byte, nonce uint64) (uint32, []byte, error) {
+	mectTokenKey := append(e.keyPrefix, tokenID...)
+	if !e.globalSettingsHandler.IsRoyaltiesEnforced(mectTokenKey) {
+		return 0, nil, nil
+	}
+
+	mectData, err := e.mectStorageHandler.GetMECTNFTTokenOnSender(acntSnd, mectTokenKey, nonce)
+	if err != nil {
+		return 0, nil, err
+	}
+	if mectData.TokenMetaData == nil {
+		return 0, nil, nil
+	}
+
+	receiver := e.globalSettingsHandler.GetRoyaltiesReceiver(tokenID)
+	if len(receiver) == 0 {
+		receiver = mectData.TokenMetaData.Creator
+	}
+
+	return mectData.TokenMetaData.Royalties, receiver, nil
+}
+
+// deductRoyaltiesFromPayments returns a copy of the transfer data holding the values sent to the destination, the
+// payments being lowered with the royalties routed to the royalties receivers. Royalties leaving nothing of a payment
+// for the destination are refused. The given transfer data is left untouched so that it still holds the gross values
+// if the transfer fails or has to be logged.
+func deductRoyaltiesFromPayments(listTransferData []*vmcommon.MECTTransfer, payments []*royaltyPayment) ([]*vmcommon.MECTTransfer, error) {
+	sentTransferData := make([]*vmcommon.MECTTransfer, len(listTransferData))
+	for i, transferData := range listTransferData {
+		sentData := *transferData
+		sentData.MECTValue = big.NewInt(0).Set(transferData.MECTValue)
+		sentTransferData[i] = &sentData
+	}
+
+	for _, payment := range payments {
+		paymentData := sentTransferData[payment.paymentIndex]
+		paymentData.MECTValue.Sub(paymentData.MECTValue, payment.value)
+		if paymentData.MECTValue.Sign() <= 0 {
+			return nil, ErrRoyaltiesExceedPayment
+		}
+	}
+
+	return sentTransferData, nil
+}
+
+// payRoyalties moves the royalties from the sender to the receivers located in the current shard and returns the
+// payments which have to be sent cross shard
+func (e *mectNFTMultiTransfer) payRoyalties(
+	acntSnd vmcommon.UserAccountHandler,
+	acntDst vmcommon.UserAccountHandler,
+	dstAddress []byte,
+	payments []*royaltyPayment,
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+) ([]*royaltyPayment, error) {
+	crossShardPayments := make([]*royaltyPayment, 0)
+	for _, payment := range payments {
+		addMECTEntryInVMOutput(
+			vmOutput,
+			[]byte(vmcommon.MECTRoyaltiesIdentifier),
+			payment.tokenID,
+			0,
+			payment.value,
+			vmInput.CallerAddr,
+			payment.receiver,
+			payment.nftTokenID,
+			big.NewInt(0).SetUint64(payment.nftNonce).Bytes(),
+		)
+
+		if bytes.Equal(payment.receiver, vmInput.CallerAddr) {
+			continue
+		}
+
+		mectTokenKey := append(e.keyPrefix, payment.tokenID...)
+		err := addToMECTBalance(acntSnd, mectTokenKey, big.NewInt(0).Neg(payment.value), e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(payment.receiver, dstAddress) && !check.IfNil(acntDst) {
+			err = addToMECTBalance(acntDst, mectTokenKey, payment.value, e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		acntReceiver, err := e.loadAccountIfInShard(payment.receiver)
+		if err != nil {
+			return nil, err
+		}
+		if check.IfNil(acntReceiver) {
+			crossShardPayments = append(crossShardPayments, payment)
+			continue
+		}
+
+		err = addToMECTBalance(acntReceiver, mectTokenKey, payment.value, e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+		err = e.accounts.SaveAccount(acntReceiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return crossShardPayments, nil
+}
+
+// addRoyaltiesOutputTransfers sends the cross shard royalties as regular MECT transfers. It must be called after the
+// output transfers of the multi transfer were created, as those are replacing the output accounts of the vm output.
+func addRoyaltiesOutputTransfers(
+	payments []*royaltyPayment,
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+) {
+	for _, payment := range payments {
+		addMECTTransferToOutputAccount(vmOutput, vmInput.CallerAddr, payment.receiver, payment.tokenID, payment.value, vmInput.CallType)
+	}
+}
@@ -0,0 +1,146 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+type mectSetMaxSupply struct {
+	*baseActiveHandler
+	accounts            vmcommon.AccountsAdapter
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+// NewMECTSetMaxSupplyFunc returns the mect set max supply built-in function component
+func NewMECTSetMaxSupplyFunc(
+	accounts vmcommon.AccountsAdapter,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	activeHandler func() bool,
+) (*mectSetMaxSupply, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
+
+	e := &mectSetMaxSupply{
+		accounts:            accounts,
+		enableEpochsHandler: enableEpochsHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectSetMaxSupply) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves MECT set max supply function call
+// Requires the following arguments:
+// arg0 - token identifier
+// arg1 - maximum supply
+// arg2 - optional SFT nonce, the maximum supply being set for the whole token if missing
+// The maximum supply can be set only once and it is split between the shards by the supply ledger. The ledger only
+// records the supply minted since its activation, so the maximum supply has to account for the supply minted before
+// and the function is refused while the ledger is not active
+func (e *mectSetMaxSupply) ProcessBuiltinFunction(
+	_, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 2 && len(vmInput.Arguments) != 3 {
+		return nil, ErrInvalidArguments
+	}
+	if !e.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTSupplyLedgerFlag) {
+		return nil, ErrSupplyLedgerNotActive
+	}
+	if !bytes.Equal(vmInput.CallerAddr, core.MECTSCAddress) {
+		return nil, ErrAddressIsNotMECTSystemSC
+	}
+	if !vmcommon.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, ErrOnlySystemAccountAccepted
+	}
+
+	tokenID := vmInput.Arguments[0]
+	if len(vmInput.Arguments[1]) > core.MaxLenForMECTIssueMint {
+		return nil, fmt.Errorf("%w max length for max supply is %d", ErrInvalidArguments, core.MaxLenForMECTIssueMint)
+	}
+	maxSupply := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if maxSupply.Sign() == 0 {
+		return nil, fmt.Errorf("%w, max supply can not be zero", ErrInvalidArguments)
+	}
+	nonce := uint64(0)
+	if len(vmInput.Arguments) == 3 {
+		nonce = big.NewInt(0).SetBytes(vmInput.Arguments[2]).Uint64()
+	}
+
+	systemAcc, err := e.getSystemAccount()
+	if err != nil {
+		return nil, err
+	}
+	currentMaxSupply, err := getMaxSupply(systemAcc, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if currentMaxSupply != nil {
+		return nil, ErrMaxSupplyAlreadySet
+	}
+
+	err = systemAcc.AccountDataHandler().SaveKeyValue(computeMaxSupplyKey(tokenID, nonce), maxSupply.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.accounts.SaveAccount(systemAcc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+func (e *mectSetMaxSupply) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemSCAccount, err := e.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := systemSCAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectSetMaxSupply) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectSetMaxSupply) IsInterfaceNil() bool {
+	return e == nil
+}
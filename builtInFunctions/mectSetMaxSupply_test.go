@@ -0,0 +1,106 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMECTSetMaxSupplyFunc(t *testing.T) {
+	t.Parallel()
+
+	setMaxSupply, err := NewMECTSetMaxSupplyFunc(nil, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, setMaxSupply)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	setMaxSupply, err = NewMECTSetMaxSupplyFunc(&mock.AccountsStub{}, nil, trueHandler)
+	assert.Nil(t, setMaxSupply)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
+
+	setMaxSupply, err = NewMECTSetMaxSupplyFunc(&mock.AccountsStub{}, &mock.EnableEpochsHandlerStub{}, nil)
+	assert.Nil(t, setMaxSupply)
+	assert.Equal(t, ErrNilActiveHandler, err)
+
+	setMaxSupply, err = NewMECTSetMaxSupplyFunc(&mock.AccountsStub{}, &mock.EnableEpochsHandlerStub{}, trueHandler)
+	assert.Nil(t, err)
+	assert.False(t, setMaxSupply.IsInterfaceNil())
+	assert.True(t, setMaxSupply.IsActive())
+}
+
+func TestMectSetMaxSupply_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	systemAcc := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAcc, nil
+		},
+	}
+	enableEpochsHandler := &mock.EnableEpochsHandlerStub{}
+	setMaxSupply, _ := NewMECTSetMaxSupplyFunc(accounts, enableEpochsHandler, trueHandler)
+
+	tokenID := []byte("SFT-abcdef")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{tokenID},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	}
+
+	_, err := setMaxSupply.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	_, err = setMaxSupply.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(1000).Bytes()}
+	_, err = setMaxSupply.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrSupplyLedgerNotActive, err)
+	assert.Nil(t, getMaxSupplyOfToken(t, systemAcc, tokenID, 0))
+
+	enableEpochsHandler.IsMECTSupplyLedgerFlagEnabledField = true
+	input.Arguments = [][]byte{tokenID, big.NewInt(0).Bytes()}
+	_, err = setMaxSupply.ProcessBuiltinFunction(nil, nil, input)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(1000).Bytes()}
+	input.CallerAddr = []byte("caller")
+	_, err = setMaxSupply.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrAddressIsNotMECTSystemSC, err)
+
+	input.CallerAddr = core.MECTSCAddress
+	input.RecipientAddr = []byte("recipient")
+	_, err = setMaxSupply.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrOnlySystemAccountAccepted, err)
+
+	input.RecipientAddr = vmcommon.SystemAccountAddress
+	vmOutput, err := setMaxSupply.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, big.NewInt(1000), getMaxSupplyOfToken(t, systemAcc, tokenID, 0))
+	assert.Nil(t, getMaxSupplyOfToken(t, systemAcc, tokenID, 5))
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(2000).Bytes()}
+	_, err = setMaxSupply.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, ErrMaxSupplyAlreadySet, err)
+	assert.Equal(t, big.NewInt(1000), getMaxSupplyOfToken(t, systemAcc, tokenID, 0))
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(50).Bytes(), big.NewInt(5).Bytes()}
+	_, err = setMaxSupply.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), getMaxSupplyOfToken(t, systemAcc, tokenID, 5))
+}
+
+func getMaxSupplyOfToken(t *testing.T, systemAcc vmcommon.UserAccountHandler, tokenID []byte, nonce uint64) *big.Int {
+	maxSupply, err := getMaxSupply(systemAcc, tokenID, nonce)
+	require.Nil(t, err)
+
+	return maxSupply
+}
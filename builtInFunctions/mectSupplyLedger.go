@@ -0,0 +1,302 @@
+package builtInFunctions
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const supply = "supply"
+
+// supplyKeyPrefix is the system account key prefix under which the supply of each token and nonce is saved
+var supplyKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + supply + core.MECTKeyIdentifier)
+
+// maxSupplyKeyPrefix is the system account key prefix under which the maximum supply of each token and nonce is saved
+var maxSupplyKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + "maxSupply" + core.MECTKeyIdentifier)
+
+// lengthPrefixSize is the size of the length written in front of every amount of a serialized supply entry
+const lengthPrefixSize = 4
+
+var _ vmcommon.MECTSupplyLedgerHandler = (*mectSupplyLedger)(nil)
+
+type mectSupplyLedger struct {
+	accounts            vmcommon.AccountsAdapter
+	shardCoordinator    vmcommon.Coordinator
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+// NewMECTSupplyLedger creates the component which keeps the MECT supply on the system account
+func NewMECTSupplyLedger(
+	accounts vmcommon.AccountsAdapter,
+	shardCoordinator vmcommon.Coordinator,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+) (*mectSupplyLedger, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+
+	return &mectSupplyLedger{
+		accounts:            accounts,
+		shardCoordinator:    shardCoordinator,
+		enableEpochsHandler: enableEpochsHandler,
+	}, nil
+}
+
+// GetMECTSupply returns the supply recorded for the provided token and nonce. Fungible tokens use nonce 0
+func (l *mectSupplyLedger) GetMECTSupply(tokenID []byte, nonce uint64) (*vmcommon.MECTSupply, error) {
+	systemAcc, err := l.getSystemAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	return l.getSupply(systemAcc, computeSupplyKey(tokenID, nonce))
+}
+
+// AddMinted records a minted amount. If the token has a maximum supply, the mint fails with ErrMaxSupplyExceeded when
+// the supply of the current shard would go over the shard quota of the maximum supply. Only the supply recorded since
+// the ledger activation is taken into account
+func (l *mectSupplyLedger) AddMinted(tokenID []byte, nonce uint64, value *big.Int) error {
+	err := l.checkMaxSupply(tokenID, nonce, value)
+	if err != nil {
+		return err
+	}
+
+	return l.update(tokenID, nonce, func(mectSupply *vmcommon.MECTSupply) {
+		mectSupply.Minted.Add(mectSupply.Minted, value)
+	})
+}
+
+// AddBurned records a burned amount
+func (l *mectSupplyLedger) AddBurned(tokenID []byte, nonce uint64, value *big.Int) error {
+	return l.update(tokenID, nonce, func(mectSupply *vmcommon.MECTSupply) {
+		mectSupply.Burned.Add(mectSupply.Burned, value)
+	})
+}
+
+// AddWiped records a wiped amount
+func (l *mectSupplyLedger) AddWiped(tokenID []byte, nonce uint64, value *big.Int) error {
+	return l.update(tokenID, nonce, func(mectSupply *vmcommon.MECTSupply) {
+		mectSupply.Wiped.Add(mectSupply.Wiped, value)
+	})
+}
+
+func (l *mectSupplyLedger) checkMaxSupply(tokenID []byte, nonce uint64, value *big.Int) error {
+	if !l.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTSupplyLedgerFlag) || !l.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTMaxSupplyFlag) {
+		return nil
+	}
+
+	systemAcc, err := l.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	maxSupply, err := getMaxSupply(systemAcc, tokenID, nonce)
+	if err != nil || maxSupply == nil {
+		return err
+	}
+
+	mectSupply, err := l.getSupply(systemAcc, computeSupplyKey(tokenID, nonce))
+	if err != nil {
+		return err
+	}
+
+	newSupply := big.NewInt(0).Add(mectSupply.Supply, value)
+	shardMaxSupply := computeShardMaxSupply(maxSupply, l.shardCoordinator.NumberOfShards(), l.shardCoordinator.SelfId())
+	if newSupply.Cmp(shardMaxSupply) > 0 {
+		return ErrMaxSupplyExceeded
+	}
+
+	return nil
+}
+
+func (l *mectSupplyLedger) update(tokenID []byte, nonce uint64, updateHandler func(mectSupply *vmcommon.MECTSupply)) error {
+	if !l.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTSupplyLedgerFlag) {
+		return nil
+	}
+
+	systemAcc, err := l.getSystemAccount()
+	if err != nil {
+		return err
+	}
+
+	supplyKey := computeSupplyKey(tokenID, nonce)
+	mectSupply, err := l.getSupply(systemAcc, supplyKey)
+	if err != nil {
+		return err
+	}
+
+	updateHandler(mectSupply)
+
+	err = systemAcc.AccountDataHandler().SaveKeyValue(supplyKey, serializeSupply(mectSupply))
+	if err != nil {
+		return err
+	}
+
+	return l.accounts.SaveAccount(systemAcc)
+}
+
+func (l *mectSupplyLedger) getSupply(systemAcc vmcommon.UserAccountHandler, supplyKey []byte) (*vmcommon.MECTSupply, error) {
+	marshaledData, err := systemAcc.AccountDataHandler().RetrieveValue(supplyKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(marshaledData) == 0 {
+		return newEmptySupply(), nil
+	}
+
+	return deserializeSupply(marshaledData)
+}
+
+func (l *mectSupplyLedger) getSystemAccount() (vmcommon.UserAccountHandler, error) {
+	systemSCAccount, err := l.accounts.LoadAccount(vmcommon.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAcc, ok := systemSCAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAcc, nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (l *mectSupplyLedger) IsInterfaceNil() bool {
+	return l == nil
+}
+
+func computeSupplyKey(tokenID []byte, nonce uint64) []byte {
+	return computeSystemAccountTokenKey(supplyKeyPrefix, tokenID, nonce)
+}
+
+func computeMaxSupplyKey(tokenID []byte, nonce uint64) []byte {
+	return computeSystemAccountTokenKey(maxSupplyKeyPrefix, tokenID, nonce)
+}
+
+func computeSystemAccountTokenKey(prefix []byte, tokenID []byte, nonce uint64) []byte {
+	key := append([]byte{}, prefix...)
+	key = append(key, tokenID...)
+	if nonce == 0 {
+		return key
+	}
+
+	return computeMECTNFTTokenKey(key, nonce)
+}
+
+// getMaxSupply returns the maximum supply saved for the token and nonce or nil if the token does not have one
+func getMaxSupply(systemAcc vmcommon.UserAccountHandler, tokenID []byte, nonce uint64) (*big.Int, error) {
+	maxSupplyBytes, err := systemAcc.AccountDataHandler().RetrieveValue(computeMaxSupplyKey(tokenID, nonce))
+	if err != nil {
+		return nil, err
+	}
+	if len(maxSupplyBytes) == 0 {
+		return nil, nil
+	}
+
+	return big.NewInt(0).SetBytes(maxSupplyBytes), nil
+}
+
+// computeShardMaxSupply splits the maximum supply evenly between the shards, the remainder going one unit each to the
+// shards with the lowest ids. The shards outside of the interval, as the metachain, do not receive any quota
+func computeShardMaxSupply(maxSupply *big.Int, numShards uint32, shardID uint32) *big.Int {
+	if numShards == 0 || shardID >= numShards {
+		return big.NewInt(0)
+	}
+
+	shardMaxSupply, remainder := big.NewInt(0).QuoRem(maxSupply, big.NewInt(int64(numShards)), big.NewInt(0))
+	if remainder.Cmp(big.NewInt(int64(shardID))) > 0 {
+		shardMaxSupply.Add(shardMaxSupply, big.NewInt(1))
+	}
+
+	return shardMaxSupply
+}
+
+func newEmptySupply() *vmcommon.MECTSupply {
+	return &vmcommon.MECTSupply{
+		Minted: big.NewInt(0),
+		Burned: big.NewInt(0),
+		Wiped:  big.NewInt(0),
+		Supply: big.NewInt(0),
+	}
+}
+
+// serializeSupply writes the minted, burned and wiped amounts, each one prefixed by its length
+func serializeSupply(mectSupply *vmcommon.MECTSupply) []byte {
+	buff := make([]byte, 0)
+	for _, value := range []*big.Int{mectSupply.Minted, mectSupply.Burned, mectSupply.Wiped} {
+		valueBytes := value.Bytes()
+		lengthBytes := make([]byte, lengthPrefixSize)
+		binary.BigEndian.PutUint32(lengthBytes, uint32(len(valueBytes)))
+
+		buff = append(buff, lengthBytes...)
+		buff = append(buff, valueBytes...)
+	}
+
+	return buff
+}
+
+func deserializeSupply(buff []byte) (*vmcommon.MECTSupply, error) {
+	values := make([]*big.Int, 0, 3)
+	for len(buff) > 0 {
+		if len(buff) < lengthPrefixSize {
+			return nil, ErrInvalidSupplyData
+		}
+		length := int(binary.BigEndian.Uint32(buff[:lengthPrefixSize]))
+		buff = buff[lengthPrefixSize:]
+		if len(buff) < length {
+			return nil, ErrInvalidSupplyData
+		}
+
+		values = append(values, big.NewInt(0).SetBytes(buff[:length]))
+		buff = buff[length:]
+	}
+	if len(values) != 3 {
+		return nil, ErrInvalidSupplyData
+	}
+
+	mectSupply := &vmcommon.MECTSupply{
+		Minted: values[0],
+		Burned: values[1],
+		Wiped:  values[2],
+	}
+	mectSupply.Supply = big.NewInt(0).Sub(mectSupply.Minted, mectSupply.Burned)
+	mectSupply.Supply.Sub(mectSupply.Supply, mectSupply.Wiped)
+
+	return mectSupply, nil
+}
+
+// baseSupplyLedgerHolder is embedded by the built-in functions which change the MECT supply
+type baseSupplyLedgerHolder struct {
+	supplyLedger vmcommon.MECTSupplyLedgerHandler
+}
+
+func newBaseSupplyLedgerHolder() baseSupplyLedgerHolder {
+	return baseSupplyLedgerHolder{
+		supplyLedger: &disabledSupplyLedger{},
+	}
+}
+
+// SetSupplyLedger sets the component which records the supply changes
+func (b *baseSupplyLedgerHolder) SetSupplyLedger(supplyLedger vmcommon.MECTSupplyLedgerHandler) error {
+	if check.IfNil(supplyLedger) {
+		return ErrNilSupplyLedger
+	}
+
+	b.supplyLedger = supplyLedger
+	return nil
+}
+
+// supplyLedgerAcceptor defines a built-in function which records supply changes
+type supplyLedgerAcceptor interface {
+	SetSupplyLedger(supplyLedger vmcommon.MECTSupplyLedgerHandler) error
+}
@@ -0,0 +1,290 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSupplyLedger(isEnabled bool) (*mectSupplyLedger, vmcommon.UserAccountHandler) {
+	systemAcc := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAcc, nil
+		},
+	}
+	ledger, _ := NewMECTSupplyLedger(accounts, &mock.ShardCoordinatorStub{}, &mock.EnableEpochsHandlerStub{IsMECTSupplyLedgerFlagEnabledField: isEnabled})
+
+	return ledger, systemAcc
+}
+
+func TestNewMECTSupplyLedger(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewMECTSupplyLedger(nil, &mock.ShardCoordinatorStub{}, &mock.EnableEpochsHandlerStub{})
+	assert.Nil(t, ledger)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	ledger, err = NewMECTSupplyLedger(&mock.AccountsStub{}, nil, &mock.EnableEpochsHandlerStub{})
+	assert.Nil(t, ledger)
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	ledger, err = NewMECTSupplyLedger(&mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, nil)
+	assert.Nil(t, ledger)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
+
+	ledger, err = NewMECTSupplyLedger(&mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.EnableEpochsHandlerStub{})
+	assert.Nil(t, err)
+	assert.False(t, ledger.IsInterfaceNil())
+}
+
+func TestMectSupplyLedger_ShouldRecordPerTokenAndNonce(t *testing.T) {
+	t.Parallel()
+
+	ledger, _ := createSupplyLedger(true)
+	tokenID := []byte("TKN-abcdef")
+
+	require.Nil(t, ledger.AddMinted(tokenID, 0, big.NewInt(100)))
+	require.Nil(t, ledger.AddBurned(tokenID, 0, big.NewInt(30)))
+	require.Nil(t, ledger.AddWiped(tokenID, 0, big.NewInt(5)))
+	require.Nil(t, ledger.AddMinted(tokenID, 2, big.NewInt(7)))
+
+	fungibleSupply, err := ledger.GetMECTSupply(tokenID, 0)
+	require.Nil(t, err)
+	assert.Equal(t, &vmcommon.MECTSupply{
+		Minted: big.NewInt(100),
+		Burned: big.NewInt(30),
+		Wiped:  big.NewInt(5),
+		Supply: big.NewInt(65),
+	}, fungibleSupply)
+
+	nftSupply, err := ledger.GetMECTSupply(tokenID, 2)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(7), nftSupply.Supply)
+
+	missingSupply, err := ledger.GetMECTSupply(tokenID, 3)
+	require.Nil(t, err)
+	assert.Equal(t, newEmptySupply(), missingSupply)
+}
+
+func TestMectSupplyLedger_FlagDisabledShouldNotRecord(t *testing.T) {
+	t.Parallel()
+
+	ledger, systemAcc := createSupplyLedger(false)
+	tokenID := []byte("TKN-abcdef")
+
+	require.Nil(t, ledger.AddMinted(tokenID, 0, big.NewInt(100)))
+
+	value, _ := systemAcc.AccountDataHandler().RetrieveValue(computeSupplyKey(tokenID, 0))
+	assert.Empty(t, value)
+}
+
+func TestMectSupplyLedger_InvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ledger, systemAcc := createSupplyLedger(true)
+	tokenID := []byte("TKN-abcdef")
+	_ = systemAcc.AccountDataHandler().SaveKeyValue(computeSupplyKey(tokenID, 0), []byte{0, 0, 0, 5, 1})
+
+	_, err := ledger.GetMECTSupply(tokenID, 0)
+	assert.Equal(t, ErrInvalidSupplyData, err)
+
+	err = ledger.AddMinted(tokenID, 0, big.NewInt(1))
+	assert.Equal(t, ErrInvalidSupplyData, err)
+}
+
+func TestSerializeSupply_ShouldBeReversible(t *testing.T) {
+	t.Parallel()
+
+	mectSupply := &vmcommon.MECTSupply{
+		Minted: big.NewInt(0).Exp(big.NewInt(10), big.NewInt(40), nil),
+		Burned: big.NewInt(0),
+		Wiped:  big.NewInt(3),
+	}
+	recovered, err := deserializeSupply(serializeSupply(mectSupply))
+	require.Nil(t, err)
+	assert.Equal(t, mectSupply.Minted, recovered.Minted)
+	assert.Equal(t, 0, recovered.Burned.Sign())
+	assert.Equal(t, mectSupply.Wiped, recovered.Wiped)
+	assert.Equal(t, big.NewInt(0).Sub(mectSupply.Minted, mectSupply.Wiped), recovered.Supply)
+}
+
+func TestBaseSupplyLedgerHolder_SetSupplyLedger(t *testing.T) {
+	t.Parallel()
+
+	holder := newBaseSupplyLedgerHolder()
+	assert.Equal(t, ErrNilSupplyLedger, holder.SetSupplyLedger(nil))
+
+	ledger := &mock.SupplyLedgerStub{}
+	assert.Nil(t, holder.SetSupplyLedger(ledger))
+	assert.True(t, holder.supplyLedger == ledger)
+}
+
+func TestMectLocalMint_ProcessBuiltinFunctionShouldRecordSupply(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	localMint, _ := NewMECTLocalMintFunc(10, &mock.MarshalizerMock{}, &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, &mock.EnableEpochsHandlerStub{})
+	var recordedToken []byte
+	var recordedValue *big.Int
+	_ = localMint.SetSupplyLedger(&mock.SupplyLedgerStub{
+		AddMintedCalled: func(tokenID []byte, nonce uint64, value *big.Int) error {
+			recordedToken = tokenID
+			recordedValue = value
+			return expectedErr
+		},
+	})
+
+	_, err := localMint.ProcessBuiltinFunction(mock.NewUserAccount([]byte("snd")), nil, &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("TKN-abcdef"), big.NewInt(15).Bytes()},
+			GasProvided: 50,
+		},
+	})
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []byte("TKN-abcdef"), recordedToken)
+	assert.Equal(t, big.NewInt(15), recordedValue)
+}
+
+func TestBuiltInFuncCreator_SupplyLedgerShouldBeSetOnSupplyChangingFunctions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	systemAcc := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAcc, nil
+		},
+	}
+	enableEpochsHandler := createEnableEpochsHandlerStubAllFlags()
+	enableEpochsHandler.IsMECTSupplyLedgerFlagEnabledField = true
+	args.EnableEpochsHandler = enableEpochsHandler
+	b, _ := NewBuiltInFunctionsCreator(args)
+	err := b.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	mintFunc, _ := b.BuiltInFunctionContainer().Get(core.BuiltInFunctionMECTLocalMint)
+	assert.True(t, mintFunc.(vmcommon.WrappedBuiltinFunction).Unwrap().(*mectLocalMint).supplyLedger == b.supplyLedger)
+	wipeFunc, _ := b.BuiltInFunctionContainer().Get(core.BuiltInFunctionMECTWipe)
+	assert.True(t, wipeFunc.(vmcommon.WrappedBuiltinFunction).Unwrap().(*mectFreezeWipe).supplyLedger == b.supplyLedger)
+	assert.True(t, b.MECTSupplyHandler() == b.supplyLedger)
+}
+
+func TestMectSupplyLedger_MaxSupplyShouldBeSplitBetweenShards(t *testing.T) {
+	t.Parallel()
+
+	systemAcc := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAcc, nil
+		},
+	}
+	shardCoordinator := &mock.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 3
+		},
+		SelfIdCalled: func() uint32 {
+			return 1
+		},
+	}
+	ledger, _ := NewMECTSupplyLedger(accounts, shardCoordinator, &mock.EnableEpochsHandlerStub{
+		IsMECTSupplyLedgerFlagEnabledField: true,
+		IsMECTMaxSupplyFlagEnabledField:    true,
+	})
+	tokenID := []byte("TKN-abcdef")
+	_ = systemAcc.AccountDataHandler().SaveKeyValue(computeMaxSupplyKey(tokenID, 0), big.NewInt(11).Bytes())
+
+	require.Nil(t, ledger.AddMinted(tokenID, 0, big.NewInt(3)))
+	assert.Equal(t, ErrMaxSupplyExceeded, ledger.AddMinted(tokenID, 0, big.NewInt(2)))
+	require.Nil(t, ledger.AddBurned(tokenID, 0, big.NewInt(1)))
+	require.Nil(t, ledger.AddMinted(tokenID, 0, big.NewInt(2)))
+	assert.Equal(t, ErrMaxSupplyExceeded, ledger.AddMinted(tokenID, 0, big.NewInt(1)))
+
+	require.Nil(t, ledger.AddMinted(tokenID, 2, big.NewInt(100)))
+
+	mectSupply, _ := ledger.GetMECTSupply(tokenID, 0)
+	assert.Equal(t, big.NewInt(4), mectSupply.Supply)
+}
+
+func TestComputeShardMaxSupply(t *testing.T) {
+	t.Parallel()
+
+	maxSupply := big.NewInt(11)
+	assert.Equal(t, big.NewInt(4), computeShardMaxSupply(maxSupply, 3, 0))
+	assert.Equal(t, big.NewInt(4), computeShardMaxSupply(maxSupply, 3, 1))
+	assert.Equal(t, big.NewInt(3), computeShardMaxSupply(maxSupply, 3, 2))
+	assert.Equal(t, big.NewInt(0), computeShardMaxSupply(maxSupply, 3, core.MetachainShardId))
+	assert.Equal(t, big.NewInt(11), computeShardMaxSupply(maxSupply, 1, 0))
+	assert.Equal(t, big.NewInt(0), computeShardMaxSupply(maxSupply, 0, 0))
+}
+
+func TestBuiltInFuncCreator_MaxSupplyShouldBeEnforcedOnMint(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	systemAcc := mock.NewUserAccount(vmcommon.SystemAccountAddress)
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAcc, nil
+		},
+	}
+	enableEpochsHandler := createEnableEpochsHandlerStubAllFlags()
+	enableEpochsHandler.IsMECTSupplyLedgerFlagEnabledField = true
+	args.EnableEpochsHandler = enableEpochsHandler
+	b, _ := NewBuiltInFunctionsCreator(args)
+	err := b.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	setMaxSupplyFunc, _ := b.BuiltInFunctionContainer().Get(vmcommon.BuiltInFunctionMECTSetMaxSupply)
+	_, err = setMaxSupplyFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: core.MECTSCAddress,
+			Arguments:  [][]byte{[]byte("TKN-abcdef"), big.NewInt(20).Bytes()},
+		},
+		RecipientAddr: vmcommon.SystemAccountAddress,
+	})
+	require.Nil(t, err)
+
+	mintFunc, _ := b.BuiltInFunctionContainer().Get(core.BuiltInFunctionMECTLocalMint)
+	mintInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{[]byte("TKN-abcdef"), big.NewInt(15).Bytes()},
+			GasProvided: 5000,
+		},
+	}
+	minter := mock.NewUserAccount([]byte("minter"))
+	mintFunc.(vmcommon.WrappedBuiltinFunction).Unwrap().(*mectLocalMint).rolesHandler = &mock.MECTRoleHandlerStub{}
+	_, err = mintFunc.ProcessBuiltinFunction(minter, nil, mintInput)
+	require.Nil(t, err)
+
+	_, err = mintFunc.ProcessBuiltinFunction(minter, nil, mintInput)
+	assert.Equal(t, ErrMaxSupplyExceeded, err)
+}
+
+func TestMectSupplyLedger_ReadErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	retrieveErr := errors.New("retrieve error")
+	systemAcc := createAccountWithRetrieveValueError(retrieveErr)
+	ledger, _ := NewMECTSupplyLedger(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAcc, nil
+		},
+	}, mock.NewMultiShardsCoordinatorMock(1), &mock.EnableEpochsHandlerStub{IsMECTSupplyLedgerFlagEnabledField: true})
+
+	_, err := ledger.GetMECTSupply([]byte("TKN-abcdef"), 0)
+	assert.Equal(t, retrieveErr, err)
+
+	maxSupply, err := getMaxSupply(systemAcc, []byte("TKN-abcdef"), 0)
+	assert.Nil(t, maxSupply)
+	assert.Equal(t, retrieveErr, err)
+}
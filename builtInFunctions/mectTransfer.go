@@ -7,7 +7,6 @@ import (
 	"sync"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/data/mect"
 	"github.com/ME-MotherEarth/me-core/data/vm"
@@ -26,11 +25,9 @@ type mectTransfer struct {
 	shardCoordinator      vmcommon.Coordinator
 	mutExecution          sync.RWMutex
 
-	rolesHandler                   vmcommon.MECTRoleHandler
-	transferToMetaEnableEpoch      uint32
-	flagTransferToMeta             atomic.Flag
-	checkCorrectTokenIDEnableEpoch uint32
-	flagCheckCorrectTokenID        atomic.Flag
+	rolesHandler         vmcommon.MECTRoleHandler
+	enableEpochsHandler  vmcommon.EnableEpochsHandler
+	transferFeeProcessor *transferFeeProcessor
 }
 
 // NewMECTTransferFunc returns the mect transfer built-in function component
@@ -39,10 +36,9 @@ func NewMECTTransferFunc(
 	marshaller vmcommon.Marshalizer,
 	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler,
 	shardCoordinator vmcommon.Coordinator,
+	accounts vmcommon.AccountsAdapter,
 	rolesHandler vmcommon.MECTRoleHandler,
-	transferToMetaEnableEpoch uint32,
-	checkCorrectTokenIDEnableEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
 ) (*mectTransfer, error) {
 	if check.IfNil(marshaller) {
 		return nil, ErrNilMarshalizer
@@ -53,38 +49,39 @@ func NewMECTTransferFunc(
 	if check.IfNil(shardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
 	}
 
 	e := &mectTransfer{
-		funcGasCost:                    funcGasCost,
-		marshaller:                     marshaller,
-		keyPrefix:                      []byte(baseMECTKeyPrefix),
-		globalSettingsHandler:          globalSettingsHandler,
-		payableHandler:                 &disabledPayableHandler{},
-		shardCoordinator:               shardCoordinator,
-		rolesHandler:                   rolesHandler,
-		checkCorrectTokenIDEnableEpoch: checkCorrectTokenIDEnableEpoch,
-		transferToMetaEnableEpoch:      transferToMetaEnableEpoch,
+		funcGasCost:           funcGasCost,
+		marshaller:            marshaller,
+		keyPrefix:             []byte(baseMECTKeyPrefix),
+		globalSettingsHandler: globalSettingsHandler,
+		payableHandler:        &disabledPayableHandler{},
+		shardCoordinator:      shardCoordinator,
+		rolesHandler:          rolesHandler,
+		enableEpochsHandler:   enableEpochsHandler,
+		transferFeeProcessor: &transferFeeProcessor{
+			keyPrefix:             []byte(baseMECTKeyPrefix),
+			marshaller:            marshaller,
+			accounts:              accounts,
+			shardCoordinator:      shardCoordinator,
+			globalSettingsHandler: globalSettingsHandler,
+			rolesHandler:          rolesHandler,
+			enableEpochsHandler:   enableEpochsHandler,
+		},
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (e *mectTransfer) EpochConfirmed(epoch uint32, _ uint64) {
-	e.flagTransferToMeta.SetValue(epoch >= e.transferToMetaEnableEpoch)
-	log.Debug("MECT transfer to metachain flag", "enabled", e.flagTransferToMeta.IsSet())
-	e.flagCheckCorrectTokenID.SetValue(epoch >= e.checkCorrectTokenIDEnableEpoch)
-	log.Debug("MECT transfer check correct tokenID for transfer role", "enabled", e.flagCheckCorrectTokenID.IsSet())
-}
-
 // SetNewGasConfig is called whenever gas cost is changed
 func (e *mectTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 	if gasCost == nil {
@@ -96,7 +93,8 @@ func (e *mectTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 	e.mutExecution.Unlock()
 }
 
-// ProcessBuiltinFunction resolves MECT transfer function calls
+// ProcessBuiltinFunction resolves MECT transfer function calls. If the token has a transfer fee, the fee is taken out
+// of the value received by the destination account and sent to the fee receiver
 func (e *mectTransfer) ProcessBuiltinFunction(
 	acntSnd, acntDst vmcommon.UserAccountHandler,
 	vmInput *vmcommon.ContractCallInput,
@@ -108,7 +106,7 @@ func (e *mectTransfer) ProcessBuiltinFunction(
 	if err != nil {
 		return nil, err
 	}
-	isInvalidTransferToMeta := e.shardCoordinator.ComputeId(vmInput.RecipientAddr) == core.MetachainShardId && !e.flagTransferToMeta.IsSet()
+	isInvalidTransferToMeta := e.shardCoordinator.ComputeId(vmInput.RecipientAddr) == core.MetachainShardId && !e.enableEpochsHandler.IsTransferToMetaFlagEnabled()
 	if isInvalidTransferToMeta {
 		return nil, ErrInvalidRcvAddr
 	}
@@ -123,7 +121,7 @@ func (e *mectTransfer) ProcessBuiltinFunction(
 	tokenID := vmInput.Arguments[0]
 
 	keyToCheck := mectTokenKey
-	if e.flagCheckCorrectTokenID.IsSet() {
+	if e.enableEpochsHandler.IsCheckCorrectTokenIDEnabled() {
 		keyToCheck = tokenID
 	}
 
@@ -131,6 +129,10 @@ func (e *mectTransfer) ProcessBuiltinFunction(
 	if err != nil {
 		return nil, err
 	}
+	err = checkIfTransferCanHappenWithSoulbound(mectTokenKey, e.globalSettingsHandler, acntSnd, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
 
 	if !check.IfNil(acntSnd) {
 		// gas is paid only by sender
@@ -152,12 +154,23 @@ func (e *mectTransfer) ProcessBuiltinFunction(
 			return nil, err
 		}
 
-		err = addToMECTBalance(acntDst, mectTokenKey, value, e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+		transferFee := e.transferFeeProcessor.computeTransferFee(acntDst, vmInput.CallerAddr, tokenID, value, vmInput.ReturnCallAfterError)
+		receivedValue := computeReceivedValue(value, transferFee)
+		err = addToMECTBalance(acntDst, mectTokenKey, receivedValue, e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+		if err != nil {
+			return nil, err
+		}
+
+		var crossShardFee *transferFeePayment
+		crossShardFee, err = e.transferFeeProcessor.payTransferFee(transferFee, vmInput.ReturnCallAfterError, vmOutput)
 		if err != nil {
 			return nil, err
 		}
 
 		if isSCCallAfter {
+			if receivedValue.Cmp(value) != 0 {
+				replaceTransferredValue(vmInput, 1, receivedValue)
+			}
 			vmOutput.GasRemaining, err = vmcommon.SafeSubUint64(vmInput.GasProvided, e.funcGasCost)
 			var callArgs [][]byte
 			if len(vmInput.Arguments) > core.MinLenArgumentsMECTTransfer+1 {
@@ -172,6 +185,7 @@ func (e *mectTransfer) ProcessBuiltinFunction(
 				vmInput.GasLocked,
 				vmInput.CallType,
 				vmOutput)
+			addTransferFeeOutputTransfers([]*transferFeePayment{crossShardFee}, vmOutput)
 
 			addMECTEntryInVMOutput(vmOutput, []byte(core.BuiltInFunctionMECTTransfer), tokenID, 0, value, vmInput.CallerAddr, acntDst.AddressBytes())
 			return vmOutput, nil
@@ -182,6 +196,7 @@ func (e *mectTransfer) ProcessBuiltinFunction(
 			vmOutput.GasRemaining = vmInput.GasProvided
 		}
 
+		addTransferFeeOutputTransfers([]*transferFeePayment{crossShardFee}, vmOutput)
 		addMECTEntryInVMOutput(vmOutput, []byte(core.BuiltInFunctionMECTTransfer), tokenID, 0, value, vmInput.CallerAddr, acntDst.AddressBytes())
 		return vmOutput, nil
 	}
@@ -231,6 +246,39 @@ func addOutputTransferToVMOutput(
 	vmOutput.GasRemaining = 0
 }
 
+// addMECTTransferToOutputAccount appends a plain MECT transfer of the given value to the output transfers of the
+// receiver, adding the receiver to the output accounts of the vm output if it is not already there
+func addMECTTransferToOutputAccount(
+	vmOutput *vmcommon.VMOutput,
+	senderAddress []byte,
+	receiver []byte,
+	tokenID []byte,
+	value *big.Int,
+	callType vm.CallType,
+) {
+	if vmOutput.OutputAccounts == nil {
+		vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	}
+
+	outTransfer := vmcommon.OutputTransfer{
+		Value:         big.NewInt(0),
+		Data:          []byte(core.BuiltInFunctionMECTTransfer + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(value.Bytes())),
+		CallType:      callType,
+		SenderAddress: senderAddress,
+	}
+
+	outAcc, exists := vmOutput.OutputAccounts[string(receiver)]
+	if !exists {
+		outAcc = &vmcommon.OutputAccount{
+			Address:      receiver,
+			Balance:      big.NewInt(0),
+			BalanceDelta: big.NewInt(0),
+		}
+		vmOutput.OutputAccounts[string(receiver)] = outAcc
+	}
+	outAcc.OutputTransfers = append(outAcc.OutputTransfers, outTransfer)
+}
+
 func addToMECTBalance(
 	userAcnt vmcommon.UserAccountHandler,
 	key []byte,
@@ -374,6 +422,28 @@ func checkIfTransferCanHappenWithLimitedTransfer(
 	return errDestination
 }
 
+// will return nil if the token is not soulbound
+// soulbound tokens can not leave the account that holds them, the only allowed movements being the returns of the
+// failed cross shard transfers. The destination shard does not check, as the transfer was validated on sender shard.
+func checkIfTransferCanHappenWithSoulbound(
+	mectTokenKey []byte,
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler,
+	acntSnd vmcommon.UserAccountHandler,
+	isReturnWithError bool,
+) error {
+	if isReturnWithError {
+		return nil
+	}
+	if check.IfNil(acntSnd) {
+		return nil
+	}
+	if globalSettingsHandler.IsSoulbound(mectTokenKey) {
+		return ErrMECTTokenIsSoulbound
+	}
+
+	return nil
+}
+
 // SetPayableChecker will set the payableCheck handler to the function
 func (e *mectTransfer) SetPayableChecker(payableHandler vmcommon.PayableChecker) error {
 	if check.IfNil(payableHandler) {
@@ -384,6 +454,18 @@ func (e *mectTransfer) SetPayableChecker(payableHandler vmcommon.PayableChecker)
 	return nil
 }
 
+// EstimateGas returns the gas consumed by the MECT transfer function, which is paid only on the sender shard
+func (e *mectTransfer) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return computeGasToConsume(acntSnd, e.funcGasCost), nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectTransfer) IsInterfaceNil() bool {
 	return e == nil
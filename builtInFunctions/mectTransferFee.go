@@ -0,0 +1,192 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	"github.com/ME-MotherEarth/me-core/data/vm"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// transferFeePayment is the part of a fungible transfer that is routed to the fee receiver of the token
+type transferFeePayment struct {
+	tokenID  []byte
+	value    *big.Int
+	payer    []byte
+	receiver []byte
+}
+
+// transferFeeProcessor charges the transfer fee of the fungible tokens on the shard of the destination account
+type transferFeeProcessor struct {
+	keyPrefix             []byte
+	marshaller            vmcommon.Marshalizer
+	accounts              vmcommon.AccountsAdapter
+	shardCoordinator      vmcommon.Coordinator
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	rolesHandler          vmcommon.MECTRoleHandler
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
+}
+
+// computeTransferFee returns the transfer fee due on the transferred value, or nil if there is none. The destination
+// account is credited only with the value left after the fee, see computeReceivedValue. The fee is charged on the shard
+// of the destination account, so there is no fee while the destination is not loaded. The transfers from or to the MECT
+// system SC or the fee receiver, the ones involving the addresses with the transfer role of the token and the ones to a
+// destination account holding the transfer role are exempted. The sender account is not looked at, as it is not loaded
+// when the transfer comes from another shard, so that the same transfer is charged the same in every shard layout.
+func (t *transferFeeProcessor) computeTransferFee(
+	acntDst vmcommon.UserAccountHandler,
+	senderAddress []byte,
+	tokenID []byte,
+	value *big.Int,
+	isReturnWithError bool,
+) *transferFeePayment {
+	if !t.enableEpochsHandler.IsFlagEnabled(vmcommon.MECTTransferFeeFlag) {
+		return nil
+	}
+	if isReturnWithError || check.IfNil(acntDst) {
+		return nil
+	}
+
+	basisPoints, receiver := t.globalSettingsHandler.GetTransferFee(tokenID)
+	if basisPoints == 0 || len(receiver) == 0 {
+		return nil
+	}
+	if t.isExemptedFromTransferFee(acntDst, senderAddress, receiver, tokenID) {
+		return nil
+	}
+
+	fee := big.NewInt(0).Mul(value, big.NewInt(int64(basisPoints)))
+	fee.Div(fee, big.NewInt(maxTransferFeeBasisPoints))
+	if fee.Sign() == 0 {
+		return nil
+	}
+
+	return &transferFeePayment{
+		tokenID:  tokenID,
+		value:    fee,
+		payer:    acntDst.AddressBytes(),
+		receiver: receiver,
+	}
+}
+
+// computeReceivedValue returns the transferred value left to the destination account after the transfer fee
+func computeReceivedValue(value *big.Int, transferFee *transferFeePayment) *big.Int {
+	if transferFee == nil {
+		return big.NewInt(0).Set(value)
+	}
+
+	return big.NewInt(0).Sub(value, transferFee.value)
+}
+
+// payTransferFee credits the transfer fee to the fee receiver if it is located in the current shard, otherwise the fee
+// is returned to be sent cross shard with addTransferFeeOutputTransfers
+func (t *transferFeeProcessor) payTransferFee(
+	transferFee *transferFeePayment,
+	isReturnWithError bool,
+	vmOutput *vmcommon.VMOutput,
+) (*transferFeePayment, error) {
+	if transferFee == nil {
+		return nil, nil
+	}
+
+	addMECTEntryInVMOutput(vmOutput, []byte(vmcommon.MECTTransferFeeIdentifier), transferFee.tokenID, 0, transferFee.value, transferFee.payer, transferFee.receiver)
+
+	acntReceiver, err := t.loadAccountIfInShard(transferFee.receiver)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(acntReceiver) {
+		return transferFee, nil
+	}
+
+	mectTokenKey := append(t.keyPrefix, transferFee.tokenID...)
+	err = addToMECTBalance(acntReceiver, mectTokenKey, transferFee.value, t.marshaller, t.globalSettingsHandler, isReturnWithError)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, t.accounts.SaveAccount(acntReceiver)
+}
+
+func (t *transferFeeProcessor) isExemptedFromTransferFee(
+	acntDst vmcommon.UserAccountHandler,
+	senderAddress []byte,
+	receiver []byte,
+	tokenID []byte,
+) bool {
+	destinationAddress := acntDst.AddressBytes()
+	if bytes.Equal(senderAddress, core.MECTSCAddress) || bytes.Equal(destinationAddress, core.MECTSCAddress) {
+		return true
+	}
+	if bytes.Equal(senderAddress, receiver) || bytes.Equal(destinationAddress, receiver) {
+		return true
+	}
+	if t.globalSettingsHandler.IsSenderOrDestinationWithTransferRole(senderAddress, destinationAddress, tokenID) {
+		return true
+	}
+
+	return t.rolesHandler.CheckAllowedToExecute(acntDst, tokenID, []byte(core.MECTRoleTransfer)) == nil
+}
+
+// checkNoTransferFee rejects the fungible tokens with a transfer fee in the built-in functions which move balances
+// without charging it, so that the fee can not be avoided by routing the tokens through them
+func checkNoTransferFee(
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
+	tokenID []byte,
+	nonce uint64,
+) error {
+	if nonce > 0 || !enableEpochsHandler.IsFlagEnabled(vmcommon.MECTTransferFeeFlag) {
+		return nil
+	}
+
+	basisPoints, receiver := globalSettingsHandler.GetTransferFee(tokenID)
+	if basisPoints == 0 || len(receiver) == 0 {
+		return nil
+	}
+
+	return ErrTransferFeeNotSupported
+}
+
+// replaceTransferredValue sets the value received by the destination in the arguments of the vm input. The smart
+// contract called after the transfer reads its MECT call value from those arguments, so it has to see the value left
+// after the royalties and the transfer fee were charged. The arguments slice is copied, the one of the caller is not
+// modified.
+func replaceTransferredValue(vmInput *vmcommon.ContractCallInput, valueIndex int, value *big.Int) {
+	arguments := make([][]byte, len(vmInput.Arguments))
+	copy(arguments, vmInput.Arguments)
+	arguments[valueIndex] = value.Bytes()
+	vmInput.Arguments = arguments
+}
+
+func (t *transferFeeProcessor) loadAccountIfInShard(address []byte) (vmcommon.UserAccountHandler, error) {
+	if t.shardCoordinator.SelfId() != t.shardCoordinator.ComputeId(address) {
+		return nil, nil
+	}
+
+	accountHandler, err := t.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	userAccount, ok := accountHandler.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+// addTransferFeeOutputTransfers sends the cross shard transfer fees as regular MECT transfers. It must be called after
+// the other output transfers were created, as those are replacing the output accounts of the vm output. The nil
+// payments, of the fees credited in the current shard, are skipped.
+func addTransferFeeOutputTransfers(payments []*transferFeePayment, vmOutput *vmcommon.VMOutput) {
+	for _, payment := range payments {
+		if payment == nil {
+			continue
+		}
+
+		addMECTTransferToOutputAccount(vmOutput, payment.payer, payment.receiver, payment.tokenID, payment.value, vm.DirectCall)
+	}
+}
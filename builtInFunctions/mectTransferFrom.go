@@ -0,0 +1,316 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	"github.com/ME-MotherEarth/me-core/data/vm"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const numArgsMECTTransferFrom = 3
+
+type mectTransferFrom struct {
+	*baseActiveHandler
+	funcGasCost           uint64
+	marshaller            vmcommon.Marshalizer
+	keyPrefix             []byte
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	rolesHandler          vmcommon.MECTRoleHandler
+	mectStorageHandler    vmcommon.MECTNFTStorageHandler
+	shardCoordinator      vmcommon.Coordinator
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
+	mutExecution          sync.RWMutex
+}
+
+// NewMECTTransferFromFunc returns the mect transfer from built-in function component
+func NewMECTTransferFromFunc(args ArgsNewMECTAllowanceFunc) (*mectTransferFrom, error) {
+	err := checkBaseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(args.StorageHandler) {
+		return nil, ErrNilMECTNFTStorageHandler
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	e := &mectTransferFrom{
+		funcGasCost:           args.FuncGasCost,
+		marshaller:            args.Marshaller,
+		keyPrefix:             []byte(baseMECTKeyPrefix),
+		globalSettingsHandler: args.GlobalSettingsHandler,
+		rolesHandler:          args.RolesHandler,
+		mectStorageHandler:    args.StorageHandler,
+		shardCoordinator:      args.ShardCoordinator,
+		enableEpochsHandler:   args.EnableEpochsHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: args.ActiveHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectTransferFrom) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.MECTTransferFrom
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction moves tokens from the owner to the spender using the allowance set by the owner.
+// The spender sends the call to the owner address and receives the tokens.
+// Requires 3 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - value to transfer
+func (e *mectTransferFrom) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicMECTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != numArgsMECTTransferFrom {
+		return nil, ErrInvalidArguments
+	}
+	if bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, fmt.Errorf("%w, can not transfer from self", ErrInvalidArguments)
+	}
+	isInvalidTransferFromMeta := e.shardCoordinator.ComputeId(vmInput.RecipientAddr) == core.MetachainShardId && !e.enableEpochsHandler.IsTransferToMetaFlagEnabled()
+	if isInvalidTransferFromMeta {
+		return nil, ErrInvalidRcvAddr
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		return nil, ErrNegativeValue
+	}
+	if !check.IfNil(acntSnd) && vmInput.GasProvided < e.funcGasCost {
+		// gas is paid only by the spender
+		return nil, ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	vmOutput := &vmcommon.VMOutput{GasRemaining: computeGasRemaining(acntSnd, vmInput.GasProvided, e.funcGasCost), ReturnCode: vmcommon.Ok}
+	if check.IfNil(acntDst) {
+		// the owner is in another shard, the call continues there
+		if vmcommon.IsSmartContractAddress(vmInput.CallerAddr) {
+			addOutputTransferToVMOutput(
+				vmInput.CallerAddr,
+				vmcommon.BuiltInFunctionMECTTransferFrom,
+				vmInput.Arguments,
+				vmInput.RecipientAddr,
+				vmInput.GasLocked,
+				vmInput.CallType,
+				vmOutput)
+		}
+
+		return vmOutput, nil
+	}
+	if vmInput.CallType == vm.AsynchronousCallBack && check.IfNil(acntSnd) {
+		// gas was already consumed on the spender shard
+		vmOutput.GasRemaining = vmInput.GasProvided
+	}
+
+	mectTokenKey := append(e.keyPrefix, tokenID...)
+	keyToCheck := mectTokenKey
+	if e.enableEpochsHandler.IsCheckCorrectTokenIDEnabled() {
+		keyToCheck = tokenID
+	}
+
+	err = checkIfTransferCanHappenWithLimitedTransfer(keyToCheck, mectTokenKey, vmInput.RecipientAddr, vmInput.CallerAddr, e.globalSettingsHandler, e.rolesHandler, acntDst, acntSnd, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+	err = checkIfTransferCanHappenWithSoulbound(mectTokenKey, e.globalSettingsHandler, acntDst, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+	err = checkNoTransferFee(e.globalSettingsHandler, e.enableEpochsHandler, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.spendAllowance(acntDst, vmInput.CallerAddr, tokenID, nonce, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if nonce == 0 {
+		err = e.transferFungible(acntDst, acntSnd, vmInput, mectTokenKey, value, vmOutput)
+	} else {
+		err = e.transferNFT(acntDst, acntSnd, vmInput, mectTokenKey, nonce, value, vmOutput)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	addMECTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionMECTTransferFrom), tokenID, nonce, value, vmInput.RecipientAddr, vmInput.CallerAddr)
+
+	return vmOutput, nil
+}
+
+func (e *mectTransferFrom) spendAllowance(
+	owner vmcommon.UserAccountHandler,
+	spender []byte,
+	tokenID []byte,
+	nonce uint64,
+	value *big.Int,
+) error {
+	allowanceKey := computeAllowanceKey(spender, tokenID, nonce)
+	mectAllowance, err := getAllowance(owner, allowanceKey)
+	if err != nil {
+		return err
+	}
+	if mectAllowance.isExpired(e.enableEpochsHandler.GetCurrentEpoch()) {
+		return ErrAllowanceExpired
+	}
+	if mectAllowance.value.Cmp(value) < 0 {
+		return ErrInsufficientAllowance
+	}
+
+	mectAllowance.value.Sub(mectAllowance.value, value)
+
+	return saveAllowance(owner, allowanceKey, mectAllowance)
+}
+
+func (e *mectTransferFrom) transferFungible(
+	owner vmcommon.UserAccountHandler,
+	spender vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	mectTokenKey []byte,
+	value *big.Int,
+	vmOutput *vmcommon.VMOutput,
+) error {
+	err := addToMECTBalance(owner, mectTokenKey, big.NewInt(0).Neg(value), e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return err
+	}
+
+	if !check.IfNil(spender) {
+		return addToMECTBalance(spender, mectTokenKey, value, e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+	}
+
+	// the spender is in another shard, the tokens are sent back as a regular MECT transfer
+	addOutputTransferToVMOutput(
+		vmInput.RecipientAddr,
+		core.BuiltInFunctionMECTTransfer,
+		[][]byte{vmInput.Arguments[0], value.Bytes()},
+		vmInput.CallerAddr,
+		vmInput.GasLocked,
+		vmInput.CallType,
+		vmOutput)
+
+	return nil
+}
+
+func (e *mectTransferFrom) transferNFT(
+	owner vmcommon.UserAccountHandler,
+	spender vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	mectTokenKey []byte,
+	nonce uint64,
+	value *big.Int,
+	vmOutput *vmcommon.VMOutput,
+) error {
+	mectData, err := e.mectStorageHandler.GetMECTNFTTokenOnSender(owner, mectTokenKey, nonce)
+	if err != nil {
+		return err
+	}
+	if mectData.Value.Cmp(value) < 0 {
+		return ErrInvalidNFTQuantity
+	}
+
+	mectData.Value.Sub(mectData.Value, value)
+	_, err = e.mectStorageHandler.SaveMECTNFTToken(owner.AddressBytes(), owner, mectTokenKey, nonce, mectData, false, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return err
+	}
+
+	mectData.Value.Set(value)
+	if !check.IfNil(spender) {
+		return e.addNFTToSpender(owner.AddressBytes(), spender, mectData, mectTokenKey, nonce, vmInput.ReturnCallAfterError)
+	}
+
+	err = e.mectStorageHandler.AddToLiquiditySystemAcc(mectTokenKey, nonce, big.NewInt(0).Neg(value))
+	if err != nil {
+		return err
+	}
+
+	// the spender is in another shard, the tokens are sent back as a cross shard MECT NFT transfer
+	marshaledNFTTransfer, err := e.marshaller.Marshal(mectData)
+	if err != nil {
+		return err
+	}
+
+	addNFTTransferToVMOutput(
+		vmInput.RecipientAddr,
+		vmInput.CallerAddr,
+		core.BuiltInFunctionMECTNFTTransfer,
+		[][]byte{vmInput.Arguments[0], vmInput.Arguments[1], value.Bytes(), marshaledNFTTransfer},
+		vmInput.GasLocked,
+		0,
+		vmInput.CallType,
+		vmOutput,
+	)
+
+	return nil
+}
+
+func (e *mectTransferFrom) addNFTToSpender(
+	ownerAddress []byte,
+	spender vmcommon.UserAccountHandler,
+	mectDataToTransfer *mect.MECToken,
+	mectTokenKey []byte,
+	nonce uint64,
+	isReturnWithError bool,
+) error {
+	currentMECTData, _, err := e.mectStorageHandler.GetMECTNFTTokenOnDestination(spender, mectTokenKey, nonce)
+	if err != nil {
+		return err
+	}
+	err = checkFrozeAndPause(spender.AddressBytes(), mectTokenKey, currentMECTData, e.globalSettingsHandler, isReturnWithError)
+	if err != nil {
+		return err
+	}
+
+	mectDataToTransfer.Value.Add(mectDataToTransfer.Value, currentMECTData.Value)
+	_, err = e.mectStorageHandler.SaveMECTNFTToken(ownerAddress, spender, mectTokenKey, nonce, mectDataToTransfer, false, isReturnWithError)
+
+	return err
+}
+
+// EstimateGas returns the gas consumed by the MECT transfer from function, which is paid only on the spender shard
+func (e *mectTransferFrom) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return computeGasToConsume(acntSnd, e.funcGasCost), nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectTransferFrom) IsInterfaceNil() bool {
+	return e == nil
+}
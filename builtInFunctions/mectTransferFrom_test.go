@@ -0,0 +1,300 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransferFromInput(args ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  allowanceSpender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+			Arguments:   args,
+		},
+		RecipientAddr: allowanceOwner,
+	}
+}
+
+func saveFungibleBalance(t *testing.T, account vmcommon.UserAccountHandler, tokenID []byte, value int64) {
+	marshaledData, err := (&mock.MarshalizerMock{}).Marshal(&mect.MECToken{Value: big.NewInt(value)})
+	require.Nil(t, err)
+	_ = account.AccountDataHandler().SaveKeyValue(append([]byte(baseMECTKeyPrefix), tokenID...), marshaledData)
+}
+
+func getFungibleBalance(account vmcommon.UserAccountHandler, tokenID []byte) *big.Int {
+	mectData, _ := getMECTDataFromKey(account, append([]byte(baseMECTKeyPrefix), tokenID...), &mock.MarshalizerMock{})
+	return mectData.Value
+}
+
+func TestNewMECTTransferFromFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.Marshaller = nil
+		e, err := NewMECTTransferFromFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil storage handler should error", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.StorageHandler = nil
+		e, err := NewMECTTransferFromFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilMECTNFTStorageHandler, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		args := createMockArgsForAllowance()
+		args.ShardCoordinator = nil
+		e, err := NewMECTTransferFromFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		e, err := NewMECTTransferFromFunc(createMockArgsForAllowance())
+		assert.Nil(t, err)
+		assert.False(t, e.IsInterfaceNil())
+	})
+}
+
+func TestMectTransferFrom_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTTransferFromFunc(createMockArgsForAllowance())
+	gasCost := createMockGasCost()
+	e.SetNewGasConfig(&gasCost)
+	assert.Equal(t, gasCost.BuiltInCost.MECTTransferFrom, e.funcGasCost)
+}
+
+func TestMectTransferFrom_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTTransferFromFunc(createMockArgsForAllowance())
+	owner := mock.NewUserAccount(allowanceOwner)
+	spender := mock.NewUserAccount(allowanceSpender)
+	tokenID := []byte("TKN-abcdef")
+
+	_, err := e.ProcessBuiltinFunction(spender, owner, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createTransferFromInput(tokenID, []byte{})
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createTransferFromInput(tokenID, []byte{}, big.NewInt(10).Bytes())
+	input.RecipientAddr = allowanceSpender
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	input = createTransferFromInput(tokenID, []byte{}, []byte{})
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrNegativeValue, err)
+
+	input = createTransferFromInput(tokenID, []byte{}, big.NewInt(10).Bytes())
+	input.GasProvided = 1
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrNotEnoughGas, err)
+
+	input = createTransferFromInput(tokenID, []byte{}, big.NewInt(10).Bytes())
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrInsufficientAllowance, err)
+
+	_ = saveAllowance(owner, computeAllowanceKey(allowanceSpender, tokenID, 0), &mectAllowance{value: big.NewInt(10), expiryEpoch: 4})
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrAllowanceExpired, err)
+
+	_ = saveAllowance(owner, computeAllowanceKey(allowanceSpender, tokenID, 0), &mectAllowance{value: big.NewInt(10), expiryEpoch: 5})
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrInsufficientFunds, err)
+}
+
+func TestMectTransferFrom_ProcessBuiltinFunctionSameShardFungible(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTTransferFromFunc(createMockArgsForAllowance())
+	owner := mock.NewUserAccount(allowanceOwner)
+	spender := mock.NewUserAccount(allowanceSpender)
+	tokenID := []byte("TKN-abcdef")
+	allowanceKey := computeAllowanceKey(allowanceSpender, tokenID, 0)
+	saveFungibleBalance(t, owner, tokenID, 100)
+	_ = saveAllowance(owner, allowanceKey, &mectAllowance{value: big.NewInt(50)})
+
+	input := createTransferFromInput(tokenID, []byte{}, big.NewInt(30).Bytes())
+	vmOutput, err := e.ProcessBuiltinFunction(spender, owner, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(70), getFungibleBalance(owner, tokenID))
+	assert.Equal(t, big.NewInt(30), getFungibleBalance(spender, tokenID))
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionMECTTransferFrom), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, allowanceOwner, vmOutput.Logs[0].Address)
+	assert.Equal(t, allowanceSpender, vmOutput.Logs[0].Topics[3])
+
+	remainingAllowance, _ := getAllowance(owner, allowanceKey)
+	assert.Equal(t, big.NewInt(20), remainingAllowance.value)
+
+	_, err = e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrInsufficientAllowance, err)
+}
+
+func TestMectTransferFrom_ProcessBuiltinFunctionFrozenOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTTransferFromFunc(createMockArgsForAllowance())
+	owner := mock.NewUserAccount(allowanceOwner)
+	spender := mock.NewUserAccount(allowanceSpender)
+	tokenID := []byte("TKN-abcdef")
+	frozenMetadata := MECTUserMetadata{Frozen: true}
+	marshaledData, _ := (&mock.MarshalizerMock{}).Marshal(&mect.MECToken{Value: big.NewInt(100), Properties: frozenMetadata.ToBytes()})
+	_ = owner.AccountDataHandler().SaveKeyValue(append([]byte(baseMECTKeyPrefix), tokenID...), marshaledData)
+	_ = saveAllowance(owner, computeAllowanceKey(allowanceSpender, tokenID, 0), &mectAllowance{value: big.NewInt(50)})
+
+	input := createTransferFromInput(tokenID, []byte{}, big.NewInt(30).Bytes())
+	_, err := e.ProcessBuiltinFunction(spender, owner, input)
+	assert.Equal(t, ErrMECTIsFrozenForAccount, err)
+}
+
+func TestMectTransferFrom_ProcessBuiltinFunctionLimitedTransferShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsForAllowance()
+	args.GlobalSettingsHandler = &mock.GlobalSettingsHandlerStub{
+		IsLimiterTransferCalled: func(token []byte) bool {
+			return true
+		},
+	}
+	args.RolesHandler = &mock.MECTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
+			return ErrActionNotAllowed
+		},
+	}
+	e, _ := NewMECTTransferFromFunc(args)
+	owner := mock.NewUserAccount(allowanceOwner)
+	tokenID := []byte("TKN-abcdef")
+	saveFungibleBalance(t, owner, tokenID, 100)
+	_ = saveAllowance(owner, computeAllowanceKey(allowanceSpender, tokenID, 0), &mectAllowance{value: big.NewInt(50)})
+
+	input := createTransferFromInput(tokenID, []byte{}, big.NewInt(30).Bytes())
+	_, err := e.ProcessBuiltinFunction(mock.NewUserAccount(allowanceSpender), owner, input)
+	assert.Equal(t, ErrActionNotAllowed, err)
+}
+
+func TestMectTransferFrom_ProcessBuiltinFunctionTokenWithTransferFeeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsForAllowance()
+	args.GlobalSettingsHandler = &mock.GlobalSettingsHandlerStub{
+		GetTransferFeeCalled: func(tokenID []byte) (uint32, []byte) {
+			return 250, allowanceOwner
+		},
+	}
+	args.EnableEpochsHandler = &mock.EnableEpochsHandlerStub{IsMECTTransferFeeFlagEnabledField: true}
+	e, _ := NewMECTTransferFromFunc(args)
+	owner := mock.NewUserAccount(allowanceOwner)
+	tokenID := []byte("TKN-abcdef")
+	saveFungibleBalance(t, owner, tokenID, 100)
+	_ = saveAllowance(owner, computeAllowanceKey(allowanceSpender, tokenID, 0), &mectAllowance{value: big.NewInt(50)})
+
+	input := createTransferFromInput(tokenID, []byte{}, big.NewInt(30).Bytes())
+	_, err := e.ProcessBuiltinFunction(mock.NewUserAccount(allowanceSpender), owner, input)
+	assert.Equal(t, ErrTransferFeeNotSupported, err)
+	assert.Equal(t, big.NewInt(100), getFungibleBalance(owner, tokenID))
+}
+
+func TestMectTransferFrom_ProcessBuiltinFunctionCrossShard(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewMECTTransferFromFunc(createMockArgsForAllowance())
+	tokenID := []byte("TKN-abcdef")
+
+	t.Run("spender shard from a smart contract should forward the call", func(t *testing.T) {
+		scSpender := make([]byte, 32)
+		scSpender[31] = 1
+		input := createTransferFromInput(tokenID, []byte{}, big.NewInt(30).Bytes())
+		input.CallerAddr = scSpender
+
+		vmOutput, err := e.ProcessBuiltinFunction(mock.NewUserAccount(scSpender), nil, input)
+		require.Nil(t, err)
+		function, args := extractScResultsFromVmOutput(t, vmOutput)
+		assert.Equal(t, vmcommon.BuiltInFunctionMECTTransferFrom, function)
+		assert.Equal(t, input.Arguments, args)
+		assert.NotNil(t, vmOutput.OutputAccounts[string(allowanceOwner)])
+	})
+	t.Run("owner shard should send the fungible tokens to the spender", func(t *testing.T) {
+		owner := mock.NewUserAccount(allowanceOwner)
+		saveFungibleBalance(t, owner, tokenID, 100)
+		_ = saveAllowance(owner, computeAllowanceKey(allowanceSpender, tokenID, 0), &mectAllowance{value: big.NewInt(50)})
+		input := createTransferFromInput(tokenID, []byte{}, big.NewInt(30).Bytes())
+
+		vmOutput, err := e.ProcessBuiltinFunction(nil, owner, input)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(70), getFungibleBalance(owner, tokenID))
+		function, args := extractScResultsFromVmOutput(t, vmOutput)
+		assert.Equal(t, core.BuiltInFunctionMECTTransfer, function)
+		assert.Equal(t, [][]byte{tokenID, big.NewInt(30).Bytes()}, args)
+		outputTransfer := vmOutput.OutputAccounts[string(allowanceSpender)].OutputTransfers[0]
+		assert.Equal(t, allowanceOwner, outputTransfer.SenderAddress)
+	})
+}
+
+func TestMectTransferFrom_ProcessBuiltinFunctionNFT(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	tokenID := []byte("SFT-abcdef")
+	nonce := uint64(7)
+
+	t.Run("same shard", func(t *testing.T) {
+		e, _ := NewMECTTransferFromFunc(createMockArgsForAllowance())
+		owner := mock.NewUserAccount(allowanceOwner)
+		spender := mock.NewUserAccount(allowanceSpender)
+		createMECTNFTToken(tokenID, core.NonFungible, nonce, big.NewInt(10), marshaller, owner)
+		_ = saveAllowance(owner, computeAllowanceKey(allowanceSpender, tokenID, nonce), &mectAllowance{value: big.NewInt(4)})
+
+		input := createTransferFromInput(tokenID, big.NewInt(int64(nonce)).Bytes(), big.NewInt(4).Bytes())
+		_, err := e.ProcessBuiltinFunction(spender, owner, input)
+		require.Nil(t, err)
+		testNFTTokenShouldExist(t, marshaller, owner, tokenID, nonce, big.NewInt(6))
+		testNFTTokenShouldExist(t, marshaller, spender, tokenID, nonce, big.NewInt(4))
+	})
+	t.Run("cross shard", func(t *testing.T) {
+		e, _ := NewMECTTransferFromFunc(createMockArgsForAllowance())
+		owner := mock.NewUserAccount(allowanceOwner)
+		createMECTNFTToken(tokenID, core.NonFungible, nonce, big.NewInt(10), marshaller, owner)
+		_ = saveAllowance(owner, computeAllowanceKey(allowanceSpender, tokenID, nonce), &mectAllowance{value: big.NewInt(4)})
+
+		input := createTransferFromInput(tokenID, big.NewInt(int64(nonce)).Bytes(), big.NewInt(4).Bytes())
+		vmOutput, err := e.ProcessBuiltinFunction(nil, owner, input)
+		require.Nil(t, err)
+		testNFTTokenShouldExist(t, marshaller, owner, tokenID, nonce, big.NewInt(6))
+
+		function, args := extractScResultsFromVmOutput(t, vmOutput)
+		assert.Equal(t, core.BuiltInFunctionMECTNFTTransfer, function)
+		require.Equal(t, 4, len(args))
+		transferredData := &mect.MECToken{}
+		_ = marshaller.Unmarshal(transferredData, args[3])
+		assert.Equal(t, big.NewInt(4), transferredData.Value)
+		assert.Equal(t, nonce, transferredData.TokenMetaData.Nonce)
+	})
+}
+
+func TestMectAllowance_SerializeShouldBeReversible(t *testing.T) {
+	t.Parallel()
+
+	expectedAllowance := &mectAllowance{value: big.NewInt(123456), expiryEpoch: 77}
+	recovered, err := deserializeAllowance(serializeAllowance(expectedAllowance))
+	require.Nil(t, err)
+	assert.Equal(t, expectedAllowance, recovered)
+
+	_, err = deserializeAllowance([]byte{1, 2})
+	assert.Equal(t, ErrInvalidAllowanceData, err)
+}
@@ -5,7 +5,6 @@ import (
 	"math/big"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/data/mect"
 	"github.com/ME-MotherEarth/me-core/marshal"
@@ -17,8 +16,9 @@ const transfer = "transfer"
 var transferAddressesKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + transfer + core.MECTKeyIdentifier)
 
 type mectTransferAddress struct {
-	*baseEnabled
+	*baseActiveHandler
 	set             bool
+	function        string
 	marshaller      vmcommon.Marshalizer
 	accounts        vmcommon.AccountsAdapter
 	maxNumAddresses uint32
@@ -28,16 +28,15 @@ type mectTransferAddress struct {
 func NewMECTTransferRoleAddressFunc(
 	accounts vmcommon.AccountsAdapter,
 	marshaller marshal.Marshalizer,
-	activationEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	activeHandler func() bool,
 	maxNumAddresses uint32,
 	set bool,
 ) (*mectTransferAddress, error) {
 	if check.IfNil(marshaller) {
 		return nil, ErrNilMarshalizer
 	}
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
 	}
 	if check.IfNil(accounts) {
 		return nil, ErrNilAccountsAdapter
@@ -51,18 +50,15 @@ func NewMECTTransferRoleAddressFunc(
 		marshaller:      marshaller,
 		maxNumAddresses: maxNumAddresses,
 		set:             set,
-	}
-
-	e.baseEnabled = &baseEnabled{
 		function:        vmcommon.BuiltInFunctionMECTTransferRoleAddAddress,
-		activationEpoch: activationEpoch,
-		flagActivated:   atomic.Flag{},
 	}
 	if !set {
 		e.function = vmcommon.BuiltInFunctionMECTTransferRoleDeleteAddress
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
+	}
 
 	return e, nil
 }
@@ -160,6 +156,15 @@ func (e *mectTransferAddress) getSystemAccount() (vmcommon.UserAccountHandler, e
 	return userAcc, nil
 }
 
+// EstimateGas returns 0 as the function is called by the system smart contract and does not consume gas
+func (e *mectTransferAddress) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return 0, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectTransferAddress) IsInterfaceNil() bool {
 	return e == nil
@@ -13,24 +13,24 @@ import (
 )
 
 func TestNewMECTTransferRoleAddressFunc(t *testing.T) {
-	_, err := NewMECTTransferRoleAddressFunc(nil, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{}, 10, true)
+	_, err := NewMECTTransferRoleAddressFunc(nil, &mock.MarshalizerMock{}, trueHandler, 10, true)
 	assert.Equal(t, err, ErrNilAccountsAdapter)
 
-	_, err = NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, nil, 0, &mock.EpochNotifierStub{}, 10, true)
+	_, err = NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, nil, trueHandler, 10, true)
 	assert.Equal(t, err, ErrNilMarshalizer)
 
-	_, err = NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, 0, nil, 10, true)
-	assert.Equal(t, err, ErrNilEpochHandler)
+	_, err = NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, nil, 10, true)
+	assert.Equal(t, err, ErrNilActiveHandler)
 
-	e, err := NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{}, 0, true)
+	e, err := NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, trueHandler, 0, true)
 	assert.Equal(t, err, ErrInvalidMaxNumAddresses)
 	assert.True(t, check.IfNil(e))
 
-	e, err = NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{}, 10, true)
+	e, err = NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, trueHandler, 10, true)
 	assert.Nil(t, err)
 	assert.Equal(t, e.function, vmcommon.BuiltInFunctionMECTTransferRoleAddAddress)
 
-	e, err = NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, 0, &mock.EpochNotifierStub{}, 10, false)
+	e, err = NewMECTTransferRoleAddressFunc(&mock.AccountsStub{}, &mock.MarshalizerMock{}, trueHandler, 10, false)
 	assert.Nil(t, err)
 	assert.Equal(t, e.function, vmcommon.BuiltInFunctionMECTTransferRoleDeleteAddress)
 
@@ -41,7 +41,7 @@ func TestNewMECTTransferRoleAddressFunc(t *testing.T) {
 func TestMECTTransferRoleProcessBuiltInFunction_Errors(t *testing.T) {
 	accounts := &mock.AccountsStub{}
 	marshaller := &mock.MarshalizerMock{}
-	e, err := NewMECTTransferRoleAddressFunc(accounts, marshaller, 0, &mock.EpochNotifierStub{}, 10, true)
+	e, err := NewMECTTransferRoleAddressFunc(accounts, marshaller, trueHandler, 10, true)
 	assert.Nil(t, err)
 	assert.Equal(t, e.function, vmcommon.BuiltInFunctionMECTTransferRoleAddAddress)
 
@@ -99,7 +99,7 @@ func TestMECTTransferRoleProcessBuiltInFunction_Errors(t *testing.T) {
 func TestMECTTransferRoleProcessBuiltInFunction_AddNewAddresses(t *testing.T) {
 	accounts := &mock.AccountsStub{}
 	marshaller := &mock.MarshalizerMock{}
-	e, err := NewMECTTransferRoleAddressFunc(accounts, marshaller, 0, &mock.EpochNotifierStub{}, 10, true)
+	e, err := NewMECTTransferRoleAddressFunc(accounts, marshaller, trueHandler, 10, true)
 	assert.Nil(t, err)
 	assert.Equal(t, e.function, vmcommon.BuiltInFunctionMECTTransferRoleAddAddress)
 
@@ -144,7 +144,7 @@ func TestMECTTransferRoleProcessBuiltInFunction_AddNewAddresses(t *testing.T) {
 func TestMECTTransferRoleIsSenderOrDestinationWithTransferRole(t *testing.T) {
 	accounts := &mock.AccountsStub{}
 	marshaller := &mock.MarshalizerMock{}
-	e, err := NewMECTTransferRoleAddressFunc(accounts, marshaller, 0, &mock.EpochNotifierStub{}, 10, true)
+	e, err := NewMECTTransferRoleAddressFunc(accounts, marshaller, trueHandler, 10, true)
 	assert.Nil(t, err)
 	assert.Equal(t, e.function, vmcommon.BuiltInFunctionMECTTransferRoleAddAddress)
 
@@ -171,7 +171,7 @@ func TestMECTTransferRoleIsSenderOrDestinationWithTransferRole(t *testing.T) {
 	addresses, _, _ := getMECTRolesForAcnt(e.marshaller, systemAcc, append(transferAddressesKeyPrefix, vmInput.Arguments[0]...))
 	assert.Equal(t, len(addresses.Roles), 3)
 
-	globalSettings, _ := NewMECTGlobalSettingsFunc(accounts, marshaller, true, vmcommon.BuiltInFunctionMECTSetBurnRoleForAll, 0, &mock.EpochNotifierStub{})
+	globalSettings, _ := NewMECTGlobalSettingsFunc(accounts, marshaller, true, vmcommon.BuiltInFunctionMECTSetBurnRoleForAll, &mock.EnableEpochsHandlerStub{}, trueHandler)
 	assert.False(t, globalSettings.IsSenderOrDestinationWithTransferRole(nil, nil, nil))
 	assert.False(t, globalSettings.IsSenderOrDestinationWithTransferRole(vmInput.Arguments[1], []byte("random"), []byte("random")))
 	assert.False(t, globalSettings.IsSenderOrDestinationWithTransferRole(vmInput.Arguments[1], vmInput.Arguments[2], []byte("random")))
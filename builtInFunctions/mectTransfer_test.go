@@ -22,10 +22,11 @@ func TestMECTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
 		&mock.MarshalizerMock{},
 		&mock.GlobalSettingsHandlerStub{},
 		shardC,
+		&mock.AccountsStub{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
 	_, err := transferFunc.ProcessBuiltinFunction(nil, nil, nil)
@@ -80,10 +81,11 @@ func TestMECTTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
 		marshaller,
 		&mock.GlobalSettingsHandlerStub{},
 		&mock.ShardCoordinatorStub{},
+		&mock.AccountsStub{},
 		mectRoleHandler,
-		1000,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
 
@@ -127,10 +129,11 @@ func TestMECTTransfer_ProcessBuiltInFunctionSenderInShard(t *testing.T) {
 		marshaller,
 		&mock.GlobalSettingsHandlerStub{},
 		&mock.ShardCoordinatorStub{},
+		&mock.AccountsStub{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
 
@@ -166,10 +169,11 @@ func TestMECTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 		marshaller,
 		&mock.GlobalSettingsHandlerStub{},
 		&mock.ShardCoordinatorStub{},
+		&mock.AccountsStub{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
 
@@ -199,16 +203,17 @@ func TestMECTTransfer_SndDstFrozen(t *testing.T) {
 
 	marshaller := &mock.MarshalizerMock{}
 	accountStub := &mock.AccountsStub{}
-	mectGlobalSettingsFunc, _ := NewMECTGlobalSettingsFunc(accountStub, marshaller, true, core.BuiltInFunctionMECTPause, 0, &mock.EpochNotifierStub{})
+	mectGlobalSettingsFunc, _ := NewMECTGlobalSettingsFunc(accountStub, marshaller, true, core.BuiltInFunctionMECTPause, &mock.EnableEpochsHandlerStub{}, trueHandler)
 	transferFunc, _ := NewMECTTransferFunc(
 		10,
 		marshaller,
 		mectGlobalSettingsFunc,
 		&mock.ShardCoordinatorStub{},
+		accountStub,
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
 
@@ -292,16 +297,17 @@ func TestMECTTransfer_SndDstWithLimitedTransfer(t *testing.T) {
 			return nil
 		},
 	}
-	mectGlobalSettingsFunc, _ := NewMECTGlobalSettingsFunc(accountStub, marshaller, true, core.BuiltInFunctionMECTSetLimitedTransfer, 0, &mock.EpochNotifierStub{})
+	mectGlobalSettingsFunc, _ := NewMECTGlobalSettingsFunc(accountStub, marshaller, true, core.BuiltInFunctionMECTSetLimitedTransfer, &mock.EnableEpochsHandlerStub{}, trueHandler)
 	transferFunc, _ := NewMECTTransferFunc(
 		10,
 		marshaller,
 		mectGlobalSettingsFunc,
 		&mock.ShardCoordinatorStub{},
+		accountStub,
 		rolesHandler,
-		1000,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
 
@@ -382,10 +388,11 @@ func TestMECTTransfer_ProcessBuiltInFunctionOnAsyncCallBack(t *testing.T) {
 		marshaller,
 		&mock.GlobalSettingsHandlerStub{},
 		&mock.ShardCoordinatorStub{},
+		&mock.AccountsStub{},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
-		&mock.EpochNotifierStub{},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 	)
 	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
 
@@ -425,43 +432,164 @@ func TestMECTTransfer_ProcessBuiltInFunctionOnAsyncCallBack(t *testing.T) {
 	assert.True(t, mectToken.Value.Cmp(big.NewInt(90)) == 0)
 }
 
-func TestMECTTransfer_EpochChange(t *testing.T) {
+func TestMECTTransfer_WithTransferFee(t *testing.T) {
 	t.Parallel()
 
-	var functionHandler vmcommon.EpochSubscriberHandler
-	notifier := &mock.EpochNotifierStub{
-		RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
-			functionHandler = handler
+	marshaller := &mock.MarshalizerMock{}
+	key := []byte("key")
+	feeReceiver := bytes.Repeat([]byte{7}, 32)
+	accFeeReceiver := mock.NewUserAccount(feeReceiver)
+	createTransferFunc := func(feeReceiverShard uint32, addressWithTransferRole []byte) *mectTransfer {
+		transferFunc, _ := NewMECTTransferFunc(
+			10,
+			marshaller,
+			&mock.GlobalSettingsHandlerStub{
+				GetTransferFeeCalled: func(tokenID []byte) (uint32, []byte) {
+					assert.Equal(t, key, tokenID)
+					return 250, feeReceiver
+				},
+			},
+			&mock.ShardCoordinatorStub{
+				ComputeIdCalled: func(address []byte) uint32 {
+					if bytes.Equal(address, feeReceiver) {
+						return feeReceiverShard
+					}
+					return 0
+				},
+			},
+			&mock.AccountsStub{
+				LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+					assert.Equal(t, feeReceiver, address)
+					return accFeeReceiver, nil
+				},
+			},
+			&mock.MECTRoleHandlerStub{
+				CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
+					if bytes.Equal(account.AddressBytes(), addressWithTransferRole) {
+						return nil
+					}
+					return ErrActionNotAllowed
+				},
+			},
+			&mock.EnableEpochsHandlerStub{
+				IsCheckCorrectTokenIDEnabledField: true,
+				IsMECTTransferFeeFlagEnabledField: true,
+			},
+		)
+		_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
+
+		return transferFunc
+	}
+	getBalance := func(account vmcommon.UserAccountHandler) *big.Int {
+		mectToken := &mect.MECToken{Value: big.NewInt(0)}
+		marshaledData, _ := account.AccountDataHandler().RetrieveValue(append([]byte(baseMECTKeyPrefix), key...))
+		_ = marshaller.Unmarshal(mectToken, marshaledData)
+		return mectToken.Value
+	}
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			CallerAddr:  []byte("snd"),
+			Arguments:   [][]byte{key, big.NewInt(400).Bytes()},
 		},
+		RecipientAddr: []byte("dst"),
 	}
-	transferFunc, _ := NewMECTTransferFunc(
-		10,
-		&mock.MarshalizerMock{},
-		&mock.GlobalSettingsHandlerStub{},
-		&mock.ShardCoordinatorStub{},
-		&mock.MECTRoleHandlerStub{},
-		1,
-		2,
-		notifier,
-	)
-
-	functionHandler.EpochConfirmed(0, 0)
-	assert.False(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.False(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-
-	functionHandler.EpochConfirmed(1, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.False(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-
-	functionHandler.EpochConfirmed(2, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
-
-	functionHandler.EpochConfirmed(3, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
 
-	functionHandler.EpochConfirmed(4, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
+	t.Run("fee receiver in shard", func(t *testing.T) {
+		transferFunc := createTransferFunc(0, nil)
+		accSnd := mock.NewUserAccount([]byte("snd"))
+		accDst := mock.NewUserAccount([]byte("dst"))
+		_ = addToMECTBalance(accSnd, append([]byte(baseMECTKeyPrefix), key...), big.NewInt(1000), marshaller, transferFunc.globalSettingsHandler, false)
+
+		vmOutput, err := transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(600), getBalance(accSnd))
+		assert.Equal(t, big.NewInt(390), getBalance(accDst))
+		assert.Equal(t, big.NewInt(10), getBalance(accFeeReceiver))
+		assert.Empty(t, vmOutput.OutputAccounts)
+		assert.Equal(t, []byte(vmcommon.MECTTransferFeeIdentifier), vmOutput.Logs[0].Identifier)
+		assert.Equal(t, [][]byte{key, {}, big.NewInt(10).Bytes(), feeReceiver}, vmOutput.Logs[0].Topics)
+		assert.Equal(t, []byte("dst"), vmOutput.Logs[0].Address)
+	})
+	t.Run("fee receiver cross shard", func(t *testing.T) {
+		transferFunc := createTransferFunc(1, nil)
+		accDst := mock.NewUserAccount([]byte("dst"))
+
+		vmOutput, err := transferFunc.ProcessBuiltinFunction(nil, accDst, input)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(390), getBalance(accDst))
+
+		assert.Equal(t, big.NewInt(0), vmOutput.OutputAccounts[string(feeReceiver)].BalanceDelta)
+		outputTransfers := vmOutput.OutputAccounts[string(feeReceiver)].OutputTransfers
+		assert.Equal(t, 1, len(outputTransfers))
+		assert.Equal(t, []byte(core.BuiltInFunctionMECTTransfer+"@6b6579@0a"), outputTransfers[0].Data)
+		assert.Equal(t, []byte("dst"), outputTransfers[0].SenderAddress)
+		assert.Equal(t, big.NewInt(0), outputTransfers[0].Value)
+	})
+	t.Run("smart contract call after the transfer should see the value left after the fee", func(t *testing.T) {
+		transferFunc := createTransferFunc(0, nil)
+		_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{
+			DetermineIsSCCallAfterCalled: func(vmInput *vmcommon.ContractCallInput, dstAddress []byte, mintArgs int) bool {
+				return true
+			},
+		})
+		accDst := mock.NewUserAccount([]byte("dst"))
+		arguments := [][]byte{key, big.NewInt(400).Bytes(), []byte("deposit")}
+		scInput := *input
+		scInput.Arguments = arguments
+
+		vmOutput, err := transferFunc.ProcessBuiltinFunction(nil, accDst, &scInput)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(390), getBalance(accDst))
+		assert.Equal(t, [][]byte{key, big.NewInt(390).Bytes(), []byte("deposit")}, scInput.Arguments)
+		assert.Equal(t, big.NewInt(400).Bytes(), arguments[1])
+		assert.Equal(t, []byte("deposit"), vmOutput.OutputAccounts["dst"].OutputTransfers[0].Data)
+		assert.Equal(t, big.NewInt(400).Bytes(), vmOutput.Logs[1].Topics[2])
+	})
+	t.Run("destination with transfer role is exempted in every shard layout", func(t *testing.T) {
+		transferFunc := createTransferFunc(1, []byte("dst"))
+		accDst := mock.NewUserAccount([]byte("dst"))
+
+		vmOutput, err := transferFunc.ProcessBuiltinFunction(nil, accDst, input)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(400), getBalance(accDst))
+		assert.Empty(t, vmOutput.OutputAccounts)
+
+		accSnd := mock.NewUserAccount([]byte("snd"))
+		accDst = mock.NewUserAccount([]byte("dst"))
+		_ = addToMECTBalance(accSnd, append([]byte(baseMECTKeyPrefix), key...), big.NewInt(1000), marshaller, transferFunc.globalSettingsHandler, false)
+
+		vmOutput, err = transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(400), getBalance(accDst))
+		assert.Empty(t, vmOutput.OutputAccounts)
+	})
+	t.Run("sender with transfer role is charged in every shard layout", func(t *testing.T) {
+		transferFunc := createTransferFunc(1, []byte("snd"))
+		accDst := mock.NewUserAccount([]byte("dst"))
+
+		_, err := transferFunc.ProcessBuiltinFunction(nil, accDst, input)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(390), getBalance(accDst))
+
+		accSnd := mock.NewUserAccount([]byte("snd"))
+		accDst = mock.NewUserAccount([]byte("dst"))
+		_ = addToMECTBalance(accSnd, append([]byte(baseMECTKeyPrefix), key...), big.NewInt(1000), marshaller, transferFunc.globalSettingsHandler, false)
+
+		_, err = transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(600), getBalance(accSnd))
+		assert.Equal(t, big.NewInt(390), getBalance(accDst))
+	})
+	t.Run("transfer from the MECT system SC is exempted", func(t *testing.T) {
+		transferFunc := createTransferFunc(1, nil)
+		accDst := mock.NewUserAccount([]byte("dst"))
+		scInput := *input
+		scInput.CallerAddr = core.MECTSCAddress
+
+		_, err := transferFunc.ProcessBuiltinFunction(nil, accDst, &scInput)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(400), getBalance(accDst))
+	})
 }
@@ -0,0 +1,421 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const numArgsMECTVestedTransfer = 7
+
+type mectVestedTransfer struct {
+	*baseActiveHandler
+	funcGasCost           uint64
+	gasConfig             vmcommon.BaseOperationCost
+	marshaller            vmcommon.Marshalizer
+	keyPrefix             []byte
+	accounts              vmcommon.AccountsAdapter
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	rolesHandler          vmcommon.MECTRoleHandler
+	mectStorageHandler    vmcommon.MECTNFTStorageHandler
+	shardCoordinator      vmcommon.Coordinator
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
+	mutExecution          sync.RWMutex
+}
+
+// NewMECTVestedTransferFunc returns the mect vested transfer built-in function component
+func NewMECTVestedTransferFunc(args ArgsNewMECTVestingFunc) (*mectVestedTransfer, error) {
+	err := checkVestingArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &mectVestedTransfer{
+		funcGasCost:           args.FuncGasCost,
+		gasConfig:             args.GasConfig,
+		marshaller:            args.Marshaller,
+		keyPrefix:             []byte(baseMECTKeyPrefix),
+		accounts:              args.Accounts,
+		globalSettingsHandler: args.GlobalSettingsHandler,
+		rolesHandler:          args.RolesHandler,
+		mectStorageHandler:    args.StorageHandler,
+		shardCoordinator:      args.ShardCoordinator,
+		enableEpochsHandler:   args.EnableEpochsHandler,
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: args.ActiveHandler,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *mectVestedTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.MECTVestedTransfer
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction moves a fungible or SFT amount into a locked balance of the destination. The sender sends the
+// call to its own address. Requires 7 arguments:
+// arg0 - token identifier
+// arg1 - nonce, 0 for fungible tokens
+// arg2 - value to lock
+// arg3 - destination address
+// arg4 - cliff epoch
+// arg5 - end epoch
+// arg6 - number of release steps, 0 for a linear release
+// if cross-shard, the token data of the SFT is added as the 8th argument
+func (e *mectVestedTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicMECTArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < numArgsMECTVestedTransfer {
+		return nil, ErrInvalidArguments
+	}
+
+	if bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return e.processOnSenderShard(acntSnd, vmInput)
+	}
+
+	// in cross shard vested transfer the sender account must be nil
+	if !check.IfNil(acntSnd) {
+		return nil, ErrInvalidRcvAddr
+	}
+	if check.IfNil(acntDst) {
+		return nil, ErrInvalidRcvAddr
+	}
+	if len(vmInput.Arguments) > numArgsMECTVestedTransfer+1 || !bytes.Equal(vmInput.Arguments[3], vmInput.RecipientAddr) {
+		return nil, ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	schedule, err := parseVestingSchedule(value, vmInput.Arguments[4], vmInput.Arguments[5], vmInput.Arguments[6])
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenData *mect.MECToken
+	if nonce > 0 {
+		if len(vmInput.Arguments) != numArgsMECTVestedTransfer+1 {
+			return nil, ErrInvalidArguments
+		}
+		tokenData = &mect.MECToken{}
+		err = e.marshaller.Unmarshal(tokenData, vmInput.Arguments[numArgsMECTVestedTransfer])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = e.addLockedToDestination(vmInput.CallerAddr, acntDst, tokenID, nonce, schedule, tokenData)
+	if err != nil {
+		return nil, err
+	}
+
+	// no need to consume gas on destination - sender already paid for it
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided}
+	addMECTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionMECTVestedTransfer), tokenID, nonce, value, vmInput.CallerAddr, acntDst.AddressBytes())
+
+	return vmOutput, nil
+}
+
+func (e *mectVestedTransfer) processOnSenderShard(
+	acntSnd vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntSnd) {
+		return nil, ErrNilUserAccount
+	}
+	if len(vmInput.Arguments) != numArgsMECTVestedTransfer {
+		return nil, ErrInvalidArguments
+	}
+	dstAddress := vmInput.Arguments[3]
+	if len(dstAddress) != len(vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, not a valid destination address", ErrInvalidArguments)
+	}
+	if bytes.Equal(dstAddress, vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, can not transfer to self", ErrInvalidArguments)
+	}
+	isInvalidTransferToMeta := e.shardCoordinator.ComputeId(dstAddress) == core.MetachainShardId && !e.enableEpochsHandler.IsTransferToMetaFlagEnabled()
+	if isInvalidTransferToMeta {
+		return nil, ErrInvalidRcvAddr
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		return nil, ErrNegativeValue
+	}
+	schedule, err := parseVestingSchedule(value, vmInput.Arguments[4], vmInput.Arguments[5], vmInput.Arguments[6])
+	if err != nil {
+		return nil, err
+	}
+
+	var dstAccount vmcommon.UserAccountHandler
+	isSameShard := e.shardCoordinator.SelfId() == e.shardCoordinator.ComputeId(dstAddress)
+	if isSameShard {
+		dstAccount, err = e.loadUserAccount(dstAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mectTokenKey := append(e.keyPrefix, tokenID...)
+	keyToCheck := mectTokenKey
+	if e.enableEpochsHandler.IsCheckCorrectTokenIDEnabled() {
+		keyToCheck = tokenID
+	}
+	err = checkIfTransferCanHappenWithLimitedTransfer(keyToCheck, mectTokenKey, acntSnd.AddressBytes(), dstAddress, e.globalSettingsHandler, e.rolesHandler, acntSnd, dstAccount, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+	err = checkIfTransferCanHappenWithSoulbound(mectTokenKey, e.globalSettingsHandler, acntSnd, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+	err = checkNoTransferFee(e.globalSettingsHandler, e.enableEpochsHandler, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenData, err := e.removeFromSender(acntSnd, mectTokenKey, nonce, value, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	if isSameShard {
+		err = e.addLockedToDestination(vmInput.CallerAddr, dstAccount, tokenID, nonce, schedule, tokenData)
+		if err != nil {
+			return nil, err
+		}
+
+		err = e.accounts.SaveAccount(dstAccount)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = e.addVestedTransferToVMOutput(vmInput, vmOutput, mectTokenKey, nonce, value, tokenData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addMECTEntryInVMOutput(vmOutput, []byte(vmcommon.BuiltInFunctionMECTVestedTransfer), tokenID, nonce, value, vmInput.CallerAddr, dstAddress)
+
+	return vmOutput, nil
+}
+
+// removeFromSender returns the token data of the transferred SFT quantity, nil for fungible tokens
+func (e *mectVestedTransfer) removeFromSender(
+	acntSnd vmcommon.UserAccountHandler,
+	mectTokenKey []byte,
+	nonce uint64,
+	value *big.Int,
+	isReturnWithError bool,
+) (*mect.MECToken, error) {
+	if nonce == 0 {
+		return nil, addToMECTBalance(acntSnd, mectTokenKey, big.NewInt(0).Neg(value), e.marshaller, e.globalSettingsHandler, isReturnWithError)
+	}
+
+	mectData, err := e.mectStorageHandler.GetMECTNFTTokenOnSender(acntSnd, mectTokenKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if mectData.Value.Cmp(value) < 0 {
+		return nil, ErrInvalidNFTQuantity
+	}
+
+	mectData.Value.Sub(mectData.Value, value)
+	_, err = e.mectStorageHandler.SaveMECTNFTToken(acntSnd.AddressBytes(), acntSnd, mectTokenKey, nonce, mectData, false, isReturnWithError)
+	if err != nil {
+		return nil, err
+	}
+
+	mectData.Value.Set(value)
+
+	return mectData, nil
+}
+
+func (e *mectVestedTransfer) addVestedTransferToVMOutput(
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	mectTokenKey []byte,
+	nonce uint64,
+	value *big.Int,
+	tokenData *mect.MECToken,
+) error {
+	callArgs := make([][]byte, 0, numArgsMECTVestedTransfer+1)
+	callArgs = append(callArgs, vmInput.Arguments[:numArgsMECTVestedTransfer]...)
+	if nonce > 0 {
+		err := e.mectStorageHandler.AddToLiquiditySystemAcc(mectTokenKey, nonce, big.NewInt(0).Neg(value))
+		if err != nil {
+			return err
+		}
+
+		marshaledTokenData, err := e.marshaller.Marshal(tokenData)
+		if err != nil {
+			return err
+		}
+
+		gasForTransfer := computeGasForDataCopy(marshaledTokenData, e.gasConfig)
+		if gasForTransfer > vmOutput.GasRemaining {
+			return ErrNotEnoughGas
+		}
+		vmOutput.GasRemaining -= gasForTransfer
+		callArgs = append(callArgs, marshaledTokenData)
+	}
+
+	addNFTTransferToVMOutput(
+		vmInput.CallerAddr,
+		vmInput.Arguments[3],
+		vmcommon.BuiltInFunctionMECTVestedTransfer,
+		callArgs,
+		vmInput.GasLocked,
+		0,
+		vmInput.CallType,
+		vmOutput,
+	)
+
+	return nil
+}
+
+func (e *mectVestedTransfer) addLockedToDestination(
+	sndAddress []byte,
+	acntDst vmcommon.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+	schedule *vmcommon.MECTVestingSchedule,
+	tokenData *mect.MECToken,
+) error {
+	schedules, err := getVestingSchedules(acntDst, tokenID, nonce)
+	if err != nil {
+		return err
+	}
+	if len(schedules) >= maxVestingSchedules {
+		return ErrTooManyVestingSchedules
+	}
+
+	err = saveVestingSchedules(acntDst, tokenID, nonce, append(schedules, schedule))
+	if err != nil {
+		return err
+	}
+	if nonce == 0 {
+		return nil
+	}
+
+	err = e.saveVestingTokenData(acntDst, tokenID, nonce, tokenData)
+	if err != nil {
+		return err
+	}
+
+	if !e.shardCoordinator.SameShard(sndAddress, acntDst.AddressBytes()) {
+		mectTokenKey := append(e.keyPrefix, tokenID...)
+		return e.mectStorageHandler.AddToLiquiditySystemAcc(mectTokenKey, nonce, schedule.Amount)
+	}
+
+	return nil
+}
+
+func (e *mectVestedTransfer) saveVestingTokenData(acntDst vmcommon.UserAccountHandler, tokenID []byte, nonce uint64, tokenData *mect.MECToken) error {
+	savedTokenData, err := getVestingTokenData(acntDst, tokenID, nonce, e.marshaller)
+	if err != nil {
+		return err
+	}
+	if savedTokenData != nil {
+		return nil
+	}
+
+	tokenDataToSave := &mect.MECToken{
+		Type:          tokenData.Type,
+		Value:         big.NewInt(0),
+		TokenMetaData: tokenData.TokenMetaData,
+	}
+	marshaledData, err := e.marshaller.Marshal(tokenDataToSave)
+	if err != nil {
+		return err
+	}
+
+	return acntDst.AccountDataHandler().SaveKeyValue(computeVestingKey(vestingMetaDataKeyPrefix, tokenID, nonce), marshaledData)
+}
+
+func (e *mectVestedTransfer) loadUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	accountHandler, err := e.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := accountHandler.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+// EstimateGas returns the gas consumed on the sender shard by the MECT vested transfer, including the cost of sending
+// the SFT token data to the destination shard
+func (e *mectVestedTransfer) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+	if check.IfNil(acntSnd) || !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return 0, nil
+	}
+	if len(vmInput.Arguments) != numArgsMECTVestedTransfer {
+		return 0, ErrInvalidArguments
+	}
+
+	gasToConsume := e.funcGasCost
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	dstAddress := vmInput.Arguments[3]
+	if nonce == 0 || e.shardCoordinator.SelfId() == e.shardCoordinator.ComputeId(dstAddress) {
+		return gasToConsume, nil
+	}
+
+	mectTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	mectData, err := e.mectStorageHandler.GetMECTNFTTokenOnSender(acntSnd, mectTokenKey, nonce)
+	if err != nil {
+		return 0, err
+	}
+	mectData.Value = big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	marshaledTokenData, err := e.marshaller.Marshal(mectData)
+	if err != nil {
+		return 0, err
+	}
+
+	return gasToConsume + computeGasForDataCopy(marshaledTokenData, e.gasConfig), nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *mectVestedTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
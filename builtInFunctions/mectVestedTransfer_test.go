@@ -0,0 +1,328 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	vestingSender      = append(bytes.Repeat([]byte{1}, 31), 0)
+	vestingBeneficiary = append(bytes.Repeat([]byte{2}, 31), 0)
+	vestingRemote      = append(bytes.Repeat([]byte{3}, 31), 1)
+)
+
+func createMockArgsForVesting(currentEpoch uint32) (ArgsNewMECTVestingFunc, map[string]vmcommon.UserAccountHandler) {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1])
+	}
+	mapAccounts := make(map[string]vmcommon.UserAccountHandler)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			_, ok := mapAccounts[string(address)]
+			if !ok {
+				mapAccounts[string(address)] = mock.NewUserAccount(address)
+			}
+			return mapAccounts[string(address)], nil
+		},
+	}
+	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{}
+
+	return ArgsNewMECTVestingFunc{
+		FuncGasCost:           10,
+		Marshaller:            &mock.MarshalizerMock{},
+		Accounts:              accounts,
+		GlobalSettingsHandler: globalSettingsHandler,
+		RolesHandler:          &mock.MECTRoleHandlerStub{},
+		StorageHandler:        createNewMECTDataStorageHandlerWithArgs(globalSettingsHandler, accounts),
+		ShardCoordinator:      shardCoordinator,
+		EnableEpochsHandler:   &mock.EnableEpochsHandlerStub{CurrentEpochField: currentEpoch},
+		ActiveHandler:         trueHandler,
+	}, mapAccounts
+}
+
+func createVestedTransferInput(tokenID []byte, nonce uint64, value int64, destination []byte, cliff uint32, end uint32, steps uint32) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  vestingSender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments: [][]byte{
+				tokenID,
+				big.NewInt(0).SetUint64(nonce).Bytes(),
+				big.NewInt(value).Bytes(),
+				destination,
+				big.NewInt(int64(cliff)).Bytes(),
+				big.NewInt(int64(end)).Bytes(),
+				big.NewInt(int64(steps)).Bytes(),
+			},
+		},
+		RecipientAddr: vestingSender,
+	}
+}
+
+func TestNewMECTVestedTransferFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		args, _ := createMockArgsForVesting(0)
+		args.Accounts = nil
+		e, err := NewMECTVestedTransferFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil storage handler should error", func(t *testing.T) {
+		args, _ := createMockArgsForVesting(0)
+		args.StorageHandler = nil
+		e, err := NewMECTVestedTransferFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilMECTNFTStorageHandler, err)
+	})
+	t.Run("nil active handler should error", func(t *testing.T) {
+		args, _ := createMockArgsForVesting(0)
+		args.ActiveHandler = nil
+		e, err := NewMECTVestedTransferFunc(args)
+		assert.Nil(t, e)
+		assert.Equal(t, ErrNilActiveHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		args, _ := createMockArgsForVesting(0)
+		e, err := NewMECTVestedTransferFunc(args)
+		assert.Nil(t, err)
+		assert.False(t, e.IsInterfaceNil())
+
+		gasCost := createMockGasCost()
+		e.SetNewGasConfig(&gasCost)
+		assert.Equal(t, gasCost.BuiltInCost.MECTVestedTransfer, e.funcGasCost)
+		assert.Equal(t, gasCost.BaseOperationCost, e.gasConfig)
+	})
+}
+
+func TestMectVestedTransfer_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(0)
+	e, _ := NewMECTVestedTransferFunc(args)
+	sender := mock.NewUserAccount(vestingSender)
+	tokenID := []byte("TKN-abcdef")
+
+	_, err := e.ProcessBuiltinFunction(sender, nil, nil)
+	assert.Equal(t, ErrNilVmInput, err)
+
+	input := createVestedTransferInput(tokenID, 0, 10, vestingBeneficiary, 1, 5, 0)
+	input.Arguments = input.Arguments[:6]
+	_, err = e.ProcessBuiltinFunction(sender, nil, input)
+	assert.Equal(t, ErrInvalidArguments, err)
+
+	input = createVestedTransferInput(tokenID, 0, 10, vestingSender, 1, 5, 0)
+	_, err = e.ProcessBuiltinFunction(sender, nil, input)
+	assert.ErrorIs(t, err, ErrInvalidArguments)
+
+	input = createVestedTransferInput(tokenID, 0, 10, vestingBeneficiary, 1, 5, 0)
+	input.GasProvided = 1
+	_, err = e.ProcessBuiltinFunction(sender, nil, input)
+	assert.Equal(t, ErrNotEnoughGas, err)
+
+	input = createVestedTransferInput(tokenID, 0, 0, vestingBeneficiary, 1, 5, 0)
+	_, err = e.ProcessBuiltinFunction(sender, nil, input)
+	assert.Equal(t, ErrNegativeValue, err)
+
+	input = createVestedTransferInput(tokenID, 0, 10, vestingBeneficiary, 5, 1, 0)
+	_, err = e.ProcessBuiltinFunction(sender, nil, input)
+	assert.Equal(t, ErrInvalidVestingSchedule, err)
+
+	input = createVestedTransferInput(tokenID, 0, 10, vestingBeneficiary, 1, 5, 5)
+	_, err = e.ProcessBuiltinFunction(sender, nil, input)
+	assert.Equal(t, ErrInvalidVestingSchedule, err)
+
+	input = createVestedTransferInput(tokenID, 0, 10, vestingBeneficiary, 1, 5, 0)
+	_, err = e.ProcessBuiltinFunction(sender, nil, input)
+	assert.Equal(t, ErrInsufficientFunds, err)
+
+	input.CallerAddr = vestingRemote
+	_, err = e.ProcessBuiltinFunction(sender, mock.NewUserAccount(vestingSender), input)
+	assert.Equal(t, ErrInvalidRcvAddr, err)
+}
+
+func TestMectVestedTransfer_ProcessBuiltinFunctionSameShardFungible(t *testing.T) {
+	t.Parallel()
+
+	args, mapAccounts := createMockArgsForVesting(0)
+	e, _ := NewMECTVestedTransferFunc(args)
+	sender := mock.NewUserAccount(vestingSender)
+	tokenID := []byte("TKN-abcdef")
+	saveFungibleBalance(t, sender, tokenID, 100)
+
+	input := createVestedTransferInput(tokenID, 0, 40, vestingBeneficiary, 2, 10, 4)
+	vmOutput, err := e.ProcessBuiltinFunction(sender, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(990), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(60), getFungibleBalance(sender, tokenID))
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(vmcommon.BuiltInFunctionMECTVestedTransfer), vmOutput.Logs[0].Identifier)
+
+	beneficiary := mapAccounts[string(vestingBeneficiary)]
+	require.NotNil(t, beneficiary)
+	assert.Equal(t, 0, getFungibleBalance(beneficiary, tokenID).Sign())
+	schedules, err := getVestingSchedules(beneficiary, tokenID, 0)
+	require.Nil(t, err)
+	assert.Equal(t, []*vmcommon.MECTVestingSchedule{
+		{Amount: big.NewInt(40), Claimed: big.NewInt(0), CliffEpoch: 2, EndEpoch: 10, Steps: 4},
+	}, schedules)
+
+	// the locked balance can not be spent through the regular transfers
+	err = addToMECTBalance(beneficiary, append([]byte(baseMECTKeyPrefix), tokenID...), big.NewInt(-1), args.Marshaller, args.GlobalSettingsHandler, false)
+	assert.Equal(t, ErrInsufficientFunds, err)
+}
+
+func TestMectVestedTransfer_ProcessBuiltinFunctionTokenWithTransferFeeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(0)
+	args.GlobalSettingsHandler = &mock.GlobalSettingsHandlerStub{
+		GetTransferFeeCalled: func(tokenID []byte) (uint32, []byte) {
+			return 250, vestingRemote
+		},
+	}
+	args.EnableEpochsHandler = &mock.EnableEpochsHandlerStub{IsMECTTransferFeeFlagEnabledField: true}
+	e, _ := NewMECTVestedTransferFunc(args)
+	sender := mock.NewUserAccount(vestingSender)
+	tokenID := []byte("TKN-abcdef")
+	saveFungibleBalance(t, sender, tokenID, 100)
+
+	_, err := e.ProcessBuiltinFunction(sender, nil, createVestedTransferInput(tokenID, 0, 40, vestingBeneficiary, 2, 10, 4))
+	assert.Equal(t, ErrTransferFeeNotSupported, err)
+	assert.Equal(t, big.NewInt(100), getFungibleBalance(sender, tokenID))
+}
+
+func TestMectVestedTransfer_ProcessBuiltinFunctionCrossShardSFT(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	tokenID := []byte("SFT-abcdef")
+	nonce := uint64(3)
+
+	senderArgs, _ := createMockArgsForVesting(0)
+	senderFunc, _ := NewMECTVestedTransferFunc(senderArgs)
+	sender := mock.NewUserAccount(vestingSender)
+	createMECTNFTToken(tokenID, core.NonFungible, nonce, big.NewInt(10), marshaller, sender)
+
+	input := createVestedTransferInput(tokenID, nonce, 4, vestingRemote, 2, 10, 0)
+	expectedGas, err := senderFunc.EstimateGas(sender, nil, input)
+	require.Nil(t, err)
+	vmOutput, err := senderFunc.ProcessBuiltinFunction(sender, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-expectedGas, vmOutput.GasRemaining)
+	testNFTTokenShouldExist(t, marshaller, sender, tokenID, nonce, big.NewInt(6))
+
+	function, callArgs := extractScResultsFromVmOutput(t, vmOutput)
+	assert.Equal(t, vmcommon.BuiltInFunctionMECTVestedTransfer, function)
+	require.Equal(t, numArgsMECTVestedTransfer+1, len(callArgs))
+
+	destinationArgs, _ := createMockArgsForVesting(0)
+	destinationShardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	destinationShardCoordinator.CurrentShard = 1
+	destinationArgs.ShardCoordinator = destinationShardCoordinator
+	destinationFunc, _ := NewMECTVestedTransferFunc(destinationArgs)
+	beneficiary := mock.NewUserAccount(vestingRemote)
+	destinationInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  vestingSender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   callArgs,
+		},
+		RecipientAddr: vestingRemote,
+	}
+	vmOutput, err = destinationFunc.ProcessBuiltinFunction(nil, beneficiary, destinationInput)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(100), vmOutput.GasRemaining)
+
+	schedules, _ := getVestingSchedules(beneficiary, tokenID, nonce)
+	require.Equal(t, 1, len(schedules))
+	assert.Equal(t, big.NewInt(4), schedules[0].Amount)
+	tokenData, err := getVestingTokenData(beneficiary, tokenID, nonce, marshaller)
+	require.Nil(t, err)
+	assert.Equal(t, nonce, tokenData.TokenMetaData.Nonce)
+}
+
+func TestMectVestedTransfer_TooManySchedulesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(0)
+	e, _ := NewMECTVestedTransferFunc(args)
+	beneficiary := mock.NewUserAccount(vestingBeneficiary)
+	tokenID := []byte("TKN-abcdef")
+	schedules := make([]*vmcommon.MECTVestingSchedule, 0, maxVestingSchedules)
+	for i := 0; i < maxVestingSchedules; i++ {
+		schedules = append(schedules, &vmcommon.MECTVestingSchedule{Amount: big.NewInt(1), Claimed: big.NewInt(0)})
+	}
+	_ = saveVestingSchedules(beneficiary, tokenID, 0, schedules)
+
+	schedule := &vmcommon.MECTVestingSchedule{Amount: big.NewInt(1), Claimed: big.NewInt(0)}
+	err := e.addLockedToDestination(vestingSender, beneficiary, tokenID, 0, schedule, nil)
+	assert.Equal(t, ErrTooManyVestingSchedules, err)
+}
+
+func TestVestingSchedules_SerializeShouldBeReversible(t *testing.T) {
+	t.Parallel()
+
+	schedules := []*vmcommon.MECTVestingSchedule{
+		{Amount: big.NewInt(1000), Claimed: big.NewInt(0), CliffEpoch: 1, EndEpoch: 9, Steps: 2},
+		{Amount: big.NewInt(5), Claimed: big.NewInt(3), CliffEpoch: 7, EndEpoch: 7},
+	}
+	recovered, err := deserializeVestingSchedules(serializeVestingSchedules(schedules))
+	require.Nil(t, err)
+	assert.Equal(t, schedules, recovered)
+
+	_, err = deserializeVestingSchedules([]byte{1, 2, 3})
+	assert.Equal(t, ErrInvalidVestingData, err)
+
+	invalidData := serializeVestingSchedules(schedules)
+	_, err = deserializeVestingSchedules(invalidData[:len(invalidData)-1])
+	assert.Equal(t, ErrInvalidVestingData, err)
+}
+
+func TestMectVestedTransfer_TokenDataShouldNotBeOverwritten(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsForVesting(0)
+	e, _ := NewMECTVestedTransferFunc(args)
+	beneficiary := mock.NewUserAccount(vestingBeneficiary)
+	tokenID := []byte("SFT-abcdef")
+
+	firstData := &mect.MECToken{Type: uint32(core.NonFungible), Value: big.NewInt(5), TokenMetaData: &mect.MetaData{Nonce: 1, Name: []byte("first")}}
+	secondData := &mect.MECToken{Type: uint32(core.NonFungible), Value: big.NewInt(5), TokenMetaData: &mect.MetaData{Nonce: 1, Name: []byte("second")}}
+	require.Nil(t, e.saveVestingTokenData(beneficiary, tokenID, 1, firstData))
+	require.Nil(t, e.saveVestingTokenData(beneficiary, tokenID, 1, secondData))
+
+	savedData, _ := getVestingTokenData(beneficiary, tokenID, 1, args.Marshaller)
+	assert.Equal(t, []byte("first"), savedData.TokenMetaData.Name)
+	assert.Equal(t, 0, savedData.Value.Sign())
+}
+
+func TestGetVestingSchedules_ReadErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	schedules, err := getVestingSchedules(mock.NewUserAccount([]byte("beneficiary")), []byte("TKN-abcdef"), 0)
+	assert.Nil(t, err)
+	assert.Empty(t, schedules)
+
+	retrieveErr := errors.New("retrieve error")
+	schedules, err = getVestingSchedules(createAccountWithRetrieveValueError(retrieveErr), []byte("TKN-abcdef"), 0)
+	assert.Nil(t, schedules)
+	assert.Equal(t, retrieveErr, err)
+
+	tokenData, err := getVestingTokenData(createAccountWithRetrieveValueError(retrieveErr), []byte("TKN-abcdef"), 1, &mock.MarshalizerMock{})
+	assert.Nil(t, tokenData)
+	assert.Equal(t, retrieveErr, err)
+}
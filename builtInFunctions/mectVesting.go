@@ -0,0 +1,223 @@
+package builtInFunctions
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	"github.com/ME-MotherEarth/me-core/data/mect"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+const vesting = "vesting"
+const vestingMetaData = "vestingmetadata"
+
+// vestingKeyPrefix is the account key prefix under which the vesting schedules of each token and nonce are saved
+var vestingKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + vesting + core.MECTKeyIdentifier)
+
+// vestingMetaDataKeyPrefix is the account key prefix under which the token data of the locked SFTs is saved, as the
+// destination shard might not know the metadata when the locked quantity is claimed
+var vestingMetaDataKeyPrefix = []byte(core.MotherEarthProtectedKeyPrefix + vestingMetaData + core.MECTKeyIdentifier)
+
+// maxVestingSchedules is the maximum number of schedules an account can hold for a token nonce
+const maxVestingSchedules = 100
+
+// scheduleEpochsSize is the size of the cliff epoch, end epoch and steps of a serialized schedule
+const scheduleEpochsSize = 12
+
+// ArgsNewMECTVestingFunc defines the arguments needed to create the MECTVestedTransfer and MECTClaimVested built-in functions
+type ArgsNewMECTVestingFunc struct {
+	FuncGasCost           uint64
+	GasConfig             vmcommon.BaseOperationCost
+	Marshaller            vmcommon.Marshalizer
+	Accounts              vmcommon.AccountsAdapter
+	GlobalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	RolesHandler          vmcommon.MECTRoleHandler
+	StorageHandler        vmcommon.MECTNFTStorageHandler
+	ShardCoordinator      vmcommon.Coordinator
+	EnableEpochsHandler   vmcommon.EnableEpochsHandler
+	ActiveHandler         func() bool
+}
+
+func checkVestingArgs(args ArgsNewMECTVestingFunc) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Accounts) {
+		return ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.GlobalSettingsHandler) {
+		return ErrNilGlobalSettingsHandler
+	}
+	if check.IfNil(args.RolesHandler) {
+		return ErrNilRolesHandler
+	}
+	if check.IfNil(args.StorageHandler) {
+		return ErrNilMECTNFTStorageHandler
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return ErrNilEnableEpochsHandler
+	}
+	if args.ActiveHandler == nil {
+		return ErrNilActiveHandler
+	}
+
+	return nil
+}
+
+func computeVestingKey(prefix []byte, tokenID []byte, nonce uint64) []byte {
+	vestingKey := make([]byte, 0, len(prefix)+len(tokenID))
+	vestingKey = append(vestingKey, prefix...)
+	vestingKey = append(vestingKey, tokenID...)
+
+	return computeMECTNFTTokenKey(vestingKey, nonce)
+}
+
+func parseVestingSchedule(value *big.Int, cliffArg []byte, endArg []byte, stepsArg []byte) (*vmcommon.MECTVestingSchedule, error) {
+	epochs := make([]uint32, 0, 3)
+	for _, arg := range [][]byte{cliffArg, endArg, stepsArg} {
+		argValue := big.NewInt(0).SetBytes(arg)
+		if !argValue.IsUint64() || argValue.Uint64() > math.MaxUint32 {
+			return nil, ErrInvalidVestingSchedule
+		}
+		epochs = append(epochs, uint32(argValue.Uint64()))
+	}
+
+	schedule := &vmcommon.MECTVestingSchedule{
+		Amount:     big.NewInt(0).Set(value),
+		Claimed:    big.NewInt(0),
+		CliffEpoch: epochs[0],
+		EndEpoch:   epochs[1],
+		Steps:      epochs[2],
+	}
+	if schedule.EndEpoch < schedule.CliffEpoch {
+		return nil, ErrInvalidVestingSchedule
+	}
+	if schedule.Steps > schedule.EndEpoch-schedule.CliffEpoch {
+		return nil, ErrInvalidVestingSchedule
+	}
+
+	return schedule, nil
+}
+
+func serializeVestingSchedules(schedules []*vmcommon.MECTVestingSchedule) []byte {
+	buff := make([]byte, 0)
+	for _, schedule := range schedules {
+		epochs := make([]byte, scheduleEpochsSize)
+		binary.BigEndian.PutUint32(epochs[0:4], schedule.CliffEpoch)
+		binary.BigEndian.PutUint32(epochs[4:8], schedule.EndEpoch)
+		binary.BigEndian.PutUint32(epochs[8:12], schedule.Steps)
+		buff = append(buff, epochs...)
+
+		for _, value := range []*big.Int{schedule.Amount, schedule.Claimed} {
+			valueBytes := value.Bytes()
+			lengthBytes := make([]byte, lengthPrefixSize)
+			binary.BigEndian.PutUint32(lengthBytes, uint32(len(valueBytes)))
+
+			buff = append(buff, lengthBytes...)
+			buff = append(buff, valueBytes...)
+		}
+	}
+
+	return buff
+}
+
+func deserializeVestingSchedules(buff []byte) ([]*vmcommon.MECTVestingSchedule, error) {
+	schedules := make([]*vmcommon.MECTVestingSchedule, 0)
+	for len(buff) > 0 {
+		if len(buff) < scheduleEpochsSize {
+			return nil, ErrInvalidVestingData
+		}
+		schedule := &vmcommon.MECTVestingSchedule{
+			CliffEpoch: binary.BigEndian.Uint32(buff[0:4]),
+			EndEpoch:   binary.BigEndian.Uint32(buff[4:8]),
+			Steps:      binary.BigEndian.Uint32(buff[8:12]),
+		}
+		buff = buff[scheduleEpochsSize:]
+
+		values := make([]*big.Int, 0, 2)
+		for i := 0; i < 2; i++ {
+			if len(buff) < lengthPrefixSize {
+				return nil, ErrInvalidVestingData
+			}
+			length := int(binary.BigEndian.Uint32(buff[:lengthPrefixSize]))
+			buff = buff[lengthPrefixSize:]
+			if len(buff) < length {
+				return nil, ErrInvalidVestingData
+			}
+
+			values = append(values, big.NewInt(0).SetBytes(buff[:length]))
+			buff = buff[length:]
+		}
+		schedule.Amount = values[0]
+		schedule.Claimed = values[1]
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+func getVestingSchedules(account vmcommon.UserAccountHandler, tokenID []byte, nonce uint64) ([]*vmcommon.MECTVestingSchedule, error) {
+	buff, err := account.AccountDataHandler().RetrieveValue(computeVestingKey(vestingKeyPrefix, tokenID, nonce))
+	if err != nil {
+		return nil, err
+	}
+	if len(buff) == 0 {
+		return make([]*vmcommon.MECTVestingSchedule, 0), nil
+	}
+
+	return deserializeVestingSchedules(buff)
+}
+
+func saveVestingSchedules(account vmcommon.UserAccountHandler, tokenID []byte, nonce uint64, schedules []*vmcommon.MECTVestingSchedule) error {
+	vestingKey := computeVestingKey(vestingKeyPrefix, tokenID, nonce)
+	if len(schedules) == 0 {
+		return account.AccountDataHandler().SaveKeyValue(vestingKey, nil)
+	}
+
+	return account.AccountDataHandler().SaveKeyValue(vestingKey, serializeVestingSchedules(schedules))
+}
+
+func newLockedBalance(tokenID []byte, nonce uint64, schedules []*vmcommon.MECTVestingSchedule) *vmcommon.MECTLockedBalance {
+	lockedBalance := &vmcommon.MECTLockedBalance{
+		TokenID:   tokenID,
+		Nonce:     nonce,
+		Locked:    big.NewInt(0),
+		Schedules: schedules,
+	}
+	for _, schedule := range schedules {
+		lockedBalance.Locked.Add(lockedBalance.Locked, schedule.Amount)
+		lockedBalance.Locked.Sub(lockedBalance.Locked, schedule.Claimed)
+	}
+
+	return lockedBalance
+}
+
+func getVestingTokenData(
+	account vmcommon.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+	marshaller vmcommon.Marshalizer,
+) (*mect.MECToken, error) {
+	marshaledData, err := account.AccountDataHandler().RetrieveValue(computeVestingKey(vestingMetaDataKeyPrefix, tokenID, nonce))
+	if err != nil {
+		return nil, err
+	}
+	if len(marshaledData) == 0 {
+		return nil, nil
+	}
+
+	mectData := &mect.MECToken{}
+	err = marshaller.Unmarshal(mectData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return mectData, nil
+}
@@ -8,29 +8,26 @@ import (
 	"sync"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/data/mect"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
 
 type mectNFTMultiTransfer struct {
-	*baseEnabled
-	keyPrefix                      []byte
-	marshaller                     vmcommon.Marshalizer
-	globalSettingsHandler          vmcommon.ExtendedMECTGlobalSettingsHandler
-	payableHandler                 vmcommon.PayableChecker
-	funcGasCost                    uint64
-	accounts                       vmcommon.AccountsAdapter
-	shardCoordinator               vmcommon.Coordinator
-	gasConfig                      vmcommon.BaseOperationCost
-	mutExecution                   sync.RWMutex
-	mectStorageHandler             vmcommon.MECTNFTStorageHandler
-	rolesHandler                   vmcommon.MECTRoleHandler
-	transferToMetaEnableEpoch      uint32
-	flagTransferToMeta             atomic.Flag
-	checkCorrectTokenIDEnableEpoch uint32
-	flagCheckCorrectTokenID        atomic.Flag
+	*baseActiveHandler
+	keyPrefix             []byte
+	marshaller            vmcommon.Marshalizer
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	payableHandler        vmcommon.PayableChecker
+	funcGasCost           uint64
+	accounts              vmcommon.AccountsAdapter
+	shardCoordinator      vmcommon.Coordinator
+	gasConfig             vmcommon.BaseOperationCost
+	mutExecution          sync.RWMutex
+	mectStorageHandler    vmcommon.MECTNFTStorageHandler
+	rolesHandler          vmcommon.MECTRoleHandler
+	enableEpochsHandler   vmcommon.EnableEpochsHandler
+	transferFeeProcessor  *transferFeeProcessor
 }
 
 const argumentsPerTransfer = uint64(3)
@@ -43,11 +40,9 @@ func NewMECTNFTMultiTransferFunc(
 	accounts vmcommon.AccountsAdapter,
 	shardCoordinator vmcommon.Coordinator,
 	gasConfig vmcommon.BaseOperationCost,
-	activationEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	activeHandler func() bool,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
 	roleHandler vmcommon.MECTRoleHandler,
-	transferToMetaEnableEpoch uint32,
-	checkCorrectTokenIDEnableEpoch uint32,
 	mectStorageHandler vmcommon.MECTNFTStorageHandler,
 ) (*mectNFTMultiTransfer, error) {
 	if check.IfNil(marshaller) {
@@ -62,8 +57,11 @@ func NewMECTNFTMultiTransferFunc(
 	if check.IfNil(shardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
+	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
 	}
 	if check.IfNil(roleHandler) {
 		return nil, ErrNilRolesHandler
@@ -73,41 +71,36 @@ func NewMECTNFTMultiTransferFunc(
 	}
 
 	e := &mectNFTMultiTransfer{
-		keyPrefix:                      []byte(baseMECTKeyPrefix),
-		marshaller:                     marshaller,
-		globalSettingsHandler:          globalSettingsHandler,
-		funcGasCost:                    funcGasCost,
-		accounts:                       accounts,
-		shardCoordinator:               shardCoordinator,
-		gasConfig:                      gasConfig,
-		mutExecution:                   sync.RWMutex{},
-		payableHandler:                 &disabledPayableHandler{},
-		rolesHandler:                   roleHandler,
-		transferToMetaEnableEpoch:      transferToMetaEnableEpoch,
-		checkCorrectTokenIDEnableEpoch: checkCorrectTokenIDEnableEpoch,
-		mectStorageHandler:             mectStorageHandler,
-	}
-
-	e.baseEnabled = &baseEnabled{
-		function:        core.BuiltInFunctionMultiMECTNFTTransfer,
-		activationEpoch: activationEpoch,
-		flagActivated:   atomic.Flag{},
+		keyPrefix:             []byte(baseMECTKeyPrefix),
+		marshaller:            marshaller,
+		globalSettingsHandler: globalSettingsHandler,
+		funcGasCost:           funcGasCost,
+		accounts:              accounts,
+		shardCoordinator:      shardCoordinator,
+		gasConfig:             gasConfig,
+		mutExecution:          sync.RWMutex{},
+		payableHandler:        &disabledPayableHandler{},
+		rolesHandler:          roleHandler,
+		enableEpochsHandler:   enableEpochsHandler,
+		mectStorageHandler:    mectStorageHandler,
+		transferFeeProcessor: &transferFeeProcessor{
+			keyPrefix:             []byte(baseMECTKeyPrefix),
+			marshaller:            marshaller,
+			accounts:              accounts,
+			shardCoordinator:      shardCoordinator,
+			globalSettingsHandler: globalSettingsHandler,
+			rolesHandler:          roleHandler,
+			enableEpochsHandler:   enableEpochsHandler,
+		},
+	}
+
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (e *mectNFTMultiTransfer) EpochConfirmed(epoch uint32, nonce uint64) {
-	e.baseEnabled.EpochConfirmed(epoch, nonce)
-	e.flagTransferToMeta.SetValue(epoch >= e.transferToMetaEnableEpoch)
-	log.Debug("MECT NFT transfer to metachain flag", "enabled", e.flagTransferToMeta.IsSet())
-	e.flagCheckCorrectTokenID.SetValue(epoch >= e.checkCorrectTokenIDEnableEpoch)
-	log.Debug("MECT multi transfer check correct tokenID for transfer role", "enabled", e.flagCheckCorrectTokenID.IsSet())
-}
-
 // SetPayableChecker will set the payableCheck handler to the function
 func (e *mectNFTMultiTransfer) SetPayableChecker(payableHandler vmcommon.PayableChecker) error {
 	if check.IfNil(payableHandler) {
@@ -140,6 +133,9 @@ func (e *mectNFTMultiTransfer) SetNewGasConfig(gasCost *vmcommon.GasCost) {
 // arg0 - number of tokens to transfer
 // list of (tokenID - nonce - quantity/MECT NFT data)
 // function and list of arguments for SC Call
+// if the collection of a transferred NFT has royalties enforced, the royalties are taken out of the fungible payments
+// of the same transfer and sent to the royalties receiver on the sender shard
+// the transfer fees of the fungible tokens are taken out of the value received by the destination account
 func (e *mectNFTMultiTransfer) ProcessBuiltinFunction(
 	acntSnd, acntDst vmcommon.UserAccountHandler,
 	vmInput *vmcommon.ContractCallInput,
@@ -179,12 +175,14 @@ func (e *mectNFTMultiTransfer) ProcessBuiltinFunction(
 	vmOutput := &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided}
 	vmOutput.Logs = make([]*vmcommon.LogEntry, 0, numOfTransfers)
 	startIndex := uint64(1)
+	transferFees := make([]*transferFeePayment, 0)
 
 	err = e.payableHandler.CheckPayable(vmInput, vmInput.RecipientAddr, int(minNumOfArguments))
 	if err != nil {
 		return nil, err
 	}
 
+	isSCCallAfter := len(vmInput.Arguments) > int(minNumOfArguments) && vmcommon.IsSmartContractAddress(vmInput.RecipientAddr)
 	for i := uint64(0); i < numOfTransfers; i++ {
 		tokenStartIndex := startIndex + i*argumentsPerTransfer
 		tokenID := vmInput.Arguments[tokenStartIndex]
@@ -219,19 +217,30 @@ func (e *mectNFTMultiTransfer) ProcessBuiltinFunction(
 				return nil, fmt.Errorf("%w for token %s", err, string(tokenID))
 			}
 		} else {
-			transferredValue := big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+2])
-			value.Set(transferredValue)
-			err = addToMECTBalance(acntDst, mectTokenKey, transferredValue, e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
+			value.SetBytes(vmInput.Arguments[tokenStartIndex+2])
+			transferFee := e.transferFeeProcessor.computeTransferFee(acntDst, vmInput.CallerAddr, tokenID, value, vmInput.ReturnCallAfterError)
+			receivedValue := computeReceivedValue(value, transferFee)
+			err = addToMECTBalance(acntDst, mectTokenKey, receivedValue, e.marshaller, e.globalSettingsHandler, vmInput.ReturnCallAfterError)
 			if err != nil {
 				return nil, fmt.Errorf("%w for token %s", err, string(tokenID))
 			}
+
+			var crossShardFee *transferFeePayment
+			crossShardFee, err = e.transferFeeProcessor.payTransferFee(transferFee, vmInput.ReturnCallAfterError, vmOutput)
+			if err != nil {
+				return nil, fmt.Errorf("%w for token %s", err, string(tokenID))
+			}
+			transferFees = append(transferFees, crossShardFee)
+			if isSCCallAfter && receivedValue.Cmp(value) != 0 {
+				replaceTransferredValue(vmInput, int(tokenStartIndex+2), receivedValue)
+			}
 		}
 
 		addMECTEntryInVMOutput(vmOutput, []byte(core.BuiltInFunctionMultiMECTNFTTransfer), tokenID, nonce, value, vmInput.CallerAddr, acntDst.AddressBytes())
 	}
 
 	// no need to consume gas on destination - sender already paid for it
-	if len(vmInput.Arguments) > int(minNumOfArguments) && vmcommon.IsSmartContractAddress(vmInput.RecipientAddr) {
+	if isSCCallAfter {
 		var callArgs [][]byte
 		if len(vmInput.Arguments) > int(minNumOfArguments)+1 {
 			callArgs = vmInput.Arguments[minNumOfArguments+1:]
@@ -246,6 +255,7 @@ func (e *mectNFTMultiTransfer) ProcessBuiltinFunction(
 			vmInput.CallType,
 			vmOutput)
 	}
+	addTransferFeeOutputTransfers(transferFees, vmOutput)
 
 	return vmOutput, nil
 }
@@ -261,7 +271,7 @@ func (e *mectNFTMultiTransfer) processMECTNFTMultiTransferOnSenderShard(
 	if bytes.Equal(dstAddress, vmInput.CallerAddr) {
 		return nil, fmt.Errorf("%w, can not transfer to self", ErrInvalidArguments)
 	}
-	isInvalidTransferToMeta := e.shardCoordinator.ComputeId(dstAddress) == core.MetachainShardId && !e.flagTransferToMeta.IsSet()
+	isInvalidTransferToMeta := e.shardCoordinator.ComputeId(dstAddress) == core.MetachainShardId && !e.enableEpochsHandler.IsTransferToMetaFlagEnabled()
 	if isInvalidTransferToMeta {
 		return nil, ErrInvalidRcvAddr
 	}
@@ -274,11 +284,36 @@ func (e *mectNFTMultiTransfer) processMECTNFTMultiTransferOnSenderShard(
 		return nil, fmt.Errorf("%w, invalid number of arguments", ErrInvalidArguments)
 	}
 
-	multiTransferCost := numOfTransfers * e.funcGasCost
+	startIndex := uint64(2)
+	listTransferData := make([]*vmcommon.MECTTransfer, numOfTransfers)
+	for i := uint64(0); i < numOfTransfers; i++ {
+		tokenStartIndex := startIndex + i*argumentsPerTransfer
+		listTransferData[i] = &vmcommon.MECTTransfer{
+			MECTValue:      big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+2]),
+			MECTTokenName:  vmInput.Arguments[tokenStartIndex],
+			MECTTokenType:  0,
+			MECTTokenNonce: big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+1]).Uint64(),
+		}
+		if listTransferData[i].MECTTokenNonce > 0 {
+			listTransferData[i].MECTTokenType = uint32(core.NonFungible)
+		}
+	}
+
+	royaltyPayments, err := e.computeRoyaltyPayments(acntSnd, listTransferData, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return nil, err
+	}
+
+	multiTransferCost := e.computeMultiTransferCost(numOfTransfers + uint64(len(royaltyPayments)))
 	if vmInput.GasProvided < multiTransferCost {
 		return nil, ErrNotEnoughGas
 	}
 
+	sentTransferData, err := deductRoyaltiesFromPayments(listTransferData, royaltyPayments)
+	if err != nil {
+		return nil, err
+	}
+
 	acntDst, err := e.loadAccountIfInShard(dstAddress)
 	if err != nil {
 		return nil, err
@@ -297,35 +332,43 @@ func (e *mectNFTMultiTransfer) processMECTNFTMultiTransferOnSenderShard(
 		Logs:         make([]*vmcommon.LogEntry, 0, numOfTransfers),
 	}
 
-	startIndex := uint64(2)
 	listMectData := make([]*mect.MECToken, numOfTransfers)
-	listTransferData := make([]*vmcommon.MECTTransfer, numOfTransfers)
-
+	receivedValues := make([]*big.Int, numOfTransfers)
+	transferFees := make([]*transferFeePayment, 0)
 	for i := uint64(0); i < numOfTransfers; i++ {
-		tokenStartIndex := startIndex + i*argumentsPerTransfer
-		listTransferData[i] = &vmcommon.MECTTransfer{
-			MECTValue:      big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+2]),
-			MECTTokenName:  vmInput.Arguments[tokenStartIndex],
-			MECTTokenType:  0,
-			MECTTokenNonce: big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+1]).Uint64(),
-		}
-		if listTransferData[i].MECTTokenNonce > 0 {
-			listTransferData[i].MECTTokenType = uint32(core.NonFungible)
+		var transferFee *transferFeePayment
+		if listTransferData[i].MECTTokenNonce == 0 {
+			transferFee = e.transferFeeProcessor.computeTransferFee(acntDst, vmInput.CallerAddr, listTransferData[i].MECTTokenName, sentTransferData[i].MECTValue, vmInput.ReturnCallAfterError)
 		}
+		receivedValues[i] = computeReceivedValue(sentTransferData[i].MECTValue, transferFee)
 
 		listMectData[i], err = e.transferOneTokenOnSenderShard(
 			acntSnd,
 			acntDst,
 			dstAddress,
 			listTransferData[i],
+			sentTransferData[i].MECTValue,
+			receivedValues[i],
 			vmInput.ReturnCallAfterError)
 		if err != nil {
 			return nil, fmt.Errorf("%w for token %s", err, string(listTransferData[i].MECTTokenName))
 		}
 
+		var crossShardFee *transferFeePayment
+		crossShardFee, err = e.transferFeeProcessor.payTransferFee(transferFee, vmInput.ReturnCallAfterError, vmOutput)
+		if err != nil {
+			return nil, fmt.Errorf("%w for token %s", err, string(listTransferData[i].MECTTokenName))
+		}
+		transferFees = append(transferFees, crossShardFee)
+
 		addMECTEntryInVMOutput(vmOutput, []byte(core.BuiltInFunctionMultiMECTNFTTransfer), listTransferData[i].MECTTokenName, listTransferData[i].MECTTokenNonce, listTransferData[i].MECTValue, vmInput.CallerAddr, dstAddress)
 	}
 
+	crossShardRoyalties, err := e.payRoyalties(acntSnd, acntDst, dstAddress, royaltyPayments, vmInput, vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
 	if !check.IfNil(acntDst) {
 		err = e.accounts.SaveAccount(acntDst)
 		if err != nil {
@@ -333,10 +376,20 @@ func (e *mectNFTMultiTransfer) processMECTNFTMultiTransferOnSenderShard(
 		}
 	}
 
-	err = e.createMECTNFTOutputTransfers(vmInput, vmOutput, listMectData, listTransferData, dstAddress)
+	if !check.IfNil(acntDst) && e.payableHandler.DetermineIsSCCallAfter(vmInput, dstAddress, int(minNumOfArguments)) {
+		for i := uint64(0); i < numOfTransfers; i++ {
+			if receivedValues[i].Cmp(listTransferData[i].MECTValue) != 0 {
+				replaceTransferredValue(vmInput, int(startIndex+i*argumentsPerTransfer+2), receivedValues[i])
+			}
+		}
+	}
+
+	err = e.createMECTNFTOutputTransfers(vmInput, vmOutput, listMectData, sentTransferData, dstAddress)
 	if err != nil {
 		return nil, err
 	}
+	addRoyaltiesOutputTransfers(crossShardRoyalties, vmInput, vmOutput)
+	addTransferFeeOutputTransfers(transferFees, vmOutput)
 
 	return vmOutput, nil
 }
@@ -346,6 +399,8 @@ func (e *mectNFTMultiTransfer) transferOneTokenOnSenderShard(
 	acntDst vmcommon.UserAccountHandler,
 	dstAddress []byte,
 	transferData *vmcommon.MECTTransfer,
+	sentValue *big.Int,
+	receivedValue *big.Int,
 	isReturnCallWithError bool,
 ) (*mect.MECToken, error) {
 	if transferData.MECTValue.Cmp(zero) <= 0 {
@@ -358,20 +413,20 @@ func (e *mectNFTMultiTransfer) transferOneTokenOnSenderShard(
 		return nil, err
 	}
 
-	if mectData.Value.Cmp(transferData.MECTValue) < 0 {
+	if mectData.Value.Cmp(sentValue) < 0 {
 		return nil, computeInsufficientQuantityMECTError(transferData.MECTTokenName, transferData.MECTTokenNonce)
 	}
-	mectData.Value.Sub(mectData.Value, transferData.MECTValue)
+	mectData.Value.Sub(mectData.Value, sentValue)
 
 	_, err = e.mectStorageHandler.SaveMECTNFTToken(acntSnd.AddressBytes(), acntSnd, mectTokenKey, transferData.MECTTokenNonce, mectData, false, isReturnCallWithError)
 	if err != nil {
 		return nil, err
 	}
 
-	mectData.Value.Set(transferData.MECTValue)
+	mectData.Value.Set(receivedValue)
 
 	tokenID := mectTokenKey
-	if e.flagCheckCorrectTokenID.IsSet() {
+	if e.enableEpochsHandler.IsCheckCorrectTokenIDEnabled() {
 		tokenID = transferData.MECTTokenName
 	}
 
@@ -379,6 +434,10 @@ func (e *mectNFTMultiTransfer) transferOneTokenOnSenderShard(
 	if err != nil {
 		return nil, err
 	}
+	err = checkIfTransferCanHappenWithSoulbound(mectTokenKey, e.globalSettingsHandler, acntSnd, isReturnCallWithError)
+	if err != nil {
+		return nil, err
+	}
 
 	if !check.IfNil(acntDst) {
 		err = e.addNFTToDestination(acntSnd.AddressBytes(), dstAddress, acntDst, mectData, mectTokenKey, transferData.MECTTokenNonce, isReturnCallWithError)
@@ -386,7 +445,7 @@ func (e *mectNFTMultiTransfer) transferOneTokenOnSenderShard(
 			return nil, err
 		}
 	} else {
-		err = e.mectStorageHandler.AddToLiquiditySystemAcc(mectTokenKey, transferData.MECTTokenNonce, big.NewInt(0).Neg(transferData.MECTValue))
+		err = e.mectStorageHandler.AddToLiquiditySystemAcc(mectTokenKey, transferData.MECTTokenNonce, big.NewInt(0).Neg(sentValue))
 		if err != nil {
 			return nil, err
 		}
@@ -454,7 +513,7 @@ func (e *mectNFTMultiTransfer) createMECTNFTOutputTransfers(
 					return err
 				}
 
-				gasForTransfer := uint64(len(marshaledNFTTransfer)) * e.gasConfig.DataCopyPerByte
+				gasForTransfer := computeGasForDataCopy(marshaledNFTTransfer, e.gasConfig)
 				if gasForTransfer > vmOutput.GasRemaining {
 					return ErrNotEnoughGas
 				}
@@ -552,6 +611,100 @@ func (e *mectNFTMultiTransfer) addNFTToDestination(
 	return nil
 }
 
+// EstimateGas returns the gas consumed on the sender shard by the multi MECT NFT transfer, including the cost of
+// sending the tokens metadata to the destination. On the destination shard no gas is consumed as the sender already
+// paid for it.
+func (e *mectNFTMultiTransfer) EstimateGas(acntSnd, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkBasicMECTArguments(vmInput)
+	if err != nil {
+		return 0, err
+	}
+	if len(vmInput.Arguments) < 4 {
+		return 0, ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) || check.IfNil(acntSnd) {
+		return 0, nil
+	}
+
+	dstAddress := vmInput.Arguments[0]
+	numOfTransfers := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if numOfTransfers == 0 {
+		return 0, fmt.Errorf("%w, 0 tokens to transfer", ErrInvalidArguments)
+	}
+	minNumOfArguments := numOfTransfers*argumentsPerTransfer + 2
+	if uint64(len(vmInput.Arguments)) < minNumOfArguments {
+		return 0, fmt.Errorf("%w, invalid number of arguments", ErrInvalidArguments)
+	}
+
+	gasToUse := uint64(0)
+	startIndex := uint64(2)
+	listTransferData := make([]*vmcommon.MECTTransfer, 0, numOfTransfers)
+	for i := uint64(0); i < numOfTransfers; i++ {
+		tokenStartIndex := startIndex + i*argumentsPerTransfer
+		tokenID := vmInput.Arguments[tokenStartIndex]
+		nonce := big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+1]).Uint64()
+		value := big.NewInt(0).SetBytes(vmInput.Arguments[tokenStartIndex+2])
+		listTransferData = append(listTransferData, &vmcommon.MECTTransfer{MECTValue: value, MECTTokenName: tokenID, MECTTokenNonce: nonce})
+		if nonce == 0 {
+			continue
+		}
+
+		gasForTransfer, errEstimate := e.estimateGasForMetadataTransfer(acntSnd, tokenID, nonce, value, dstAddress)
+		if errEstimate != nil {
+			return 0, fmt.Errorf("%w for token %s", errEstimate, string(tokenID))
+		}
+		gasToUse += gasForTransfer
+	}
+
+	royaltyPayments, err := e.computeRoyaltyPayments(acntSnd, listTransferData, vmInput.ReturnCallAfterError)
+	if err != nil {
+		return 0, err
+	}
+	gasToUse += e.computeMultiTransferCost(numOfTransfers + uint64(len(royaltyPayments)))
+
+	return gasToUse, nil
+}
+
+func (e *mectNFTMultiTransfer) estimateGasForMetadataTransfer(
+	acntSnd vmcommon.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+	value *big.Int,
+	dstAddress []byte,
+) (uint64, error) {
+	wasAlreadySent, err := wasMetadataAlreadySent(e.mectStorageHandler, tokenID, nonce, dstAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	sendCrossShardAsMarshalledData := !wasAlreadySent || value.Cmp(oneValue) == 0 ||
+		len(value.Bytes()) > vmcommon.MaxLengthForValueToOptTransfer
+	if !sendCrossShardAsMarshalledData {
+		return 0, nil
+	}
+
+	mectTokenKey := append(e.keyPrefix, tokenID...)
+	mectData, err := e.mectStorageHandler.GetMECTNFTTokenOnSender(acntSnd, mectTokenKey, nonce)
+	if err != nil {
+		return 0, err
+	}
+	mectData.Value = value
+
+	marshaledNFTTransfer, err := e.marshaller.Marshal(mectData)
+	if err != nil {
+		return 0, err
+	}
+
+	return computeGasForDataCopy(marshaledNFTTransfer, e.gasConfig), nil
+}
+
+func (e *mectNFTMultiTransfer) computeMultiTransferCost(numOfTransfers uint64) uint64 {
+	return numOfTransfers * e.funcGasCost
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectNFTMultiTransfer) IsInterfaceNil() bool {
 	return e == nil
@@ -25,11 +25,11 @@ func createMECTNFTMultiTransferWithStubArguments() *mectNFTMultiTransfer {
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
 		createNewMECTDataStorageHandler(),
 	)
 
@@ -78,8 +78,10 @@ func createMECTNFTMultiTransferWithMockArguments(selfShard uint32, numShards uin
 		accounts,
 		shardCoordinator,
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		&mock.MECTRoleHandlerStub{
 			CheckAllowedToExecuteCalled: func(account vmcommon.UserAccountHandler, tokenID []byte, action []byte) error {
 				if bytes.Equal(action, []byte(core.MECTRoleTransfer)) {
@@ -88,8 +90,6 @@ func createMECTNFTMultiTransferWithMockArguments(selfShard uint32, numShards uin
 				return nil
 			},
 		},
-		1000,
-		0,
 		createNewMECTDataStorageHandlerWithArgs(globalSettingsHandler, accounts),
 	)
 
@@ -106,11 +106,11 @@ func TestNewMECTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
 		createNewMECTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(multiTransfer))
@@ -123,11 +123,11 @@ func TestNewMECTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
 		createNewMECTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(multiTransfer))
@@ -140,11 +140,11 @@ func TestNewMECTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		nil,
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
 		createNewMECTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(multiTransfer))
@@ -157,11 +157,11 @@ func TestNewMECTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		nil,
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
 		createNewMECTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(multiTransfer))
@@ -174,15 +174,13 @@ func TestNewMECTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
-		0,
+		trueHandler,
 		nil,
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
 		createNewMECTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(multiTransfer))
-	assert.Equal(t, ErrNilEpochHandler, err)
+	assert.Equal(t, ErrNilEnableEpochsHandler, err)
 
 	multiTransfer, err = NewMECTNFTMultiTransferFunc(
 		0,
@@ -191,11 +189,11 @@ func TestNewMECTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		nil,
-		1000,
-		0,
 		createNewMECTDataStorageHandler(),
 	)
 	assert.True(t, check.IfNil(multiTransfer))
@@ -208,11 +206,11 @@ func TestNewMECTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
 		nil,
 	)
 	assert.True(t, check.IfNil(multiTransfer))
@@ -229,11 +227,11 @@ func TestNewMECTNFTMultiTransferFunc(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.ShardCoordinatorStub{},
 		vmcommon.BaseOperationCost{},
-		0,
-		&mock.EpochNotifierStub{},
+		trueHandler,
+		&mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+		},
 		&mock.MECTRoleHandlerStub{},
-		1000,
-		0,
 		createNewMECTDataStorageHandler(),
 	)
 	assert.False(t, check.IfNil(multiTransfer))
@@ -314,7 +312,12 @@ func TestMECTNFTMultiTransfer_ProcessBuiltinFunctionOnSameShardWithScCall(t *tes
 			IsPayableCalled: func(address []byte) (bool, error) {
 				return true, nil
 			},
-		}, 0, 0, &mock.EpochNotifierStub{})
+		},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckFunctionArgumentFlagEnabledField: true,
+			IsFixAsyncCallbackCheckFlagEnabledField: true,
+		},
+	)
 
 	_ = multiTransfer.SetPayableChecker(payableChecker)
 	senderAddress := bytes.Repeat([]byte{2}, 32)
@@ -769,7 +772,12 @@ func TestMECTNFTMultiTransfer_ProcessBuiltinFunctionOnCrossShardsShouldErr(t *te
 			IsPayableCalled: func(address []byte) (bool, error) {
 				return true, nil
 			},
-		}, 0, 0, &mock.EpochNotifierStub{})
+		},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckFunctionArgumentFlagEnabledField: true,
+			IsFixAsyncCallbackCheckFlagEnabledField: true,
+		},
+	)
 
 	multiTransferSenderShard := createMECTNFTMultiTransferWithMockArguments(0, 2, &mock.GlobalSettingsHandlerStub{})
 	_ = multiTransferSenderShard.SetPayableChecker(payableChecker)
@@ -847,7 +855,12 @@ func TestMECTNFTMultiTransfer_ProcessBuiltinFunctionOnCrossShardsShouldErr(t *te
 			IsPayableCalled: func(address []byte) (bool, error) {
 				return false, nil
 			},
-		}, 0, 0, &mock.EpochNotifierStub{})
+		},
+		&mock.EnableEpochsHandlerStub{
+			IsCheckFunctionArgumentFlagEnabledField: true,
+			IsFixAsyncCallbackCheckFlagEnabledField: true,
+		},
+	)
 
 	_ = multiTransferDestinationShard.SetPayableChecker(payableChecker)
 	vmOutput, err = multiTransferDestinationShard.ProcessBuiltinFunction(nil, destination.(vmcommon.UserAccountHandler), vmInput)
@@ -929,6 +942,58 @@ func TestMECTNFTMultiTransfer_SndDstFrozen(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMECTNFTMultiTransfer_WithSoulbound(t *testing.T) {
+	t.Parallel()
+
+	globalSettings := &mock.GlobalSettingsHandlerStub{}
+	transferFunc := createMECTNFTMultiTransferWithMockArguments(0, 1, globalSettings)
+	_ = transferFunc.SetPayableChecker(&mock.PayableHandlerStub{})
+
+	senderAddress := bytes.Repeat([]byte{2}, 32) // sender is in the same shard
+	destinationAddress := bytes.Repeat([]byte{1}, 32)
+	destinationAddress[31] = 0
+	sender, err := transferFunc.accounts.LoadAccount(senderAddress)
+	require.Nil(t, err)
+
+	token1 := []byte("token1")
+	token2 := []byte("token2")
+	tokenNonce := uint64(1)
+
+	initialTokens := big.NewInt(3)
+	createMECTNFTToken(token1, core.NonFungible, tokenNonce, initialTokens, transferFunc.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTToken(token2, core.Fungible, 0, initialTokens, transferFunc.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	_ = transferFunc.accounts.SaveAccount(sender)
+	_, _ = transferFunc.accounts.Commit()
+	// reload sender account
+	sender, err = transferFunc.accounts.LoadAccount(senderAddress)
+	require.Nil(t, err)
+
+	nonceBytes := big.NewInt(int64(tokenNonce)).Bytes()
+	quantityBytes := big.NewInt(1).Bytes()
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			Arguments:   [][]byte{destinationAddress, big.NewInt(2).Bytes(), token2, big.NewInt(0).Bytes(), quantityBytes, token1, nonceBytes, quantityBytes},
+			GasProvided: 100000,
+		},
+		RecipientAddr: senderAddress,
+	}
+
+	destination, _ := transferFunc.accounts.LoadAccount(destinationAddress)
+	globalSettings.IsSoulboundCalled = func(token []byte) bool {
+		return bytes.Equal(token, append(keyPrefix, token1...))
+	}
+	_, err = transferFunc.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), destination.(vmcommon.UserAccountHandler), vmInput)
+	assert.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("%s for token %s", ErrMECTTokenIsSoulbound, string(token1)), err.Error())
+
+	vmInput.ReturnCallAfterError = true
+	_, err = transferFunc.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), destination.(vmcommon.UserAccountHandler), vmInput)
+	assert.Nil(t, err)
+}
+
 func TestMECTNFTMultiTransfer_NotEnoughGas(t *testing.T) {
 	t.Parallel()
 
@@ -1022,47 +1087,435 @@ func TestComputeInsufficientQuantityMECTError(t *testing.T) {
 	require.Equal(t, errors.New("insufficient quantity for token: my-token-2 nonce 5").Error(), resErr.Error())
 }
 
-func TestMECTNFTMultiTransfer_EpochChange(t *testing.T) {
+func TestMECTNFTMultiTransfer_EstimateGasShouldMatchConsumedGas(t *testing.T) {
 	t.Parallel()
 
-	var functionHandler vmcommon.EpochSubscriberHandler
-	notifier := &mock.EpochNotifierStub{
-		RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
-			functionHandler = handler
+	multiTransfer := createMECTNFTMultiTransferWithMockArguments(0, 2, &mock.GlobalSettingsHandlerStub{})
+	gasCost := createMockGasCost()
+	multiTransfer.SetNewGasConfig(&gasCost)
+	_ = multiTransfer.SetPayableChecker(&mock.PayableHandlerStub{})
+
+	senderAddress := bytes.Repeat([]byte{2}, 32)
+	destinationAddress := bytes.Repeat([]byte{1}, 32)
+	sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+
+	token1 := []byte("token1")
+	token2 := []byte("token2")
+	tokenNonce := uint64(1)
+	createMECTNFTToken(token1, core.NonFungible, tokenNonce, big.NewInt(3), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTToken(token2, core.Fungible, 0, big.NewInt(3), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	nonceBytes := big.NewInt(int64(tokenNonce)).Bytes()
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: senderAddress,
+			Arguments: [][]byte{
+				destinationAddress, big.NewInt(2).Bytes(),
+				token1, nonceBytes, big.NewInt(1).Bytes(),
+				token2, big.NewInt(0).Bytes(), big.NewInt(2).Bytes(),
+			},
+			GasProvided: 100000,
 		},
+		RecipientAddr: senderAddress,
 	}
-	transferFunc, _ := NewMECTNFTMultiTransferFunc(
-		0,
-		&mock.MarshalizerMock{},
-		&mock.GlobalSettingsHandlerStub{},
-		&mock.AccountsStub{},
-		&mock.ShardCoordinatorStub{},
-		vmcommon.BaseOperationCost{},
-		0,
-		notifier,
-		&mock.MECTRoleHandlerStub{},
-		1,
-		2,
-		createNewMECTDataStorageHandler(),
+
+	estimatedGas, err := multiTransfer.EstimateGas(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	assert.True(t, estimatedGas > 2*gasCost.BuiltInCost.MECTNFTMultiTransfer)
+
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmInput.GasProvided-vmOutput.GasRemaining, estimatedGas)
+
+	vmInput.RecipientAddr = destinationAddress
+	estimatedGas, err = multiTransfer.EstimateGas(nil, nil, vmInput)
+	require.Nil(t, err)
+	assert.Zero(t, estimatedGas)
+}
+
+func createMECTNFTTokenWithRoyalties(
+	tokenName []byte,
+	nonce uint64,
+	royalties uint32,
+	creator []byte,
+	marshaller vmcommon.Marshalizer,
+	account vmcommon.UserAccountHandler,
+) {
+	mectNFTTokenKey := computeMECTNFTTokenKey(append(keyPrefix, tokenName...), nonce)
+	mectData := &mect.MECToken{
+		Type:  uint32(core.NonFungible),
+		Value: big.NewInt(1),
+		TokenMetaData: &mect.MetaData{
+			Nonce:     nonce,
+			Creator:   creator,
+			Royalties: royalties,
+		},
+	}
+	buff, _ := marshaller.Marshal(mectData)
+	_ = account.AccountDataHandler().SaveKeyValue(mectNFTTokenKey, buff)
+}
+
+func createMultiTransferWithRoyalties(royaltiesReceiver []byte) *mectNFTMultiTransfer {
+	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{
+		IsRoyaltiesEnforcedCalled: func(token []byte) bool {
+			return bytes.Equal(token, append(keyPrefix, []byte("NFT-abcdef")...))
+		},
+		GetRoyaltiesReceiverCalled: func(tokenID []byte) []byte {
+			return royaltiesReceiver
+		},
+	}
+	multiTransfer := createMECTNFTMultiTransferWithMockArguments(0, 2, globalSettingsHandler)
+	multiTransfer.enableEpochsHandler = &mock.EnableEpochsHandlerStub{
+		IsCheckCorrectTokenIDEnabledField: true,
+		IsMECTRoyaltiesFlagEnabledField:   true,
+	}
+	_ = multiTransfer.SetPayableChecker(&mock.PayableHandlerStub{})
+	gasCost := createMockGasCost()
+	multiTransfer.SetNewGasConfig(&gasCost)
+
+	return multiTransfer
+}
+
+func createRoyaltiesMultiTransferInput(senderAddress []byte, destinationAddress []byte, payment int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: senderAddress,
+			Arguments: [][]byte{
+				destinationAddress, big.NewInt(2).Bytes(),
+				[]byte("NFT-abcdef"), big.NewInt(1).Bytes(), big.NewInt(1).Bytes(),
+				[]byte("PAY-abcdef"), big.NewInt(0).Bytes(), big.NewInt(payment).Bytes(),
+			},
+			GasProvided: 100000,
+		},
+		RecipientAddr: senderAddress,
+	}
+}
+
+func TestMECTNFTMultiTransfer_RoyaltiesSameShard(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer := createMultiTransferWithRoyalties(nil)
+	senderAddress := append(bytes.Repeat([]byte{2}, 31), 0)
+	destinationAddress := append(bytes.Repeat([]byte{3}, 31), 0)
+	creatorAddress := append(bytes.Repeat([]byte{4}, 31), 0)
+	sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+	createMECTNFTTokenWithRoyalties([]byte("NFT-abcdef"), 1, 1000, creatorAddress, multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	vmInput := createRoyaltiesMultiTransferInput(senderAddress, destinationAddress, 200)
+	estimatedGas, err := multiTransfer.EstimateGas(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmInput.GasProvided-vmOutput.GasRemaining, estimatedGas)
+
+	destination, _ := multiTransfer.accounts.LoadAccount(destinationAddress)
+	creator, _ := multiTransfer.accounts.LoadAccount(creatorAddress)
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, sender, []byte("PAY-abcdef"), 0, big.NewInt(800))
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("PAY-abcdef"), 0, big.NewInt(180))
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, creator, []byte("PAY-abcdef"), 0, big.NewInt(20))
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("NFT-abcdef"), 1, big.NewInt(1))
+
+	require.Equal(t, 3, len(vmOutput.Logs))
+	royaltiesLog := vmOutput.Logs[2]
+	assert.Equal(t, []byte(vmcommon.MECTRoyaltiesIdentifier), royaltiesLog.Identifier)
+	assert.Equal(t, senderAddress, royaltiesLog.Address)
+	assert.Equal(t, [][]byte{[]byte("PAY-abcdef"), {}, big.NewInt(20).Bytes(), creatorAddress, []byte("NFT-abcdef"), big.NewInt(1).Bytes()}, royaltiesLog.Topics)
+	assert.Equal(t, big.NewInt(200).Bytes(), vmOutput.Logs[1].Topics[2])
+}
+
+func TestMECTNFTMultiTransfer_RoyaltiesConsumingTheWholePaymentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer := createMultiTransferWithRoyalties(nil)
+	senderAddress := append(bytes.Repeat([]byte{2}, 31), 0)
+	destinationAddress := append(bytes.Repeat([]byte{3}, 31), 0)
+	creatorAddress := append(bytes.Repeat([]byte{4}, 31), 0)
+	sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+	createMECTNFTTokenWithRoyalties([]byte("NFT-abcdef"), 1, core.MaxRoyalty, creatorAddress, multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, createRoyaltiesMultiTransferInput(senderAddress, destinationAddress, 200))
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrRoyaltiesExceedPayment, err)
+
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, sender, []byte("PAY-abcdef"), 0, big.NewInt(1000))
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, sender, []byte("NFT-abcdef"), 1, big.NewInt(1))
+}
+
+func TestMECTNFTMultiTransfer_ReturnCallAfterErrorShouldNotPayRoyalties(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer := createMultiTransferWithRoyalties(nil)
+	senderAddress := append(bytes.Repeat([]byte{2}, 31), 0)
+	destinationAddress := append(bytes.Repeat([]byte{3}, 31), 0)
+	creatorAddress := append(bytes.Repeat([]byte{4}, 31), 0)
+	sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+	createMECTNFTTokenWithRoyalties([]byte("NFT-abcdef"), 1, 1000, creatorAddress, multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	vmInput := createRoyaltiesMultiTransferInput(senderAddress, destinationAddress, 200)
+	vmInput.ReturnCallAfterError = true
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(vmOutput.Logs))
+
+	destination, _ := multiTransfer.accounts.LoadAccount(destinationAddress)
+	creator, _ := multiTransfer.accounts.LoadAccount(creatorAddress)
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("PAY-abcdef"), 0, big.NewInt(200))
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, creator, []byte("PAY-abcdef"), 0, big.NewInt(0))
+}
+
+func TestDeductRoyaltiesFromPayments(t *testing.T) {
+	t.Parallel()
+
+	listTransferData := []*vmcommon.MECTTransfer{
+		{MECTTokenName: []byte("NFT-abcdef"), MECTTokenNonce: 1, MECTValue: big.NewInt(1)},
+		{MECTTokenName: []byte("PAY-abcdef"), MECTValue: big.NewInt(100)},
+	}
+	payments := []*royaltyPayment{{paymentIndex: 1, value: big.NewInt(30)}}
+
+	sentTransferData, err := deductRoyaltiesFromPayments(listTransferData, payments)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), sentTransferData[0].MECTValue)
+	assert.Equal(t, big.NewInt(70), sentTransferData[1].MECTValue)
+	assert.Equal(t, big.NewInt(100), listTransferData[1].MECTValue)
+
+	payments = append(payments, &royaltyPayment{paymentIndex: 1, value: big.NewInt(70)})
+	sentTransferData, err = deductRoyaltiesFromPayments(listTransferData, payments)
+	assert.Nil(t, sentTransferData)
+	assert.Equal(t, ErrRoyaltiesExceedPayment, err)
+	assert.Equal(t, big.NewInt(100), listTransferData[1].MECTValue)
+
+	payments[1].value = big.NewInt(71)
+	sentTransferData, err = deductRoyaltiesFromPayments(listTransferData, payments)
+	assert.Nil(t, sentTransferData)
+	assert.Equal(t, ErrRoyaltiesExceedPayment, err)
+}
+
+func TestMECTNFTMultiTransfer_RoyaltiesToConfiguredReceiverCrossShard(t *testing.T) {
+	t.Parallel()
+
+	receiverAddress := append(bytes.Repeat([]byte{5}, 31), 1)
+	multiTransfer := createMultiTransferWithRoyalties(receiverAddress)
+	senderAddress := append(bytes.Repeat([]byte{2}, 31), 0)
+	destinationAddress := append(bytes.Repeat([]byte{3}, 31), 0)
+	creatorAddress := append(bytes.Repeat([]byte{4}, 31), 0)
+	sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+	createMECTNFTTokenWithRoyalties([]byte("NFT-abcdef"), 1, 2500, creatorAddress, multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	vmInput := createRoyaltiesMultiTransferInput(senderAddress, destinationAddress, 100)
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
+
+	destination, _ := multiTransfer.accounts.LoadAccount(destinationAddress)
+	creator, _ := multiTransfer.accounts.LoadAccount(creatorAddress)
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, sender, []byte("PAY-abcdef"), 0, big.NewInt(900))
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("PAY-abcdef"), 0, big.NewInt(75))
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, creator, []byte("PAY-abcdef"), 0, big.NewInt(0))
+
+	function, args := extractScResultsFromVmOutput(t, vmOutput)
+	assert.Equal(t, core.BuiltInFunctionMECTTransfer, function)
+	assert.Equal(t, [][]byte{[]byte("PAY-abcdef"), big.NewInt(25).Bytes()}, args)
+	assert.Equal(t, receiverAddress, vmOutput.OutputAccounts[string(receiverAddress)].Address)
+	assert.Equal(t, big.NewInt(0), vmOutput.OutputAccounts[string(receiverAddress)].BalanceDelta)
+	assert.Equal(t, big.NewInt(0), vmOutput.OutputAccounts[string(receiverAddress)].Balance)
+}
+
+func TestMECTNFTMultiTransfer_RoyaltiesShouldBeComputedForEveryNFT(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer := createMultiTransferWithRoyalties(nil)
+	senderAddress := append(bytes.Repeat([]byte{2}, 31), 0)
+	destinationAddress := append(bytes.Repeat([]byte{3}, 31), 0)
+	creatorAddress := append(bytes.Repeat([]byte{4}, 31), 0)
+	sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+	createMECTNFTTokenWithRoyalties([]byte("NFT-abcdef"), 1, 1000, creatorAddress, multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTTokenWithRoyalties([]byte("NFT-abcdef"), 2, 1000, creatorAddress, multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTToken([]byte("OTHER-abcdef"), core.NonFungible, 1, big.NewInt(1), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+	createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+	vmInput := createRoyaltiesMultiTransferInput(senderAddress, destinationAddress, 200)
+	vmInput.Arguments[1] = big.NewInt(4).Bytes()
+	vmInput.Arguments = append(vmInput.Arguments,
+		[]byte("NFT-abcdef"), big.NewInt(2).Bytes(), big.NewInt(1).Bytes(),
+		[]byte("OTHER-abcdef"), big.NewInt(1).Bytes(), big.NewInt(1).Bytes(),
 	)
+	_, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+	require.Nil(t, err)
 
-	functionHandler.EpochConfirmed(0, 0)
-	assert.False(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.False(t, transferFunc.flagCheckCorrectTokenID.IsSet())
+	destination, _ := multiTransfer.accounts.LoadAccount(destinationAddress)
+	creator, _ := multiTransfer.accounts.LoadAccount(creatorAddress)
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, creator, []byte("PAY-abcdef"), 0, big.NewInt(40))
+	testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("PAY-abcdef"), 0, big.NewInt(160))
+}
 
-	functionHandler.EpochConfirmed(1, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.False(t, transferFunc.flagCheckCorrectTokenID.IsSet())
+func TestMECTNFTMultiTransfer_RoyaltiesErrors(t *testing.T) {
+	t.Parallel()
 
-	functionHandler.EpochConfirmed(2, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
+	senderAddress := append(bytes.Repeat([]byte{2}, 31), 0)
+	destinationAddress := append(bytes.Repeat([]byte{3}, 31), 0)
+	creatorAddress := append(bytes.Repeat([]byte{4}, 31), 0)
+
+	t.Run("not enough gas for the royalties should error", func(t *testing.T) {
+		multiTransfer := createMultiTransferWithRoyalties(nil)
+		sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+		createMECTNFTTokenWithRoyalties([]byte("NFT-abcdef"), 1, 1000, creatorAddress, multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+		createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+		vmInput := createRoyaltiesMultiTransferInput(senderAddress, destinationAddress, 200)
+		vmInput.GasProvided = 2 * multiTransfer.funcGasCost
+		_, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+		assert.Equal(t, ErrNotEnoughGas, err)
+	})
+	t.Run("flag disabled should not pay royalties", func(t *testing.T) {
+		multiTransfer := createMultiTransferWithRoyalties(nil)
+		multiTransfer.enableEpochsHandler = &mock.EnableEpochsHandlerStub{IsCheckCorrectTokenIDEnabledField: true}
+		sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+		createMECTNFTTokenWithRoyalties([]byte("NFT-abcdef"), 1, 1000, creatorAddress, multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+		createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+		vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, createRoyaltiesMultiTransferInput(senderAddress, destinationAddress, 200))
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(vmOutput.Logs))
+
+		destination, _ := multiTransfer.accounts.LoadAccount(destinationAddress)
+		testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("PAY-abcdef"), 0, big.NewInt(200))
+	})
+}
 
-	functionHandler.EpochConfirmed(3, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
+func TestMECTNFTMultiTransfer_WithTransferFee(t *testing.T) {
+	t.Parallel()
 
-	functionHandler.EpochConfirmed(4, 0)
-	assert.True(t, transferFunc.flagTransferToMeta.IsSet())
-	assert.True(t, transferFunc.flagCheckCorrectTokenID.IsSet())
+	createMultiTransferWithTransferFee := func(feeReceiver []byte) *mectNFTMultiTransfer {
+		globalSettingsHandler := &mock.GlobalSettingsHandlerStub{
+			GetTransferFeeCalled: func(tokenID []byte) (uint32, []byte) {
+				if bytes.Equal(tokenID, []byte("PAY-abcdef")) {
+					return 1000, feeReceiver
+				}
+				return 0, nil
+			},
+		}
+		multiTransfer := createMECTNFTMultiTransferWithMockArguments(0, 2, globalSettingsHandler)
+		multiTransfer.enableEpochsHandler = &mock.EnableEpochsHandlerStub{
+			IsCheckCorrectTokenIDEnabledField: true,
+			IsMECTTransferFeeFlagEnabledField: true,
+		}
+		multiTransfer.transferFeeProcessor.enableEpochsHandler = multiTransfer.enableEpochsHandler
+		_ = multiTransfer.SetPayableChecker(&mock.PayableHandlerStub{})
+		gasCost := createMockGasCost()
+		multiTransfer.SetNewGasConfig(&gasCost)
+
+		return multiTransfer
+	}
+	senderAddress := append(bytes.Repeat([]byte{2}, 31), 0)
+	destinationAddress := append(bytes.Repeat([]byte{3}, 31), 0)
+
+	t.Run("sender shard", func(t *testing.T) {
+		feeReceiverAddress := append(bytes.Repeat([]byte{5}, 31), 0)
+		multiTransfer := createMultiTransferWithTransferFee(feeReceiverAddress)
+		sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+		createMECTNFTToken([]byte("NFT-abcdef"), core.NonFungible, 1, big.NewInt(1), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+		createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+		vmInput := createRoyaltiesMultiTransferInput(senderAddress, destinationAddress, 200)
+		vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+		require.Nil(t, err)
+
+		destination, _ := multiTransfer.accounts.LoadAccount(destinationAddress)
+		feeReceiver, _ := multiTransfer.accounts.LoadAccount(feeReceiverAddress)
+		testNFTTokenShouldExist(t, multiTransfer.marshaller, sender, []byte("PAY-abcdef"), 0, big.NewInt(800))
+		testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("PAY-abcdef"), 0, big.NewInt(180))
+		testNFTTokenShouldExist(t, multiTransfer.marshaller, feeReceiver, []byte("PAY-abcdef"), 0, big.NewInt(20))
+		testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("NFT-abcdef"), 1, big.NewInt(1))
+
+		require.Equal(t, 3, len(vmOutput.Logs))
+		transferFeeLog := vmOutput.Logs[1]
+		assert.Equal(t, []byte(vmcommon.MECTTransferFeeIdentifier), transferFeeLog.Identifier)
+		assert.Equal(t, destinationAddress, transferFeeLog.Address)
+		assert.Equal(t, [][]byte{[]byte("PAY-abcdef"), {}, big.NewInt(20).Bytes(), feeReceiverAddress}, transferFeeLog.Topics)
+	})
+	t.Run("destination shard with fee receiver cross shard", func(t *testing.T) {
+		feeReceiverAddress := append(bytes.Repeat([]byte{5}, 31), 1)
+		multiTransfer := createMultiTransferWithTransferFee(feeReceiverAddress)
+		destination, _ := multiTransfer.accounts.LoadAccount(destinationAddress)
+
+		vmInput := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallValue:  big.NewInt(0),
+				CallerAddr: senderAddress,
+				Arguments: [][]byte{
+					big.NewInt(1).Bytes(),
+					[]byte("PAY-abcdef"), big.NewInt(0).Bytes(), big.NewInt(200).Bytes(),
+				},
+			},
+			RecipientAddr: destinationAddress,
+		}
+		vmOutput, err := multiTransfer.ProcessBuiltinFunction(nil, destination.(vmcommon.UserAccountHandler), vmInput)
+		require.Nil(t, err)
+
+		testNFTTokenShouldExist(t, multiTransfer.marshaller, destination, []byte("PAY-abcdef"), 0, big.NewInt(180))
+		function, args := extractScResultsFromVmOutput(t, vmOutput)
+		assert.Equal(t, core.BuiltInFunctionMECTTransfer, function)
+		assert.Equal(t, [][]byte{[]byte("PAY-abcdef"), big.NewInt(20).Bytes()}, args)
+		assert.Equal(t, destinationAddress, vmOutput.OutputAccounts[string(feeReceiverAddress)].OutputTransfers[0].SenderAddress)
+	})
+	t.Run("smart contract call after the transfer on the sender shard should see the value left after the fee", func(t *testing.T) {
+		feeReceiverAddress := append(bytes.Repeat([]byte{5}, 31), 0)
+		scAddress := append(make([]byte, 31), 0)
+		scAddress[10] = 1
+		multiTransfer := createMultiTransferWithTransferFee(feeReceiverAddress)
+		_ = multiTransfer.SetPayableChecker(&mock.PayableHandlerStub{
+			DetermineIsSCCallAfterCalled: func(vmInput *vmcommon.ContractCallInput, dstAddress []byte, mintArgs int) bool {
+				return true
+			},
+		})
+		sender, _ := multiTransfer.accounts.LoadAccount(senderAddress)
+		createMECTNFTToken([]byte("NFT-abcdef"), core.NonFungible, 1, big.NewInt(1), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+		createMECTNFTToken([]byte("PAY-abcdef"), core.Fungible, 0, big.NewInt(1000), multiTransfer.marshaller, sender.(vmcommon.UserAccountHandler))
+
+		vmInput := createRoyaltiesMultiTransferInput(senderAddress, scAddress, 200)
+		vmInput.Arguments = append(vmInput.Arguments, []byte("deposit"))
+		arguments := vmInput.Arguments
+		vmOutput, err := multiTransfer.ProcessBuiltinFunction(sender.(vmcommon.UserAccountHandler), nil, vmInput)
+		require.Nil(t, err)
+
+		sc, _ := multiTransfer.accounts.LoadAccount(scAddress)
+		testNFTTokenShouldExist(t, multiTransfer.marshaller, sc, []byte("PAY-abcdef"), 0, big.NewInt(180))
+		assert.Equal(t, big.NewInt(180).Bytes(), vmInput.Arguments[7])
+		assert.Equal(t, big.NewInt(1).Bytes(), vmInput.Arguments[4])
+		assert.Equal(t, big.NewInt(200).Bytes(), arguments[7])
+		assert.Equal(t, []byte("deposit"), vmOutput.OutputAccounts[string(scAddress)].OutputTransfers[0].Data)
+	})
+	t.Run("smart contract call after the transfer on the destination shard should see the value left after the fee", func(t *testing.T) {
+		feeReceiverAddress := append(bytes.Repeat([]byte{5}, 31), 1)
+		scAddress := append(make([]byte, 31), 0)
+		scAddress[10] = 1
+		multiTransfer := createMultiTransferWithTransferFee(feeReceiverAddress)
+		sc, _ := multiTransfer.accounts.LoadAccount(scAddress)
+
+		vmInput := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallValue:  big.NewInt(0),
+				CallerAddr: senderAddress,
+				Arguments: [][]byte{
+					big.NewInt(1).Bytes(),
+					[]byte("PAY-abcdef"), big.NewInt(0).Bytes(), big.NewInt(200).Bytes(),
+					[]byte("deposit"),
+				},
+			},
+			RecipientAddr: scAddress,
+		}
+		vmOutput, err := multiTransfer.ProcessBuiltinFunction(nil, sc.(vmcommon.UserAccountHandler), vmInput)
+		require.Nil(t, err)
+
+		testNFTTokenShouldExist(t, multiTransfer.marshaller, sc, []byte("PAY-abcdef"), 0, big.NewInt(180))
+		assert.Equal(t, big.NewInt(180).Bytes(), vmInput.Arguments[3])
+		assert.Equal(t, []byte("deposit"), vmOutput.OutputAccounts[string(scAddress)].OutputTransfers[0].Data)
+		assert.Equal(t, 1, len(vmOutput.OutputAccounts[string(feeReceiverAddress)].OutputTransfers))
+	})
 }
@@ -4,50 +4,39 @@ import (
 	"bytes"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	"github.com/ME-MotherEarth/me-core/data/vm"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
 
 type payableCheck struct {
-	checkFunctionArgumentsEnableEpoch uint32
-	flagCheckFunctionArgument         atomic.Flag
-
-	fixAsyncCallbackCheckEnableEpoch uint32
-	flagAsyncCallbackCheck           atomic.Flag
-
-	payableHandler vmcommon.PayableHandler
+	payableHandler      vmcommon.PayableHandler
+	enableEpochsHandler vmcommon.EnableEpochsHandler
 }
 
 // NewPayableCheckFunc returns a new component which checks if destination is payableCheck when needed
 func NewPayableCheckFunc(
 	payable vmcommon.PayableHandler,
-	checkFunctionArgumentsEnableEpoch uint32,
-	fixAsyncCallbackCheckEnableEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	enableEpochsHandler vmcommon.EnableEpochsHandler,
 ) (*payableCheck, error) {
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if check.IfNil(enableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
 	}
 	if check.IfNil(payable) {
 		return nil, ErrNilPayableHandler
 	}
 
 	p := &payableCheck{
-		payableHandler:                    payable,
-		checkFunctionArgumentsEnableEpoch: checkFunctionArgumentsEnableEpoch,
-		fixAsyncCallbackCheckEnableEpoch:  fixAsyncCallbackCheckEnableEpoch,
+		payableHandler:      payable,
+		enableEpochsHandler: enableEpochsHandler,
 	}
 
-	epochNotifier.RegisterNotifyHandler(p)
-
 	return p, nil
 }
 
 func (p *payableCheck) mustVerifyPayable(vmInput *vmcommon.ContractCallInput, minLenArguments int) bool {
 	typeToVerify := vm.AsynchronousCall
-	if p.flagAsyncCallbackCheck.IsSet() {
+	if p.enableEpochsHandler.IsFixAsyncCallbackCheckFlagEnabled() {
 		typeToVerify = vm.AsynchronousCallBack
 		if vmInput.ReturnCallAfterError {
 			return false
@@ -60,7 +49,7 @@ func (p *payableCheck) mustVerifyPayable(vmInput *vmcommon.ContractCallInput, mi
 		return false
 	}
 	if len(vmInput.Arguments) > minLenArguments {
-		if p.flagCheckFunctionArgument.IsSet() {
+		if p.enableEpochsHandler.IsCheckFunctionArgumentFlagEnabled() {
 			if len(vmInput.Arguments[minLenArguments]) > 0 {
 				return false
 			}
@@ -100,7 +89,7 @@ func (p *payableCheck) DetermineIsSCCallAfter(vmInput *vmcommon.ContractCallInpu
 	if !vmcommon.IsSmartContractAddress(destAddress) {
 		return false
 	}
-	if p.flagCheckFunctionArgument.IsSet() {
+	if p.enableEpochsHandler.IsCheckFunctionArgumentFlagEnabled() {
 		if len(vmInput.Arguments[minLenArguments]) == 0 {
 			return false
 		}
@@ -109,15 +98,6 @@ func (p *payableCheck) DetermineIsSCCallAfter(vmInput *vmcommon.ContractCallInpu
 	return true
 }
 
-// EpochConfirmed is called whenever a new epoch is confirmed
-func (p *payableCheck) EpochConfirmed(epoch uint32, _ uint64) {
-	p.flagCheckFunctionArgument.SetValue(epoch >= p.checkFunctionArgumentsEnableEpoch)
-	log.Debug("MECTPayable func check function argument", "enabled", p.flagCheckFunctionArgument.IsSet())
-	p.flagAsyncCallbackCheck.SetValue(epoch >= p.fixAsyncCallbackCheckEnableEpoch)
-	log.Debug("MECTPayable fix asyncCallBack check", "enabled", p.flagAsyncCallbackCheck.IsSet())
-
-}
-
 // IsInterfaceNil returns true if underlying object is nil
 func (p *payableCheck) IsInterfaceNil() bool {
 	return p == nil
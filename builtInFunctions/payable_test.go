@@ -12,25 +12,26 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func createMockPayableChecker(fix1, fix2 uint32) *payableCheck {
+func createMockPayableChecker(isCheckFunctionArgumentEnabled, isFixAsyncCallbackCheckEnabled bool) *payableCheck {
 	p, _ := NewPayableCheckFunc(
 		&mock.PayableHandlerStub{},
-		fix1,
-		fix2,
-		&mock.EpochNotifierStub{})
+		&mock.EnableEpochsHandlerStub{
+			IsCheckFunctionArgumentFlagEnabledField: isCheckFunctionArgumentEnabled,
+			IsFixAsyncCallbackCheckFlagEnabledField: isFixAsyncCallbackCheckEnabled,
+		})
 	return p
 }
 
 func TestNewPayableCheckFunc(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewPayableCheckFunc(nil, 0, 0, &mock.EpochNotifierStub{})
+	_, err := NewPayableCheckFunc(nil, &mock.EnableEpochsHandlerStub{})
 	assert.Equal(t, err, ErrNilPayableHandler)
 
-	_, err = NewPayableCheckFunc(&mock.PayableHandlerStub{}, 0, 0, nil)
-	assert.Equal(t, err, ErrNilEpochHandler)
+	_, err = NewPayableCheckFunc(&mock.PayableHandlerStub{}, nil)
+	assert.Equal(t, err, ErrNilEnableEpochsHandler)
 
-	p := createMockPayableChecker(1, 2)
+	p := createMockPayableChecker(false, false)
 	assert.False(t, p.IsInterfaceNil())
 }
 
@@ -39,8 +40,8 @@ func TestDetermineIsSCCallAfter(t *testing.T) {
 
 	scAddress, _ := hex.DecodeString("00000000000000000500e9a061848044cc9c6ac2d78dca9e4f72e72a0a5b315c")
 	address, _ := hex.DecodeString("432d6fed4f1d8ac43cd3201fd047b98e27fc9c06efb20c6593ba577cd11228ab")
-	p1 := createMockPayableChecker(0, 0)
-	p2 := createMockPayableChecker(1, 1)
+	p1 := createMockPayableChecker(true, true)
+	p2 := createMockPayableChecker(false, false)
 	minLenArguments := 4
 	t.Run("less number of arguments should return false", func(t *testing.T) {
 		vmInput := &vmcommon.ContractCallInput{
@@ -108,8 +109,8 @@ func TestMustVerifyPayable(t *testing.T) {
 	t.Parallel()
 
 	minLenArguments := 4
-	p1 := createMockPayableChecker(0, 0)
-	p2 := createMockPayableChecker(1, 1)
+	p1 := createMockPayableChecker(true, true)
+	p2 := createMockPayableChecker(false, false)
 
 	t.Run("call type is AsynchronousCall should return false", func(t *testing.T) {
 		vmInput := &vmcommon.ContractCallInput{
@@ -232,7 +233,7 @@ func TestMustVerifyPayable(t *testing.T) {
 func TestPayableCheck_CheckPayable(t *testing.T) {
 	t.Parallel()
 
-	p := createMockPayableChecker(0, 0)
+	p := createMockPayableChecker(true, true)
 	p.payableHandler = &mock.PayableHandlerStub{
 		IsPayableCalled: func(address []byte) (bool, error) {
 			return false, nil
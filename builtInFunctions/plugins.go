@@ -0,0 +1,116 @@
+package builtInFunctions
+
+import (
+	"fmt"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// PluginDependencies holds the components handed over to a plugin built-in function when it is created.
+// These are the same instances the native built-in functions use
+type PluginDependencies struct {
+	Accounts              vmcommon.AccountsAdapter
+	Marshaller            vmcommon.Marshalizer
+	ShardCoordinator      vmcommon.Coordinator
+	EnableEpochsHandler   vmcommon.EnableEpochsHandler
+	GlobalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
+	RolesHandler          vmcommon.MECTRoleHandler
+	StorageHandler        vmcommon.MECTNFTStorageHandler
+	SupplyLedger          vmcommon.MECTSupplyLedgerHandler
+	GasConfig             vmcommon.GasCost
+}
+
+// PluginCreateHandler creates a plugin built-in function
+type PluginCreateHandler func(dependencies PluginDependencies) (vmcommon.BuiltinFunction, error)
+
+// BuiltInFunctionPlugin declares a built-in function provided outside the core set
+type BuiltInFunctionPlugin struct {
+	Name   string
+	Create PluginCreateHandler
+}
+
+// RegisterPlugin adds a plugin built-in function to the creator. The plugin is created on the next
+// CreateBuiltInFunctionContainer call only if its name is in the enabled plugins allow-list
+func (b *builtInFuncCreator) RegisterPlugin(plugin BuiltInFunctionPlugin) error {
+	if len(plugin.Name) == 0 {
+		return ErrEmptyFunctionName
+	}
+	if plugin.Create == nil {
+		return fmt.Errorf("%w for plugin %s", ErrNilBuiltInFunctionCreateHandler, plugin.Name)
+	}
+	if b.getDefinition(plugin.Name) != nil {
+		return fmt.Errorf("%w: plugin %s collides with a core built-in function", ErrBuiltInFunctionNameCollision, plugin.Name)
+	}
+	for _, registeredPlugin := range b.plugins {
+		if registeredPlugin.Name == plugin.Name {
+			return fmt.Errorf("%w: plugin %s is already registered", ErrBuiltInFunctionNameCollision, plugin.Name)
+		}
+	}
+
+	b.plugins = append(b.plugins, plugin)
+
+	return nil
+}
+
+// RegisteredPlugins returns the names of the registered plugins, in registration order
+func (b *builtInFuncCreator) RegisteredPlugins() []string {
+	names := make([]string, 0, len(b.plugins))
+	for _, plugin := range b.plugins {
+		names = append(names, plugin.Name)
+	}
+
+	return names
+}
+
+func (b *builtInFuncCreator) checkEnabledPlugins() error {
+	for name := range b.enabledPlugins {
+		found := false
+		for _, plugin := range b.plugins {
+			found = found || plugin.Name == name
+		}
+
+		if !found {
+			return fmt.Errorf("%w: %s", ErrUnknownPlugin, name)
+		}
+	}
+
+	return nil
+}
+
+func (b *builtInFuncCreator) createPlugins() error {
+	err := b.checkEnabledPlugins()
+	if err != nil {
+		return err
+	}
+
+	dependencies := PluginDependencies{
+		Accounts:              b.accounts,
+		Marshaller:            b.marshaller,
+		ShardCoordinator:      b.shardCoordinator,
+		EnableEpochsHandler:   b.enableEpochsHandler,
+		GlobalSettingsHandler: b.mectGlobalSettingsHandler,
+		RolesHandler:          b.rolesHandler,
+		StorageHandler:        b.mectStorageHandler,
+		SupplyLedger:          b.supplyLedger,
+		GasConfig:             *b.gasConfig,
+	}
+
+	for _, plugin := range b.plugins {
+		_, isEnabled := b.enabledPlugins[plugin.Name]
+		if !isEnabled {
+			continue
+		}
+
+		newFunc, errCreate := plugin.Create(dependencies)
+		if errCreate != nil {
+			return fmt.Errorf("%w while creating plugin %s", errCreate, plugin.Name)
+		}
+
+		err = b.builtInFunctions.Add(plugin.Name, newFunc)
+		if err != nil {
+			return fmt.Errorf("%w for plugin %s", err, plugin.Name)
+		}
+	}
+
+	return nil
+}
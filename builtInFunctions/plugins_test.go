@@ -0,0 +1,150 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPlugin(name string, created *PluginDependencies, gasConfigs *[]*vmcommon.GasCost) BuiltInFunctionPlugin {
+	return BuiltInFunctionPlugin{
+		Name: name,
+		Create: func(dependencies PluginDependencies) (vmcommon.BuiltinFunction, error) {
+			if created != nil {
+				*created = dependencies
+			}
+
+			return &mock.BuiltInFunctionStub{
+				SetNewGasConfigCalled: func(gasCost *vmcommon.GasCost) {
+					if gasConfigs != nil {
+						*gasConfigs = append(*gasConfigs, gasCost)
+					}
+				},
+			}, nil
+		},
+	}
+}
+
+func TestBuiltInFuncCreator_RegisterPlugin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := NewBuiltInFunctionsCreator(createMockArguments())
+		err := b.RegisterPlugin(createPlugin("", nil, nil))
+		assert.Equal(t, ErrEmptyFunctionName, err)
+	})
+	t.Run("nil create handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := NewBuiltInFunctionsCreator(createMockArguments())
+		err := b.RegisterPlugin(BuiltInFunctionPlugin{Name: "plugin"})
+		assert.True(t, errors.Is(err, ErrNilBuiltInFunctionCreateHandler))
+	})
+	t.Run("collision with a core function should error", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := NewBuiltInFunctionsCreator(createMockArguments())
+		err := b.RegisterPlugin(createPlugin(core.BuiltInFunctionMECTTransfer, nil, nil))
+		assert.True(t, errors.Is(err, ErrBuiltInFunctionNameCollision))
+	})
+	t.Run("collision with another plugin should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.Plugins = []BuiltInFunctionPlugin{createPlugin("plugin", nil, nil), createPlugin("plugin", nil, nil)}
+		b, err := NewBuiltInFunctionsCreator(args)
+		assert.Nil(t, b)
+		assert.True(t, errors.Is(err, ErrBuiltInFunctionNameCollision))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := NewBuiltInFunctionsCreator(createMockArguments())
+		err := b.RegisterPlugin(createPlugin("plugin1", nil, nil))
+		assert.Nil(t, err)
+		err = b.RegisterPlugin(createPlugin("plugin2", nil, nil))
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"plugin1", "plugin2"}, b.RegisteredPlugins())
+	})
+}
+
+func TestBuiltInFuncCreator_CreateBuiltInFunctionContainerWithPlugins(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown enabled plugin should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.EnabledPlugins = map[string]struct{}{"missing": {}}
+		b, _ := NewBuiltInFunctionsCreator(args)
+
+		err := b.CreateBuiltInFunctionContainer()
+		assert.True(t, errors.Is(err, ErrUnknownPlugin))
+	})
+	t.Run("create error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArguments()
+		args.Plugins = []BuiltInFunctionPlugin{{
+			Name: "plugin",
+			Create: func(_ PluginDependencies) (vmcommon.BuiltinFunction, error) {
+				return nil, expectedErr
+			},
+		}}
+		args.EnabledPlugins = map[string]struct{}{"plugin": {}}
+		b, _ := NewBuiltInFunctionsCreator(args)
+
+		err := b.CreateBuiltInFunctionContainer()
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("only allow-listed plugins should be created", func(t *testing.T) {
+		t.Parallel()
+
+		dependencies := PluginDependencies{}
+		gasConfigs := make([]*vmcommon.GasCost, 0)
+		args := createMockArguments()
+		args.Plugins = []BuiltInFunctionPlugin{
+			createPlugin("enabled", &dependencies, &gasConfigs),
+			createPlugin("disabled", nil, nil),
+		}
+		args.EnabledPlugins = map[string]struct{}{"enabled": {}}
+		b, _ := NewBuiltInFunctionsCreator(args)
+
+		err := b.CreateBuiltInFunctionContainer()
+		require.Nil(t, err)
+		numCoreFunctions := len(BuiltInFunctionNames())
+		assert.Equal(t, numCoreFunctions+1, b.BuiltInFunctionContainer().Len())
+
+		_, err = b.BuiltInFunctionContainer().Get("enabled")
+		assert.Nil(t, err)
+		_, err = b.BuiltInFunctionContainer().Get("disabled")
+		assert.True(t, errors.Is(err, ErrInvalidContainerKey))
+
+		assert.True(t, dependencies.Accounts == args.Accounts)
+		assert.True(t, dependencies.Marshaller == args.Marshalizer)
+		assert.True(t, dependencies.EnableEpochsHandler == args.EnableEpochsHandler)
+		assert.False(t, check.IfNil(dependencies.GlobalSettingsHandler))
+		assert.False(t, check.IfNil(dependencies.RolesHandler))
+		assert.False(t, check.IfNil(dependencies.StorageHandler))
+		assert.Equal(t, uint64(1), dependencies.GasConfig.BuiltInCost.MECTTransfer)
+
+		b.GasScheduleChange(fillGasMapInternal(make(map[string]map[string]uint64), 5))
+		require.Equal(t, 1, len(gasConfigs))
+		assert.Equal(t, uint64(5), gasConfigs[0].BuiltInCost.MECTTransfer)
+
+		err = b.CreateBuiltInFunctionContainer()
+		require.Nil(t, err)
+		_, err = b.BuiltInFunctionContainer().Get("enabled")
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(5), dependencies.GasConfig.BuiltInCost.MECTTransfer)
+	})
+}
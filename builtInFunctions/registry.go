@@ -0,0 +1,709 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// noArgumentsLimit marks a built-in function which accepts any number of trailing arguments
+const noArgumentsLimit = -1
+
+const (
+	globalSettingsDependency = "globalSettingsHandler"
+	rolesDependency          = "rolesHandler"
+	storageDependency        = "nftStorageHandler"
+	supplyLedgerDependency   = "supplyLedger"
+)
+
+// argumentsShape describes the number of positional arguments accepted by a built-in function
+type argumentsShape struct {
+	min int
+	max int
+}
+
+func (shape argumentsShape) isValid() bool {
+	return shape.min >= 0 && (shape.max == noArgumentsLimit || shape.max >= shape.min)
+}
+
+func (shape argumentsShape) check(function string, numArguments int) error {
+	if numArguments < shape.min {
+		return fmt.Errorf("%w, %s expects at least %d arguments, got %d", ErrInvalidArguments, function, shape.min, numArguments)
+	}
+	if shape.max != noArgumentsLimit && numArguments > shape.max {
+		return fmt.Errorf("%w, %s expects at most %d arguments, got %d", ErrInvalidArguments, function, shape.max, numArguments)
+	}
+
+	return nil
+}
+
+type builtInFunctionCreateHandler func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error)
+
+// builtInFunctionDefinition declares, in a single place, everything the creator needs to know about a built-in function.
+// The gasCostKey names the BuiltInCost field charged by the function. It is left empty only for the functions executed on
+// behalf of the MECT system smart contract, like SetMECTRole or MECTPause: the issuer already paid the gas of the call in
+// the metachain, so these functions charge nothing and report a gas cost of 0.
+type builtInFunctionDefinition struct {
+	name           string
+	gasCostKey     string
+	activationFlag string
+	dependencies   []string
+	requiredFlags  []string
+	arguments      argumentsShape
+	create         builtInFunctionCreateHandler
+}
+
+func builtInFunctionsRegistry() []*builtInFunctionDefinition {
+	return []*builtInFunctionDefinition{
+		{
+			name:       core.BuiltInFunctionClaimDeveloperRewards,
+			gasCostKey: "ClaimDeveloperRewards",
+			arguments:  argumentsShape{min: 0, max: noArgumentsLimit},
+			create: func(_ *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewClaimDeveloperRewardsFunc(gasCost), nil
+			},
+		},
+		{
+			name:       core.BuiltInFunctionChangeOwnerAddress,
+			gasCostKey: "ChangeOwnerAddress",
+			arguments:  argumentsShape{min: 1, max: noArgumentsLimit},
+			create: func(_ *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewChangeOwnerAddressFunc(gasCost), nil
+			},
+		},
+		{
+			name:       core.BuiltInFunctionSetUserName,
+			gasCostKey: "SaveUserName",
+			arguments:  argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewSaveUserNameFunc(gasCost, b.mapDNSAddresses, b.enableUserNameChange)
+			},
+		},
+		{
+			name:       core.BuiltInFunctionSaveKeyValue,
+			gasCostKey: "SaveKeyValue",
+			arguments:  argumentsShape{min: 2, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewSaveKeyValueStorageFunc(b.gasConfig.BaseOperationCost, gasCost)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTPause,
+			dependencies: []string{globalSettingsDependency},
+			arguments:    argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return b.mectGlobalSettingsHandler, nil
+			},
+		},
+		{
+			name:         core.BuiltInFunctionSetMECTRole,
+			dependencies: []string{rolesDependency},
+			arguments:    argumentsShape{min: 2, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, _ uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return b.rolesHandler, nil
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTTransfer,
+			gasCostKey:   "MECTTransfer",
+			dependencies: []string{globalSettingsDependency, rolesDependency},
+			arguments:    argumentsShape{min: core.MinLenArgumentsMECTTransfer, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTTransferFunc(
+					gasCost,
+					b.marshaller,
+					b.mectGlobalSettingsHandler,
+					b.shardCoordinator,
+					b.accounts,
+					b.rolesHandler,
+					b.enableEpochsHandler,
+				)
+			},
+		},
+		{
+			name:           core.BuiltInFunctionMECTBurn,
+			gasCostKey:     "MECTBurn",
+			activationFlag: vmcommon.GlobalMintBurnFlag,
+			dependencies:   []string{globalSettingsDependency, supplyLedgerDependency},
+			arguments:      argumentsShape{min: 2, max: 2},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTBurnFunc(gasCost, b.marshaller, b.mectGlobalSettingsHandler, activeHandler)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTFreeze,
+			dependencies: []string{globalSettingsDependency},
+			arguments:    argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTFreezeWipeFunc(b.marshaller, b.mectGlobalSettingsHandler, true, false)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTUnFreeze,
+			dependencies: []string{globalSettingsDependency},
+			arguments:    argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTFreezeWipeFunc(b.marshaller, b.mectGlobalSettingsHandler, false, false)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTWipe,
+			dependencies: []string{globalSettingsDependency, supplyLedgerDependency},
+			arguments:    argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTFreezeWipeFunc(b.marshaller, b.mectGlobalSettingsHandler, false, true)
+			},
+		},
+		{
+			name:      core.BuiltInFunctionMECTUnPause,
+			arguments: argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, core.BuiltInFunctionMECTUnPause, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:      core.BuiltInFunctionUnSetMECTRole,
+			arguments: argumentsShape{min: 2, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, _ uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTRolesFunc(b.marshaller, false, b.enableEpochsHandler)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTLocalBurn,
+			gasCostKey:   "MECTLocalBurn",
+			dependencies: []string{globalSettingsDependency, rolesDependency, supplyLedgerDependency},
+			arguments:    argumentsShape{min: 2, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTLocalBurnFunc(gasCost, b.marshaller, b.mectGlobalSettingsHandler, b.rolesHandler)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTLocalMint,
+			gasCostKey:   "MECTLocalMint",
+			dependencies: []string{globalSettingsDependency, rolesDependency, supplyLedgerDependency},
+			arguments:    argumentsShape{min: 2, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTLocalMintFunc(gasCost, b.marshaller, b.mectGlobalSettingsHandler, b.rolesHandler, b.enableEpochsHandler)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTNFTAddQuantity,
+			gasCostKey:   "MECTNFTAddQuantity",
+			dependencies: []string{globalSettingsDependency, rolesDependency, storageDependency, supplyLedgerDependency},
+			arguments:    argumentsShape{min: 3, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTAddQuantityFunc(gasCost, b.mectStorageHandler, b.mectGlobalSettingsHandler, b.rolesHandler, b.enableEpochsHandler)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTNFTBurn,
+			gasCostKey:   "MECTNFTBurn",
+			dependencies: []string{globalSettingsDependency, rolesDependency, storageDependency, supplyLedgerDependency},
+			arguments:    argumentsShape{min: 3, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTBurnFunc(gasCost, b.mectStorageHandler, b.mectGlobalSettingsHandler, b.rolesHandler)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTNFTCreate,
+			gasCostKey:   "MECTNFTCreate",
+			dependencies: []string{globalSettingsDependency, rolesDependency, storageDependency, supplyLedgerDependency},
+			arguments:    argumentsShape{min: 7, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTCreateFunc(
+					gasCost,
+					b.gasConfig.BaseOperationCost,
+					b.marshaller,
+					b.mectGlobalSettingsHandler,
+					b.rolesHandler,
+					b.mectStorageHandler,
+					b.accounts,
+					b.enableEpochsHandler,
+				)
+			},
+		},
+		{
+			name:         core.BuiltInFunctionMECTNFTTransfer,
+			gasCostKey:   "MECTNFTTransfer",
+			dependencies: []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:    argumentsShape{min: core.MinLenArgumentsMECTNFTTransfer, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTTransferFunc(
+					gasCost,
+					b.marshaller,
+					b.mectGlobalSettingsHandler,
+					b.accounts,
+					b.shardCoordinator,
+					b.gasConfig.BaseOperationCost,
+					b.rolesHandler,
+					b.mectStorageHandler,
+					b.enableEpochsHandler,
+				)
+			},
+		},
+		{
+			name:      core.BuiltInFunctionMECTNFTCreateRoleTransfer,
+			arguments: argumentsShape{min: 2, max: 2},
+			create: func(b *builtInFuncCreator, _ uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTCreateRoleTransfer(b.marshaller, b.accounts, b.shardCoordinator)
+			},
+		},
+		{
+			name:           core.BuiltInFunctionMECTNFTUpdateAttributes,
+			gasCostKey:     "MECTNFTUpdateAttributes",
+			activationFlag: vmcommon.MECTNFTImprovementV1Flag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:      argumentsShape{min: 3, max: 3},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTUpdateAttributesFunc(gasCost, b.gasConfig.BaseOperationCost, b.mectStorageHandler, b.mectGlobalSettingsHandler, b.rolesHandler, activeHandler)
+			},
+		},
+		{
+			name:           core.BuiltInFunctionMECTNFTAddURI,
+			gasCostKey:     "MECTNFTAddURI",
+			activationFlag: vmcommon.MECTNFTImprovementV1Flag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:      argumentsShape{min: 3, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTAddUriFunc(gasCost, b.gasConfig.BaseOperationCost, b.mectStorageHandler, b.mectGlobalSettingsHandler, b.rolesHandler, activeHandler)
+			},
+		},
+		{
+			name:           core.BuiltInFunctionMultiMECTNFTTransfer,
+			gasCostKey:     "MECTNFTMultiTransfer",
+			activationFlag: vmcommon.MECTNFTImprovementV1Flag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:      argumentsShape{min: 4, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTMultiTransferFunc(
+					gasCost,
+					b.marshaller,
+					b.mectGlobalSettingsHandler,
+					b.accounts,
+					b.shardCoordinator,
+					b.gasConfig.BaseOperationCost,
+					activeHandler,
+					b.enableEpochsHandler,
+					b.rolesHandler,
+					b.mectStorageHandler,
+				)
+			},
+		},
+		{
+			name:           core.BuiltInFunctionMECTSetLimitedTransfer,
+			activationFlag: vmcommon.MECTTransferRoleFlag,
+			arguments:      argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, core.BuiltInFunctionMECTSetLimitedTransfer, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           core.BuiltInFunctionMECTUnSetLimitedTransfer,
+			activationFlag: vmcommon.MECTTransferRoleFlag,
+			arguments:      argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, core.BuiltInFunctionMECTUnSetLimitedTransfer, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.MECTDeleteMetadata,
+			gasCostKey:     "MECTNFTBurn",
+			activationFlag: vmcommon.SendAlwaysFlag,
+			arguments:      argumentsShape{min: 4, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTDeleteMetadataFunc(b.createDeleteMetadataArgs(gasCost, activeHandler, true))
+			},
+		},
+		{
+			name:           vmcommon.MECTAddMetadata,
+			gasCostKey:     "MECTNFTBurn",
+			activationFlag: vmcommon.SendAlwaysFlag,
+			arguments:      argumentsShape{min: 4, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTDeleteMetadataFunc(b.createDeleteMetadataArgs(gasCost, activeHandler, false))
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTSetBurnRoleForAll,
+			activationFlag: vmcommon.SendAlwaysFlag,
+			arguments:      argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, vmcommon.BuiltInFunctionMECTSetBurnRoleForAll, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll,
+			activationFlag: vmcommon.SendAlwaysFlag,
+			arguments:      argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTTransferRoleDeleteAddress,
+			activationFlag: vmcommon.SendAlwaysFlag,
+			arguments:      argumentsShape{min: 2, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTTransferRoleAddressFunc(b.accounts, b.marshaller, activeHandler, b.maxNumOfAddressesForTransferRole, false)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTTransferRoleAddAddress,
+			activationFlag: vmcommon.SendAlwaysFlag,
+			arguments:      argumentsShape{min: 2, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTTransferRoleAddressFunc(b.accounts, b.marshaller, activeHandler, b.maxNumOfAddressesForTransferRole, true)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTApprove,
+			gasCostKey:     "MECTApprove",
+			activationFlag: vmcommon.MECTAllowanceFlag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency},
+			arguments:      argumentsShape{min: minArgsMECTApprove, max: maxArgsMECTApprove},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTApproveFunc(b.createAllowanceArgs(gasCost, activeHandler))
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTTransferFrom,
+			gasCostKey:     "MECTTransferFrom",
+			activationFlag: vmcommon.MECTAllowanceFlag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:      argumentsShape{min: numArgsMECTTransferFrom, max: numArgsMECTTransferFrom},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTTransferFromFunc(b.createAllowanceArgs(gasCost, activeHandler))
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTVestedTransfer,
+			gasCostKey:     "MECTVestedTransfer",
+			activationFlag: vmcommon.MECTVestingFlag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:      argumentsShape{min: numArgsMECTVestedTransfer, max: numArgsMECTVestedTransfer + 1},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTVestedTransferFunc(b.createVestingArgs(gasCost, activeHandler))
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTClaimVested,
+			gasCostKey:     "MECTClaimVested",
+			activationFlag: vmcommon.MECTVestingFlag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:      argumentsShape{min: numArgsMECTClaimVested, max: numArgsMECTClaimVested},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTClaimVestedFunc(b.createVestingArgs(gasCost, activeHandler))
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTSetRoyaltiesEnforced,
+			activationFlag: vmcommon.MECTRoyaltiesFlag,
+			arguments:      argumentsShape{min: 1, max: 2},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, vmcommon.BuiltInFunctionMECTSetRoyaltiesEnforced, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTUnSetRoyaltiesEnforced,
+			activationFlag: vmcommon.MECTRoyaltiesFlag,
+			arguments:      argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, vmcommon.BuiltInFunctionMECTUnSetRoyaltiesEnforced, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTNFTCreateBatch,
+			gasCostKey:     "MECTNFTCreateBatch",
+			activationFlag: vmcommon.MECTNFTCreateBatchFlag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency, supplyLedgerDependency},
+			arguments:      argumentsShape{min: minArgsMECTNFTCreateBatch, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTCreateBatchFunc(
+					gasCost,
+					b.gasConfig.BaseOperationCost,
+					b.marshaller,
+					b.mectGlobalSettingsHandler,
+					b.rolesHandler,
+					b.mectStorageHandler,
+					b.accounts,
+					b.enableEpochsHandler,
+					activeHandler,
+				)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTNFTSetURIs,
+			gasCostKey:     "MECTNFTSetURIs",
+			activationFlag: vmcommon.MECTNFTModifyURIsFlag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:      argumentsShape{min: 3, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTModifyURIsFunc(gasCost, b.gasConfig.BaseOperationCost, b.mectStorageHandler, b.mectGlobalSettingsHandler, b.rolesHandler, vmcommon.BuiltInFunctionMECTNFTSetURIs, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTNFTRemoveURI,
+			gasCostKey:     "MECTNFTRemoveURI",
+			activationFlag: vmcommon.MECTNFTModifyURIsFlag,
+			dependencies:   []string{globalSettingsDependency, rolesDependency, storageDependency},
+			arguments:      argumentsShape{min: 4, max: 4},
+			create: func(b *builtInFuncCreator, gasCost uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTNFTModifyURIsFunc(gasCost, b.gasConfig.BaseOperationCost, b.mectStorageHandler, b.mectGlobalSettingsHandler, b.rolesHandler, vmcommon.BuiltInFunctionMECTNFTRemoveURI, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTFreezeMetadata,
+			activationFlag: vmcommon.MECTMetadataFreezeFlag,
+			arguments:      argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, vmcommon.BuiltInFunctionMECTFreezeMetadata, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTSetSoulbound,
+			activationFlag: vmcommon.MECTSoulboundFlag,
+			arguments:      argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, vmcommon.BuiltInFunctionMECTSetSoulbound, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTSetTransferFee,
+			activationFlag: vmcommon.MECTTransferFeeFlag,
+			arguments:      argumentsShape{min: 3, max: 3},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, vmcommon.BuiltInFunctionMECTSetTransferFee, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTUnSetTransferFee,
+			activationFlag: vmcommon.MECTTransferFeeFlag,
+			arguments:      argumentsShape{min: 1, max: 1},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, vmcommon.BuiltInFunctionMECTUnSetTransferFee, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTSetMaxSupply,
+			activationFlag: vmcommon.MECTMaxSupplyFlag,
+			requiredFlags:  []string{vmcommon.MECTSupplyLedgerFlag},
+			arguments:      argumentsShape{min: 2, max: 3},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTSetMaxSupplyFunc(b.accounts, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTSetRoleWithExpiry,
+			activationFlag: vmcommon.MECTRoleExpiryFlag,
+			arguments:      argumentsShape{min: 3, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTRolesWithExpiryFunc(b.marshaller, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTRoleTransfer,
+			activationFlag: vmcommon.MECTRoleTransferFlag,
+			arguments:      argumentsShape{min: 3, max: noArgumentsLimit},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTRoleTransferFunc(b.marshaller, b.accounts, b.shardCoordinator, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTSetMintQuota,
+			activationFlag: vmcommon.MECTMintQuotaFlag,
+			arguments:      argumentsShape{min: 2, max: 3},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTSetMintQuotaFunc(b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTScheduleSetting,
+			activationFlag: vmcommon.MECTScheduledSettingsFlag,
+			arguments:      argumentsShape{min: 3, max: 3},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, true, vmcommon.BuiltInFunctionMECTScheduleSetting, b.enableEpochsHandler, activeHandler)
+			},
+		},
+		{
+			name:           vmcommon.BuiltInFunctionMECTCancelScheduledSetting,
+			activationFlag: vmcommon.MECTScheduledSettingsFlag,
+			arguments:      argumentsShape{min: 3, max: 3},
+			create: func(b *builtInFuncCreator, _ uint64, activeHandler func() bool) (vmcommon.BuiltinFunction, error) {
+				return NewMECTGlobalSettingsFunc(b.accounts, b.marshaller, false, vmcommon.BuiltInFunctionMECTCancelScheduledSetting, b.enableEpochsHandler, activeHandler)
+			},
+		},
+	}
+}
+
+// BuiltInFunctionNames returns the names of all the built-in functions declared in the registry
+func BuiltInFunctionNames() []string {
+	registry := builtInFunctionsRegistry()
+	names := make([]string, 0, len(registry))
+	for _, definition := range registry {
+		names = append(names, definition.name)
+	}
+
+	return names
+}
+
+// CheckBuiltInFunctionArguments returns ErrInvalidArguments if the number of arguments does not fit the shape declared
+// in the registry for the built-in function. The functions which are not declared in the registry are not checked.
+func CheckBuiltInFunctionArguments(function string, numArguments int) error {
+	shape, ok := registryArgumentsShapes[function]
+	if !ok {
+		return nil
+	}
+
+	return shape.check(function, numArguments)
+}
+
+var registryArgumentsShapes = createArgumentsShapes(builtInFunctionsRegistry())
+
+func createArgumentsShapes(registry []*builtInFunctionDefinition) map[string]argumentsShape {
+	shapes := make(map[string]argumentsShape, len(registry))
+	for _, definition := range registry {
+		shapes[definition.name] = definition.arguments
+	}
+
+	return shapes
+}
+
+func checkRegistry(registry []*builtInFunctionDefinition) error {
+	names := make(map[string]struct{}, len(registry))
+	for _, definition := range registry {
+		if len(definition.name) == 0 {
+			return ErrEmptyFunctionName
+		}
+		_, exists := names[definition.name]
+		if exists {
+			return fmt.Errorf("%w for built-in function %s", ErrContainerKeyAlreadyExists, definition.name)
+		}
+		names[definition.name] = struct{}{}
+
+		if !definition.arguments.isValid() {
+			return fmt.Errorf("%w for built-in function %s", ErrInvalidArgumentsShape, definition.name)
+		}
+
+		if definition.create == nil {
+			return fmt.Errorf("%w for built-in function %s", ErrNilBuiltInFunctionCreateHandler, definition.name)
+		}
+
+		_, err := getBuiltInCostByKey(vmcommon.BuiltInCost{}, definition.gasCostKey)
+		if err != nil {
+			return fmt.Errorf("%w for built-in function %s", err, definition.name)
+		}
+	}
+
+	return nil
+}
+
+// getBuiltInCostByKey returns the value of the BuiltInCost field named by the provided gas cost key.
+// An empty key means the built-in function does not have a dedicated gas cost.
+func getBuiltInCostByKey(builtInCost vmcommon.BuiltInCost, gasCostKey string) (uint64, error) {
+	if len(gasCostKey) == 0 {
+		return 0, nil
+	}
+
+	field := reflect.ValueOf(builtInCost).FieldByName(gasCostKey)
+	if !field.IsValid() || field.Kind() != reflect.Uint64 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidGasCostKey, gasCostKey)
+	}
+
+	return field.Uint(), nil
+}
+
+func (b *builtInFuncCreator) checkDependencies(definition *builtInFunctionDefinition) error {
+	for _, dependency := range definition.dependencies {
+		var isNil bool
+		switch dependency {
+		case globalSettingsDependency:
+			isNil = check.IfNil(b.mectGlobalSettingsHandler)
+		case rolesDependency:
+			isNil = check.IfNil(b.rolesHandler)
+		case storageDependency:
+			isNil = check.IfNil(b.mectStorageHandler)
+		case supplyLedgerDependency:
+			isNil = check.IfNil(b.supplyLedger)
+		default:
+			return fmt.Errorf("%w %s for built-in function %s", ErrUnknownDependency, dependency, definition.name)
+		}
+
+		if isNil {
+			return fmt.Errorf("%w %s for built-in function %s", ErrMissingDependency, dependency, definition.name)
+		}
+	}
+
+	return nil
+}
+
+// checkRequiredFlags refuses an epochs configuration activating a built-in function before the flags it relies on
+func (b *builtInFuncCreator) checkRequiredFlags() error {
+	for _, definition := range b.registry {
+		activationEpoch := b.enableEpochsHandler.GetActivationEpoch(definition.activationFlag)
+		for _, requiredFlag := range definition.requiredFlags {
+			if b.enableEpochsHandler.GetActivationEpoch(requiredFlag) > activationEpoch {
+				return fmt.Errorf("%w: %s for built-in function %s", ErrRequiredFlagActivatedLater, requiredFlag, definition.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b *builtInFuncCreator) createFromDefinition(definition *builtInFunctionDefinition) (vmcommon.BuiltinFunction, error) {
+	err := b.checkDependencies(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCost, err := getBuiltInCostByKey(b.gasConfig.BuiltInCost, definition.gasCostKey)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err := definition.create(b, gasCost, b.createActiveHandler(definition.activationFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.setDependencies(definition, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFunc, nil
+}
+
+// setDependencies hands over the dependencies which are not constructor arguments
+func (b *builtInFuncCreator) setDependencies(definition *builtInFunctionDefinition, newFunc vmcommon.BuiltinFunction) error {
+	for _, dependency := range definition.dependencies {
+		if dependency != supplyLedgerDependency {
+			continue
+		}
+
+		acceptor, ok := newFunc.(supplyLedgerAcceptor)
+		if !ok {
+			return fmt.Errorf("%w: built-in function %s does not accept a supply ledger", ErrWrongTypeAssertion, definition.name)
+		}
+
+		err := acceptor.SetSupplyLedger(b.supplyLedger)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *builtInFuncCreator) createActiveHandler(activationFlag string) func() bool {
+	if len(activationFlag) == 0 {
+		return trueHandler
+	}
+
+	return func() bool {
+		return b.enableEpochsHandler.IsFlagEnabled(activationFlag)
+	}
+}
@@ -0,0 +1,276 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/enableEpochs"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltInFunctionsRegistry_ShouldBeValid(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, checkRegistry(builtInFunctionsRegistry()))
+}
+
+func TestCheckRegistry_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := builtInFunctionsRegistry()
+		registry[0].name = ""
+		assert.Equal(t, ErrEmptyFunctionName, checkRegistry(registry))
+	})
+	t.Run("duplicated name should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := builtInFunctionsRegistry()
+		registry[1].name = registry[0].name
+		assert.True(t, errors.Is(checkRegistry(registry), ErrContainerKeyAlreadyExists))
+	})
+	t.Run("nil create handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := builtInFunctionsRegistry()
+		registry[0].create = nil
+		assert.True(t, errors.Is(checkRegistry(registry), ErrNilBuiltInFunctionCreateHandler))
+	})
+	t.Run("invalid gas cost key should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := builtInFunctionsRegistry()
+		registry[0].gasCostKey = "NotAGasCost"
+		assert.True(t, errors.Is(checkRegistry(registry), ErrInvalidGasCostKey))
+	})
+	t.Run("invalid arguments shape should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := builtInFunctionsRegistry()
+		registry[0].arguments = argumentsShape{min: 2, max: 1}
+		assert.True(t, errors.Is(checkRegistry(registry), ErrInvalidArgumentsShape))
+
+		registry[0].arguments = argumentsShape{min: -1, max: noArgumentsLimit}
+		assert.True(t, errors.Is(checkRegistry(registry), ErrInvalidArgumentsShape))
+	})
+}
+
+func TestCheckBuiltInFunctionArguments(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, CheckBuiltInFunctionArguments(vmcommon.BuiltInFunctionMECTSetMaxSupply, 2))
+	assert.Nil(t, CheckBuiltInFunctionArguments(vmcommon.BuiltInFunctionMECTSetMaxSupply, 3))
+	assert.True(t, errors.Is(CheckBuiltInFunctionArguments(vmcommon.BuiltInFunctionMECTSetMaxSupply, 1), ErrInvalidArguments))
+	assert.True(t, errors.Is(CheckBuiltInFunctionArguments(vmcommon.BuiltInFunctionMECTSetMaxSupply, 4), ErrInvalidArguments))
+	assert.Nil(t, CheckBuiltInFunctionArguments(core.BuiltInFunctionClaimDeveloperRewards, 100))
+	assert.Nil(t, CheckBuiltInFunctionArguments("unknown", 100))
+}
+
+func TestGetBuiltInCostByKey(t *testing.T) {
+	t.Parallel()
+
+	builtInCost := vmcommon.BuiltInCost{
+		MECTTransfer:  5,
+		MECTNFTAddURI: 7,
+	}
+
+	value, err := getBuiltInCostByKey(builtInCost, "MECTTransfer")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), value)
+
+	value, err = getBuiltInCostByKey(builtInCost, "MECTNFTAddURI")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), value)
+
+	value, err = getBuiltInCostByKey(builtInCost, "")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), value)
+
+	_, err = getBuiltInCostByKey(builtInCost, "missing")
+	assert.True(t, errors.Is(err, ErrInvalidGasCostKey))
+}
+
+func TestBuiltInFuncCreator_CreateFromDefinitionMissingDependencyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	b, err := NewBuiltInFunctionsCreator(createMockArguments())
+	require.Nil(t, err)
+
+	definition := &builtInFunctionDefinition{
+		name:         "test",
+		dependencies: []string{storageDependency},
+		create: func(_ *builtInFuncCreator, _ uint64, _ func() bool) (vmcommon.BuiltinFunction, error) {
+			return nil, nil
+		},
+	}
+	_, err = b.createFromDefinition(definition)
+	assert.True(t, errors.Is(err, ErrMissingDependency))
+
+	definition.dependencies = []string{"unknown"}
+	_, err = b.createFromDefinition(definition)
+	assert.True(t, errors.Is(err, ErrUnknownDependency))
+}
+
+func TestNewBuiltInFunctionsCreator_RequiredFlagActivatedLaterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	activationEpochs := map[string]uint32{
+		vmcommon.MECTMaxSupplyFlag:    10,
+		vmcommon.MECTSupplyLedgerFlag: 11,
+	}
+	args := createMockArguments()
+	args.EnableEpochsHandler = &mock.EnableEpochsHandlerStub{
+		GetActivationEpochCalled: func(flag string) uint32 {
+			return activationEpochs[flag]
+		},
+	}
+	b, err := NewBuiltInFunctionsCreator(args)
+	assert.Nil(t, b)
+	assert.True(t, errors.Is(err, ErrRequiredFlagActivatedLater))
+
+	activationEpochs[vmcommon.MECTSupplyLedgerFlag] = 10
+	b, err = NewBuiltInFunctionsCreator(args)
+	assert.Nil(t, err)
+	assert.NotNil(t, b)
+}
+
+func TestBuiltInFunctionNames_ShouldMatchTheCreatedContainer(t *testing.T) {
+	t.Parallel()
+
+	b, err := NewBuiltInFunctionsCreator(createMockArguments())
+	require.Nil(t, err)
+
+	err = b.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	names := BuiltInFunctionNames()
+	assert.Equal(t, b.BuiltInFunctionContainer().Len(), len(names))
+	for _, name := range names {
+		_, errGet := b.BuiltInFunctionContainer().Get(name)
+		assert.Nil(t, errGet, name)
+	}
+}
+
+func TestBuiltInFunctionsRegistry_OnlySystemSCFunctionsShouldHaveNoGasCost(t *testing.T) {
+	t.Parallel()
+
+	expectedFunctionsWithoutGasCost := []string{
+		core.BuiltInFunctionMECTPause,
+		core.BuiltInFunctionSetMECTRole,
+		core.BuiltInFunctionMECTFreeze,
+		core.BuiltInFunctionMECTUnFreeze,
+		core.BuiltInFunctionMECTWipe,
+		core.BuiltInFunctionMECTUnPause,
+		core.BuiltInFunctionUnSetMECTRole,
+		core.BuiltInFunctionMECTNFTCreateRoleTransfer,
+		core.BuiltInFunctionMECTSetLimitedTransfer,
+		core.BuiltInFunctionMECTUnSetLimitedTransfer,
+		vmcommon.BuiltInFunctionMECTSetBurnRoleForAll,
+		vmcommon.BuiltInFunctionMECTUnSetBurnRoleForAll,
+		vmcommon.BuiltInFunctionMECTTransferRoleDeleteAddress,
+		vmcommon.BuiltInFunctionMECTTransferRoleAddAddress,
+		vmcommon.BuiltInFunctionMECTSetRoyaltiesEnforced,
+		vmcommon.BuiltInFunctionMECTUnSetRoyaltiesEnforced,
+		vmcommon.BuiltInFunctionMECTFreezeMetadata,
+		vmcommon.BuiltInFunctionMECTSetSoulbound,
+		vmcommon.BuiltInFunctionMECTSetTransferFee,
+		vmcommon.BuiltInFunctionMECTUnSetTransferFee,
+		vmcommon.BuiltInFunctionMECTSetMaxSupply,
+		vmcommon.BuiltInFunctionMECTSetRoleWithExpiry,
+		vmcommon.BuiltInFunctionMECTRoleTransfer,
+		vmcommon.BuiltInFunctionMECTSetMintQuota,
+		vmcommon.BuiltInFunctionMECTScheduleSetting,
+		vmcommon.BuiltInFunctionMECTCancelScheduledSetting,
+	}
+
+	functionsWithoutGasCost := make([]string, 0)
+	for _, definition := range builtInFunctionsRegistry() {
+		if len(definition.gasCostKey) == 0 {
+			functionsWithoutGasCost = append(functionsWithoutGasCost, definition.name)
+		}
+	}
+	assert.Equal(t, expectedFunctionsWithoutGasCost, functionsWithoutGasCost)
+}
+
+func createEnableEpochsConfigWithDistinctEpochs() enableEpochs.EnableEpochs {
+	return enableEpochs.EnableEpochs{
+		GlobalMintBurnDisableEpoch:          2,
+		MECTTransferRoleEnableEpoch:         3,
+		MECTTransferToMetaEnableEpoch:       4,
+		MECTNFTImprovementV1ActivationEpoch: 5,
+		SaveNFTToSystemAccountEnableEpoch:   6,
+		CheckCorrectTokenIDEnableEpoch:      7,
+		SendMECTMetadataAlwaysEnableEpoch:   8,
+		CheckFunctionArgumentEnableEpoch:    9,
+		FixOldTokenLiquidityEnableEpoch:     11,
+		MECTSupplyLedgerEnableEpoch:         12,
+		MECTAllowanceEnableEpoch:            13,
+		MECTVestingEnableEpoch:              14,
+		MECTRoyaltiesEnableEpoch:            15,
+		MECTNFTCreateBatchEnableEpoch:       16,
+		MECTNFTModifyURIsEnableEpoch:        17,
+		MECTMetadataFreezeEnableEpoch:       18,
+		MECTSoulboundEnableEpoch:            19,
+		MECTTransferFeeEnableEpoch:          20,
+		MECTMaxSupplyEnableEpoch:            21,
+		MECTRoleExpiryEnableEpoch:           22,
+		MECTRoleTransferEnableEpoch:         23,
+		MECTMintQuotaEnableEpoch:            24,
+		MECTScheduledSettingsEnableEpoch:    25,
+	}
+}
+
+func TestBuiltInFuncCreator_ActivationFlagsShouldFollowTheEnableEpochsHandler(t *testing.T) {
+	t.Parallel()
+
+	enableEpochsHandler, err := enableEpochs.NewEnableEpochsHandler(createEnableEpochsConfigWithDistinctEpochs(), &mock.EpochNotifierStub{})
+	require.Nil(t, err)
+	args := createMockArguments()
+	args.EnableEpochsHandler = enableEpochsHandler
+	b, err := NewBuiltInFunctionsCreator(args)
+	require.Nil(t, err)
+
+	err = b.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	for _, definition := range b.registry {
+		builtInFunc, errGet := b.BuiltInFunctionContainer().Get(definition.name)
+		require.Nil(t, errGet)
+		if len(definition.activationFlag) == 0 {
+			assert.True(t, builtInFunc.IsActive(), definition.name)
+			continue
+		}
+
+		activationEpoch := enableEpochsHandler.GetActivationEpoch(definition.activationFlag)
+		require.NotZero(t, activationEpoch, definition.name)
+		isDisableFlag := definition.activationFlag == vmcommon.GlobalMintBurnFlag
+
+		enableEpochsHandler.EpochConfirmed(activationEpoch-1, 0)
+		assert.Equal(t, isDisableFlag, builtInFunc.IsActive(), definition.name)
+
+		enableEpochsHandler.EpochConfirmed(activationEpoch, 0)
+		assert.Equal(t, !isDisableFlag, builtInFunc.IsActive(), definition.name)
+	}
+}
+
+func TestBuiltInFuncCreator_AllBuiltInFunctionsShouldEstimateGas(t *testing.T) {
+	t.Parallel()
+
+	b, err := NewBuiltInFunctionsCreator(createMockArguments())
+	require.Nil(t, err)
+
+	err = b.CreateBuiltInFunctionContainer()
+	require.Nil(t, err)
+
+	for name := range b.BuiltInFunctionContainer().Keys() {
+		builtInFunc, _ := b.BuiltInFunctionContainer().Get(name)
+		_, ok := builtInFunc.(vmcommon.GasEstimator)
+		assert.True(t, ok, name)
+	}
+}
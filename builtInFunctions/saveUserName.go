@@ -107,6 +107,18 @@ func (s *saveUserName) ProcessBuiltinFunction(
 	return &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - s.gasCost, ReturnCode: vmcommon.Ok}, nil
 }
 
+// EstimateGas returns the gas consumed by the save user name function
+func (s *saveUserName) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+
+	return s.gasCost, nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (s *saveUserName) IsInterfaceNil() bool {
 	return s == nil
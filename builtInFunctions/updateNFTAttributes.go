@@ -5,16 +5,15 @@ import (
 	"sync"
 
 	"github.com/ME-MotherEarth/me-core/core"
-	"github.com/ME-MotherEarth/me-core/core/atomic"
 	"github.com/ME-MotherEarth/me-core/core/check"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
 
 type mectNFTupdate struct {
-	*baseEnabled
+	*baseActiveHandler
 	keyPrefix             []byte
 	mectStorageHandler    vmcommon.MECTNFTStorageHandler
-	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
 	rolesHandler          vmcommon.MECTRoleHandler
 	gasConfig             vmcommon.BaseOperationCost
 	funcGasCost           uint64
@@ -26,10 +25,9 @@ func NewMECTNFTUpdateAttributesFunc(
 	funcGasCost uint64,
 	gasConfig vmcommon.BaseOperationCost,
 	mectStorageHandler vmcommon.MECTNFTStorageHandler,
-	globalSettingsHandler vmcommon.MECTGlobalSettingsHandler,
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler,
 	rolesHandler vmcommon.MECTRoleHandler,
-	activationEpoch uint32,
-	epochNotifier vmcommon.EpochNotifier,
+	activeHandler func() bool,
 ) (*mectNFTupdate, error) {
 	if check.IfNil(mectStorageHandler) {
 		return nil, ErrNilMECTNFTStorageHandler
@@ -40,8 +38,8 @@ func NewMECTNFTUpdateAttributesFunc(
 	if check.IfNil(rolesHandler) {
 		return nil, ErrNilRolesHandler
 	}
-	if check.IfNil(epochNotifier) {
-		return nil, ErrNilEpochHandler
+	if activeHandler == nil {
+		return nil, ErrNilActiveHandler
 	}
 
 	e := &mectNFTupdate{
@@ -54,14 +52,10 @@ func NewMECTNFTUpdateAttributesFunc(
 		rolesHandler:          rolesHandler,
 	}
 
-	e.baseEnabled = &baseEnabled{
-		function:        core.BuiltInFunctionMECTNFTUpdateAttributes,
-		activationEpoch: activationEpoch,
-		flagActivated:   atomic.Flag{},
+	e.baseActiveHandler = &baseActiveHandler{
+		activeHandler: activeHandler,
 	}
 
-	epochNotifier.RegisterNotifyHandler(e)
-
 	return e, nil
 }
 
@@ -102,12 +96,15 @@ func (e *mectNFTupdate) ProcessBuiltinFunction(
 		return nil, err
 	}
 
-	gasCostForStore := uint64(len(vmInput.Arguments[2])) * e.gasConfig.StorePerByte
+	gasCostForStore := e.getGasCostForAttributesStore(vmInput)
 	if vmInput.GasProvided < e.funcGasCost+gasCostForStore {
 		return nil, ErrNotEnoughGas
 	}
 
 	mectTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	if e.globalSettingsHandler.IsMetadataFrozen(mectTokenKey) {
+		return nil, ErrMECTMetadataIsFrozen
+	}
 	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
 	if nonce == 0 {
 		return nil, ErrNFTDoesNotHaveMetadata
@@ -134,6 +131,25 @@ func (e *mectNFTupdate) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
+// EstimateGas returns the gas consumed by the MECT NFT update attributes function
+func (e *mectNFTupdate) EstimateGas(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (uint64, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return 0, ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 3 {
+		return 0, ErrInvalidArguments
+	}
+
+	return e.funcGasCost + e.getGasCostForAttributesStore(vmInput), nil
+}
+
+func (e *mectNFTupdate) getGasCostForAttributesStore(vmInput *vmcommon.ContractCallInput) uint64 {
+	return uint64(len(vmInput.Arguments[2])) * e.gasConfig.StorePerByte
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *mectNFTupdate) IsInterfaceNil() bool {
 	return e == nil
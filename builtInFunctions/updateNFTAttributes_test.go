@@ -18,27 +18,27 @@ func TestNewMECTNFTUpdateAttributesFunc(t *testing.T) {
 	t.Parallel()
 
 	// nil marshaller
-	e, err := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, nil, nil, nil, 0, &mock.EpochNotifierStub{})
+	e, err := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, nil, nil, nil, trueHandler)
 	require.True(t, check.IfNil(e))
 	require.Equal(t, ErrNilMECTNFTStorageHandler, err)
 
 	// nil pause handler
-	e, err = NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), nil, nil, 0, &mock.EpochNotifierStub{})
+	e, err = NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), nil, nil, trueHandler)
 	require.True(t, check.IfNil(e))
 	require.Equal(t, ErrNilGlobalSettingsHandler, err)
 
 	// nil roles handler
-	e, err = NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, nil, 0, &mock.EpochNotifierStub{})
+	e, err = NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, nil, trueHandler)
 	require.True(t, check.IfNil(e))
 	require.Equal(t, ErrNilRolesHandler, err)
 
-	// nil epoch notifier
-	e, err = NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, nil)
+	// nil active handler
+	e, err = NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, nil)
 	require.True(t, check.IfNil(e))
-	require.Equal(t, ErrNilEpochHandler, err)
+	require.Equal(t, ErrNilActiveHandler, err)
 
 	// should work
-	e, err = NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	e, err = NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, falseHandler)
 	require.False(t, check.IfNil(e))
 	require.NoError(t, err)
 	require.False(t, e.IsActive())
@@ -48,7 +48,7 @@ func TestMECTNFTUpdateAttributes_SetNewGasConfig_NilGasCost(t *testing.T) {
 	t.Parallel()
 
 	defaultGasCost := uint64(10)
-	e, _ := NewMECTNFTUpdateAttributesFunc(defaultGasCost, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(defaultGasCost, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, trueHandler)
 
 	e.SetNewGasConfig(nil)
 	require.Equal(t, defaultGasCost, e.funcGasCost)
@@ -59,7 +59,7 @@ func TestMECTNFTUpdateAttributes_SetNewGasConfig_ShouldWork(t *testing.T) {
 
 	defaultGasCost := uint64(10)
 	newGasCost := uint64(37)
-	e, _ := NewMECTNFTUpdateAttributesFunc(defaultGasCost, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(defaultGasCost, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, trueHandler)
 
 	e.SetNewGasConfig(
 		&vmcommon.GasCost{
@@ -75,7 +75,7 @@ func TestMECTNFTUpdateAttributes_SetNewGasConfig_ShouldWork(t *testing.T) {
 func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionErrorOnCheckInput(t *testing.T) {
 	t.Parallel()
 
-	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, trueHandler)
 
 	// nil vm input
 	output, err := e.ProcessBuiltinFunction(mock.NewAccountWrapMock([]byte("addr")), nil, nil)
@@ -176,7 +176,7 @@ func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionErrorOnCheckInput(t *test
 func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionInvalidNumberOfArguments(t *testing.T) {
 	t.Parallel()
 
-	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, trueHandler)
 	output, err := e.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -203,7 +203,7 @@ func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionCheckAllowedToExecuteErro
 			return localErr
 		},
 	}
-	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, rolesHandler, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, rolesHandler, trueHandler)
 	output, err := e.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -225,7 +225,7 @@ func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionCheckAllowedToExecuteErro
 func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionNewSenderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, trueHandler)
 	output, err := e.ProcessBuiltinFunction(
 		mock.NewAccountWrapMock([]byte("addr")),
 		nil,
@@ -249,7 +249,7 @@ func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionMetaDataMissing(t *testin
 	t.Parallel()
 
 	marshaller := &mock.MarshalizerMock{}
-	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), &mock.GlobalSettingsHandlerStub{}, &mock.MECTRoleHandlerStub{}, trueHandler)
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	mectData := &mect.MECToken{}
@@ -283,7 +283,7 @@ func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionShouldErrOnSaveBecauseTok
 		},
 	}
 
-	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandlerWithArgs(globalSettingsHandler, &mock.AccountsStub{}), globalSettingsHandler, &mock.MECTRoleHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandlerWithArgs(globalSettingsHandler, &mock.AccountsStub{}), globalSettingsHandler, &mock.MECTRoleHandlerStub{}, trueHandler)
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	mectData := &mect.MECToken{
@@ -331,7 +331,7 @@ func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionShouldWork(t *testing.T)
 			return nil
 		},
 	}
-	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, mectDataStorage, &mock.GlobalSettingsHandlerStub{}, mectRoleHandler, 0, &mock.EpochNotifierStub{})
+	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, mectDataStorage, &mock.GlobalSettingsHandlerStub{}, mectRoleHandler, trueHandler)
 
 	userAcc := mock.NewAccountWrapMock([]byte("addr"))
 	mectData := &mect.MECToken{
@@ -369,3 +369,32 @@ func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionShouldWork(t *testing.T)
 	metaData, _ := mectDataStorage.getMECTMetaDataFromSystemAccount(tokenKey)
 	require.Equal(t, metaData.Attributes, newAttributes)
 }
+
+func TestMECTNFTUpdateAttributes_ProcessBuiltinFunctionShouldErrBecauseMetadataIsFrozen(t *testing.T) {
+	t.Parallel()
+
+	globalSettingsHandler := &mock.GlobalSettingsHandlerStub{
+		IsMetadataFrozenCalled: func(token []byte) bool {
+			require.Equal(t, []byte(baseMECTKeyPrefix+"arg0"), token)
+			return true
+		},
+	}
+	e, _ := NewMECTNFTUpdateAttributesFunc(10, vmcommon.BaseOperationCost{}, createNewMECTDataStorageHandler(), globalSettingsHandler, &mock.MECTRoleHandlerStub{}, trueHandler)
+
+	output, err := e.ProcessBuiltinFunction(
+		mock.NewAccountWrapMock([]byte("addr")),
+		nil,
+		&vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallValue:   big.NewInt(0),
+				Arguments:   [][]byte{[]byte("arg0"), []byte("arg1"), []byte("arg2")},
+				CallerAddr:  []byte("address 1"),
+				GasProvided: 12,
+			},
+			RecipientAddr: []byte("address 1"),
+		},
+	)
+
+	require.Nil(t, output)
+	require.Equal(t, ErrMECTMetadataIsFrozen, err)
+}
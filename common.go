@@ -26,6 +26,69 @@ const BuiltInFunctionMECTTransferRoleAddAddress = "MECTTransferRoleAddAddress"
 // BuiltInFunctionMECTTransferRoleDeleteAddress represents the defined built in function name for transfer role delete address
 const BuiltInFunctionMECTTransferRoleDeleteAddress = "MECTTransferRoleDeleteAddress"
 
+// BuiltInFunctionMECTSetRoyaltiesEnforced represents the defined built in function name for mect set royalties enforced
+const BuiltInFunctionMECTSetRoyaltiesEnforced = "MECTSetRoyaltiesEnforced"
+
+// BuiltInFunctionMECTUnSetRoyaltiesEnforced represents the defined built in function name for mect unset royalties enforced
+const BuiltInFunctionMECTUnSetRoyaltiesEnforced = "MECTUnSetRoyaltiesEnforced"
+
+// MECTRoyaltiesIdentifier is the identifier of the log entry emitted when royalties are paid during an NFT transfer
+const MECTRoyaltiesIdentifier = "MECTRoyalties"
+
+// BuiltInFunctionMECTSetTransferFee represents the defined built in function name for mect set transfer fee
+const BuiltInFunctionMECTSetTransferFee = "MECTSetTransferFee"
+
+// BuiltInFunctionMECTUnSetTransferFee represents the defined built in function name for mect unset transfer fee
+const BuiltInFunctionMECTUnSetTransferFee = "MECTUnSetTransferFee"
+
+// MECTTransferFeeIdentifier is the identifier of the log entry emitted when a transfer fee is paid
+const MECTTransferFeeIdentifier = "MECTTransferFee"
+
+// BuiltInFunctionMECTNFTCreateBatch represents the defined built in function name for mect nft create batch
+const BuiltInFunctionMECTNFTCreateBatch = "MECTNFTCreateBatch"
+
+// BuiltInFunctionMECTNFTSetURIs represents the defined built in function name for mect nft set uris
+const BuiltInFunctionMECTNFTSetURIs = "MECTNFTSetURIs"
+
+// BuiltInFunctionMECTNFTRemoveURI represents the defined built in function name for mect nft remove uri
+const BuiltInFunctionMECTNFTRemoveURI = "MECTNFTRemoveURI"
+
+// BuiltInFunctionMECTFreezeMetadata represents the defined built in function name for mect freeze metadata
+const BuiltInFunctionMECTFreezeMetadata = "MECTFreezeMetadata"
+
+// BuiltInFunctionMECTSetSoulbound represents the defined built in function name for mect set soulbound
+const BuiltInFunctionMECTSetSoulbound = "MECTSetSoulbound"
+
+// BuiltInFunctionMECTSetMaxSupply represents the defined built in function name for mect set max supply
+const BuiltInFunctionMECTSetMaxSupply = "MECTSetMaxSupply"
+
+// BuiltInFunctionMECTSetRoleWithExpiry represents the defined built in function name for mect set role with expiry
+const BuiltInFunctionMECTSetRoleWithExpiry = "MECTSetRoleWithExpiry"
+
+// BuiltInFunctionMECTRoleTransfer represents the defined built in function name for mect role transfer
+const BuiltInFunctionMECTRoleTransfer = "MECTRoleTransfer"
+
+// BuiltInFunctionMECTSetMintQuota represents the defined built in function name for mect set mint quota
+const BuiltInFunctionMECTSetMintQuota = "MECTSetMintQuota"
+
+// BuiltInFunctionMECTScheduleSetting represents the defined built in function name for mect schedule setting
+const BuiltInFunctionMECTScheduleSetting = "MECTScheduleSetting"
+
+// BuiltInFunctionMECTCancelScheduledSetting represents the defined built in function name for mect cancel scheduled setting
+const BuiltInFunctionMECTCancelScheduledSetting = "MECTCancelScheduledSetting"
+
+// BuiltInFunctionMECTApprove represents the defined built in function name for mect approve
+const BuiltInFunctionMECTApprove = "MECTApprove"
+
+// BuiltInFunctionMECTTransferFrom represents the defined built in function name for mect transfer from
+const BuiltInFunctionMECTTransferFrom = "MECTTransferFrom"
+
+// BuiltInFunctionMECTVestedTransfer represents the defined built in function name for mect vested transfer
+const BuiltInFunctionMECTVestedTransfer = "MECTVestedTransfer"
+
+// BuiltInFunctionMECTClaimVested represents the defined built in function name for mect claim vested
+const BuiltInFunctionMECTClaimVested = "MECTClaimVested"
+
 // MECTRoleBurnForAll represents the role for burn for all
 const MECTRoleBurnForAll = "MECTRoleBurnForAll"
 
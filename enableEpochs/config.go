@@ -0,0 +1,28 @@
+package enableEpochs
+
+// EnableEpochs holds the epochs at which the features used by the built-in functions are enabled or disabled
+type EnableEpochs struct {
+	GlobalMintBurnDisableEpoch          uint32
+	MECTTransferRoleEnableEpoch         uint32
+	MECTTransferToMetaEnableEpoch       uint32
+	MECTNFTImprovementV1ActivationEpoch uint32
+	SaveNFTToSystemAccountEnableEpoch   uint32
+	CheckCorrectTokenIDEnableEpoch      uint32
+	SendMECTMetadataAlwaysEnableEpoch   uint32
+	CheckFunctionArgumentEnableEpoch    uint32
+	FixOldTokenLiquidityEnableEpoch     uint32
+	MECTSupplyLedgerEnableEpoch         uint32
+	MECTAllowanceEnableEpoch            uint32
+	MECTVestingEnableEpoch              uint32
+	MECTRoyaltiesEnableEpoch            uint32
+	MECTNFTCreateBatchEnableEpoch       uint32
+	MECTNFTModifyURIsEnableEpoch        uint32
+	MECTMetadataFreezeEnableEpoch       uint32
+	MECTSoulboundEnableEpoch            uint32
+	MECTTransferFeeEnableEpoch          uint32
+	MECTMaxSupplyEnableEpoch            uint32
+	MECTRoleExpiryEnableEpoch           uint32
+	MECTRoleTransferEnableEpoch         uint32
+	MECTMintQuotaEnableEpoch            uint32
+	MECTScheduledSettingsEnableEpoch    uint32
+}
@@ -0,0 +1,196 @@
+package enableEpochs
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ME-MotherEarth/me-core/core/check"
+	logger "github.com/ME-MotherEarth/me-logger"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+var log = logger.GetOrCreate("vmCommon/enableEpochs")
+
+var _ vmcommon.EnableEpochsHandler = (*enableEpochsHandler)(nil)
+
+// FlagActivation holds the activation details of a flag in a given epoch
+type FlagActivation struct {
+	Flag          string
+	Epoch         uint32
+	IsDisableFlag bool
+	Enabled       bool
+}
+
+type enableEpochsHandler struct {
+	flags           map[string]flagEpoch
+	mutCurrentEpoch sync.RWMutex
+	currentEpoch    uint32
+}
+
+// NewEnableEpochsHandler creates a new instance of enableEpochsHandler, registered on the provided epoch notifier
+func NewEnableEpochsHandler(enableEpochsConfig EnableEpochs, epochNotifier vmcommon.EpochNotifier) (*enableEpochsHandler, error) {
+	if check.IfNil(epochNotifier) {
+		return nil, ErrNilEpochNotifier
+	}
+
+	handler := &enableEpochsHandler{
+		flags: createFlags(enableEpochsConfig),
+	}
+
+	epochNotifier.RegisterNotifyHandler(handler)
+
+	return handler, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (handler *enableEpochsHandler) EpochConfirmed(epoch uint32, _ uint64) {
+	handler.mutCurrentEpoch.Lock()
+	previousEpoch := handler.currentEpoch
+	handler.currentEpoch = epoch
+	handler.mutCurrentEpoch.Unlock()
+
+	for _, flagActivation := range handler.getChangedFlags(previousEpoch, epoch) {
+		log.Debug("enable epochs handler", "epoch", epoch, "flag", flagActivation.Flag, "enabled", flagActivation.Enabled)
+	}
+}
+
+// getChangedFlags returns the activation details, in the new epoch, of the flags whose state differs between the two epochs
+func (handler *enableEpochsHandler) getChangedFlags(oldEpoch uint32, newEpoch uint32) []FlagActivation {
+	changedFlags := make([]FlagActivation, 0)
+	for _, flagActivation := range handler.GetActivationTable(newEpoch) {
+		if flagActivation.Enabled != handler.IsFlagEnabledInEpoch(flagActivation.Flag, oldEpoch) {
+			changedFlags = append(changedFlags, flagActivation)
+		}
+	}
+
+	return changedFlags
+}
+
+// GetCurrentEpoch returns the last confirmed epoch
+func (handler *enableEpochsHandler) GetCurrentEpoch() uint32 {
+	handler.mutCurrentEpoch.RLock()
+	defer handler.mutCurrentEpoch.RUnlock()
+
+	return handler.currentEpoch
+}
+
+// IsFlagEnabled returns true if the provided flag is enabled in the current epoch
+func (handler *enableEpochsHandler) IsFlagEnabled(flag string) bool {
+	return handler.IsFlagEnabledInEpoch(flag, handler.GetCurrentEpoch())
+}
+
+// IsFlagEnabledInEpoch returns true if the provided flag is enabled in the provided epoch
+func (handler *enableEpochsHandler) IsFlagEnabledInEpoch(flag string, epoch uint32) bool {
+	fe, ok := handler.flags[flag]
+	if !ok {
+		return false
+	}
+
+	return fe.isEnabledInEpoch(epoch)
+}
+
+// GetActivationEpoch returns the epoch at which the provided flag changes its state, 0 for unknown flags
+func (handler *enableEpochsHandler) GetActivationEpoch(flag string) uint32 {
+	return handler.flags[flag].epoch
+}
+
+// GetActivationTable returns the state of all the flags in the provided epoch, sorted by flag name
+func (handler *enableEpochsHandler) GetActivationTable(epoch uint32) []FlagActivation {
+	table := make([]FlagActivation, 0, len(handler.flags))
+	for flag, fe := range handler.flags {
+		table = append(table, FlagActivation{
+			Flag:          flag,
+			Epoch:         fe.epoch,
+			IsDisableFlag: fe.isDisableFlag,
+			Enabled:       fe.isEnabledInEpoch(epoch),
+		})
+	}
+
+	sort.Slice(table, func(i, j int) bool {
+		return table[i].Flag < table[j].Flag
+	})
+
+	return table
+}
+
+// GetEnabledFlagsInEpoch returns the sorted names of the flags enabled in the provided epoch
+func (handler *enableEpochsHandler) GetEnabledFlagsInEpoch(epoch uint32) []string {
+	enabledFlags := make([]string, 0, len(handler.flags))
+	for _, flagActivation := range handler.GetActivationTable(epoch) {
+		if flagActivation.Enabled {
+			enabledFlags = append(enabledFlags, flagActivation.Flag)
+		}
+	}
+
+	return enabledFlags
+}
+
+// IsGlobalMintBurnFlagEnabled returns true if the global mint and burn functions are still enabled
+func (handler *enableEpochsHandler) IsGlobalMintBurnFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.GlobalMintBurnFlag)
+}
+
+// IsMECTTransferRoleFlagEnabled returns true if vmcommon.MECTTransferRoleFlag is enabled
+func (handler *enableEpochsHandler) IsMECTTransferRoleFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.MECTTransferRoleFlag)
+}
+
+// IsTransferToMetaFlagEnabled returns true if vmcommon.TransferToMetaFlag is enabled
+func (handler *enableEpochsHandler) IsTransferToMetaFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.TransferToMetaFlag)
+}
+
+// IsMECTNFTImprovementV1FlagEnabled returns true if vmcommon.MECTNFTImprovementV1Flag is enabled
+func (handler *enableEpochsHandler) IsMECTNFTImprovementV1FlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.MECTNFTImprovementV1Flag)
+}
+
+// IsSaveToSystemAccountFlagEnabled returns true if vmcommon.SaveToSystemAccountFlag is enabled
+func (handler *enableEpochsHandler) IsSaveToSystemAccountFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.SaveToSystemAccountFlag)
+}
+
+// IsCheckFrozenCollectionFlagEnabled returns true if vmcommon.CheckFrozenCollectionFlag is enabled
+func (handler *enableEpochsHandler) IsCheckFrozenCollectionFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.CheckFrozenCollectionFlag)
+}
+
+// IsValueLengthCheckFlagEnabled returns true if vmcommon.ValueLengthCheckFlag is enabled
+func (handler *enableEpochsHandler) IsValueLengthCheckFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.ValueLengthCheckFlag)
+}
+
+// IsCheckTransferFlagEnabled returns true if vmcommon.CheckTransferFlag is enabled
+func (handler *enableEpochsHandler) IsCheckTransferFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.CheckTransferFlag)
+}
+
+// IsCheckCorrectTokenIDEnabled returns true if vmcommon.CheckCorrectTokenIDFlag is enabled
+func (handler *enableEpochsHandler) IsCheckCorrectTokenIDEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.CheckCorrectTokenIDFlag)
+}
+
+// IsSendAlwaysFlagEnabled returns true if vmcommon.SendAlwaysFlag is enabled
+func (handler *enableEpochsHandler) IsSendAlwaysFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.SendAlwaysFlag)
+}
+
+// IsCheckFunctionArgumentFlagEnabled returns true if vmcommon.CheckFunctionArgumentFlag is enabled
+func (handler *enableEpochsHandler) IsCheckFunctionArgumentFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.CheckFunctionArgumentFlag)
+}
+
+// IsFixAsyncCallbackCheckFlagEnabled returns true if vmcommon.FixAsyncCallbackCheckFlag is enabled
+func (handler *enableEpochsHandler) IsFixAsyncCallbackCheckFlagEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.FixAsyncCallbackCheckFlag)
+}
+
+// IsFixOldTokenLiquidityEnabled returns true if vmcommon.FixOldTokenLiquidityFlag is enabled
+func (handler *enableEpochsHandler) IsFixOldTokenLiquidityEnabled() bool {
+	return handler.IsFlagEnabled(vmcommon.FixOldTokenLiquidityFlag)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *enableEpochsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
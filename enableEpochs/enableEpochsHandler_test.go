@@ -0,0 +1,195 @@
+package enableEpochs
+
+import (
+	"testing"
+
+	"github.com/ME-MotherEarth/me-core/core/check"
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createEnableEpochsConfig() EnableEpochs {
+	return EnableEpochs{
+		GlobalMintBurnDisableEpoch:          2,
+		MECTTransferRoleEnableEpoch:         3,
+		MECTTransferToMetaEnableEpoch:       4,
+		MECTNFTImprovementV1ActivationEpoch: 5,
+		SaveNFTToSystemAccountEnableEpoch:   6,
+		CheckCorrectTokenIDEnableEpoch:      7,
+		SendMECTMetadataAlwaysEnableEpoch:   8,
+		CheckFunctionArgumentEnableEpoch:    9,
+		FixOldTokenLiquidityEnableEpoch:     11,
+		MECTSupplyLedgerEnableEpoch:         12,
+		MECTAllowanceEnableEpoch:            13,
+		MECTVestingEnableEpoch:              14,
+		MECTRoyaltiesEnableEpoch:            15,
+		MECTNFTCreateBatchEnableEpoch:       16,
+		MECTNFTModifyURIsEnableEpoch:        17,
+		MECTMetadataFreezeEnableEpoch:       18,
+		MECTSoulboundEnableEpoch:            19,
+		MECTTransferFeeEnableEpoch:          20,
+		MECTMaxSupplyEnableEpoch:            21,
+		MECTRoleExpiryEnableEpoch:           22,
+		MECTRoleTransferEnableEpoch:         23,
+		MECTMintQuotaEnableEpoch:            24,
+		MECTScheduledSettingsEnableEpoch:    25,
+	}
+}
+
+func TestNewEnableEpochsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil epoch notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewEnableEpochsHandler(createEnableEpochsConfig(), nil)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilEpochNotifier, err)
+	})
+	t.Run("should work and register once", func(t *testing.T) {
+		t.Parallel()
+
+		numRegistered := 0
+		epochNotifier := &mock.EpochNotifierStub{
+			RegisterNotifyHandlerCalled: func(handler vmcommon.EpochSubscriberHandler) {
+				numRegistered++
+			},
+		}
+		handler, err := NewEnableEpochsHandler(createEnableEpochsConfig(), epochNotifier)
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numRegistered)
+	})
+}
+
+func TestEnableEpochsHandler_EpochConfirmed(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewEnableEpochsHandler(createEnableEpochsConfig(), &mock.EpochNotifierStub{})
+	assert.Equal(t, uint32(0), handler.GetCurrentEpoch())
+	assert.True(t, handler.IsGlobalMintBurnFlagEnabled())
+	assert.False(t, handler.IsMECTTransferRoleFlagEnabled())
+	assert.False(t, handler.IsFixOldTokenLiquidityEnabled())
+
+	handler.EpochConfirmed(6, 0)
+	assert.Equal(t, uint32(6), handler.GetCurrentEpoch())
+	assert.False(t, handler.IsGlobalMintBurnFlagEnabled())
+	assert.True(t, handler.IsMECTTransferRoleFlagEnabled())
+	assert.True(t, handler.IsTransferToMetaFlagEnabled())
+	assert.True(t, handler.IsMECTNFTImprovementV1FlagEnabled())
+	assert.True(t, handler.IsSaveToSystemAccountFlagEnabled())
+	assert.True(t, handler.IsCheckFrozenCollectionFlagEnabled())
+	assert.True(t, handler.IsValueLengthCheckFlagEnabled())
+	assert.True(t, handler.IsCheckTransferFlagEnabled())
+	assert.False(t, handler.IsCheckCorrectTokenIDEnabled())
+	assert.False(t, handler.IsSendAlwaysFlagEnabled())
+	assert.False(t, handler.IsCheckFunctionArgumentFlagEnabled())
+	assert.False(t, handler.IsFixOldTokenLiquidityEnabled())
+
+	handler.EpochConfirmed(11, 0)
+	assert.True(t, handler.IsCheckCorrectTokenIDEnabled())
+	assert.True(t, handler.IsSendAlwaysFlagEnabled())
+	assert.True(t, handler.IsCheckFunctionArgumentFlagEnabled())
+	assert.True(t, handler.IsFixOldTokenLiquidityEnabled())
+}
+
+func TestEnableEpochsHandler_FixAsyncCallbackCheckShouldBeAlwaysEnabled(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewEnableEpochsHandler(createEnableEpochsConfig(), &mock.EpochNotifierStub{})
+	assert.True(t, handler.IsFixAsyncCallbackCheckFlagEnabled())
+	assert.Equal(t, uint32(0), handler.GetActivationEpoch(vmcommon.FixAsyncCallbackCheckFlag))
+
+	handler.EpochConfirmed(5, 0)
+	assert.True(t, handler.IsFixAsyncCallbackCheckFlagEnabled())
+}
+
+func TestEnableEpochsHandler_GetChangedFlags(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewEnableEpochsHandler(createEnableEpochsConfig(), &mock.EpochNotifierStub{})
+
+	assert.Empty(t, handler.getChangedFlags(3, 3))
+	assert.Empty(t, handler.getChangedFlags(9, 10))
+
+	expectedChanges := []FlagActivation{
+		{Flag: vmcommon.GlobalMintBurnFlag, Epoch: 2, IsDisableFlag: true, Enabled: false},
+		{Flag: vmcommon.MECTTransferRoleFlag, Epoch: 3, Enabled: true},
+	}
+	assert.Equal(t, expectedChanges, handler.getChangedFlags(1, 3))
+
+	expectedChanges = []FlagActivation{
+		{Flag: vmcommon.GlobalMintBurnFlag, Epoch: 2, IsDisableFlag: true, Enabled: true},
+		{Flag: vmcommon.MECTTransferRoleFlag, Epoch: 3, Enabled: false},
+	}
+	assert.Equal(t, expectedChanges, handler.getChangedFlags(3, 1))
+}
+
+func TestEnableEpochsHandler_FlagsShouldActivateAtTheirConfiguredEpoch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		flag     string
+		setEpoch func(config *EnableEpochs, epoch uint32)
+	}{
+		{vmcommon.MECTSupplyLedgerFlag, func(config *EnableEpochs, epoch uint32) { config.MECTSupplyLedgerEnableEpoch = epoch }},
+		{vmcommon.MECTAllowanceFlag, func(config *EnableEpochs, epoch uint32) { config.MECTAllowanceEnableEpoch = epoch }},
+		{vmcommon.MECTVestingFlag, func(config *EnableEpochs, epoch uint32) { config.MECTVestingEnableEpoch = epoch }},
+		{vmcommon.MECTRoyaltiesFlag, func(config *EnableEpochs, epoch uint32) { config.MECTRoyaltiesEnableEpoch = epoch }},
+		{vmcommon.MECTNFTCreateBatchFlag, func(config *EnableEpochs, epoch uint32) { config.MECTNFTCreateBatchEnableEpoch = epoch }},
+		{vmcommon.MECTNFTModifyURIsFlag, func(config *EnableEpochs, epoch uint32) { config.MECTNFTModifyURIsEnableEpoch = epoch }},
+		{vmcommon.MECTMetadataFreezeFlag, func(config *EnableEpochs, epoch uint32) { config.MECTMetadataFreezeEnableEpoch = epoch }},
+		{vmcommon.MECTSoulboundFlag, func(config *EnableEpochs, epoch uint32) { config.MECTSoulboundEnableEpoch = epoch }},
+		{vmcommon.MECTTransferFeeFlag, func(config *EnableEpochs, epoch uint32) { config.MECTTransferFeeEnableEpoch = epoch }},
+		{vmcommon.MECTMaxSupplyFlag, func(config *EnableEpochs, epoch uint32) { config.MECTMaxSupplyEnableEpoch = epoch }},
+		{vmcommon.MECTRoleExpiryFlag, func(config *EnableEpochs, epoch uint32) { config.MECTRoleExpiryEnableEpoch = epoch }},
+		{vmcommon.MECTRoleTransferFlag, func(config *EnableEpochs, epoch uint32) { config.MECTRoleTransferEnableEpoch = epoch }},
+		{vmcommon.MECTMintQuotaFlag, func(config *EnableEpochs, epoch uint32) { config.MECTMintQuotaEnableEpoch = epoch }},
+		{vmcommon.MECTScheduledSettingsFlag, func(config *EnableEpochs, epoch uint32) { config.MECTScheduledSettingsEnableEpoch = epoch }},
+	}
+	for _, tt := range tests {
+		config := EnableEpochs{}
+		tt.setEpoch(&config, 7)
+		handler, _ := NewEnableEpochsHandler(config, &mock.EpochNotifierStub{})
+
+		handler.EpochConfirmed(6, 0)
+		assert.False(t, handler.IsFlagEnabled(tt.flag), tt.flag)
+		enabledBefore := handler.GetEnabledFlagsInEpoch(6)
+
+		handler.EpochConfirmed(7, 0)
+		assert.True(t, handler.IsFlagEnabled(tt.flag), tt.flag)
+		assert.Equal(t, uint32(7), handler.GetActivationEpoch(tt.flag))
+		assert.Equal(t, len(enabledBefore)+1, len(handler.GetEnabledFlagsInEpoch(7)), tt.flag)
+	}
+}
+
+func TestEnableEpochsHandler_IsFlagEnabledInEpoch(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewEnableEpochsHandler(createEnableEpochsConfig(), &mock.EpochNotifierStub{})
+
+	assert.False(t, handler.IsFlagEnabledInEpoch("unknown flag", 100))
+	assert.True(t, handler.IsFlagEnabledInEpoch(vmcommon.GlobalMintBurnFlag, 1))
+	assert.False(t, handler.IsFlagEnabledInEpoch(vmcommon.GlobalMintBurnFlag, 2))
+	assert.False(t, handler.IsFlagEnabledInEpoch(vmcommon.SendAlwaysFlag, 7))
+	assert.True(t, handler.IsFlagEnabledInEpoch(vmcommon.SendAlwaysFlag, 8))
+	assert.Equal(t, uint32(8), handler.GetActivationEpoch(vmcommon.SendAlwaysFlag))
+	assert.Equal(t, uint32(0), handler.GetActivationEpoch("unknown flag"))
+}
+
+func TestEnableEpochsHandler_GetActivationTable(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewEnableEpochsHandler(createEnableEpochsConfig(), &mock.EpochNotifierStub{})
+
+	table := handler.GetActivationTable(4)
+	assert.Equal(t, len(createFlags(createEnableEpochsConfig())), len(table))
+	for i := 1; i < len(table); i++ {
+		assert.True(t, table[i-1].Flag < table[i].Flag)
+	}
+
+	expectedEnabledFlags := []string{vmcommon.FixAsyncCallbackCheckFlag, vmcommon.MECTTransferRoleFlag, vmcommon.TransferToMetaFlag}
+	assert.Equal(t, expectedEnabledFlags, handler.GetEnabledFlagsInEpoch(4))
+	assert.Equal(t, []string{vmcommon.FixAsyncCallbackCheckFlag, vmcommon.GlobalMintBurnFlag}, handler.GetEnabledFlagsInEpoch(0))
+}
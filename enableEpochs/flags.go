@@ -0,0 +1,50 @@
+package enableEpochs
+
+import vmcommon "github.com/ME-MotherEarth/me-vm-common"
+
+type flagEpoch struct {
+	epoch         uint32
+	isDisableFlag bool
+}
+
+func (fe flagEpoch) isEnabledInEpoch(epoch uint32) bool {
+	if fe.isDisableFlag {
+		return epoch < fe.epoch
+	}
+
+	return epoch >= fe.epoch
+}
+
+// createFlags maps every flag to the epoch configured for it. The fix of the async callback payable check has no configured
+// epoch as it is active since genesis, the built-in functions creator never having applied one.
+func createFlags(enableEpochs EnableEpochs) map[string]flagEpoch {
+	return map[string]flagEpoch{
+		vmcommon.GlobalMintBurnFlag:        {epoch: enableEpochs.GlobalMintBurnDisableEpoch, isDisableFlag: true},
+		vmcommon.MECTTransferRoleFlag:      {epoch: enableEpochs.MECTTransferRoleEnableEpoch},
+		vmcommon.TransferToMetaFlag:        {epoch: enableEpochs.MECTTransferToMetaEnableEpoch},
+		vmcommon.MECTNFTImprovementV1Flag:  {epoch: enableEpochs.MECTNFTImprovementV1ActivationEpoch},
+		vmcommon.SaveToSystemAccountFlag:   {epoch: enableEpochs.SaveNFTToSystemAccountEnableEpoch},
+		vmcommon.CheckFrozenCollectionFlag: {epoch: enableEpochs.SaveNFTToSystemAccountEnableEpoch},
+		vmcommon.ValueLengthCheckFlag:      {epoch: enableEpochs.SaveNFTToSystemAccountEnableEpoch},
+		vmcommon.CheckTransferFlag:         {epoch: enableEpochs.SaveNFTToSystemAccountEnableEpoch},
+		vmcommon.CheckCorrectTokenIDFlag:   {epoch: enableEpochs.CheckCorrectTokenIDEnableEpoch},
+		vmcommon.SendAlwaysFlag:            {epoch: enableEpochs.SendMECTMetadataAlwaysEnableEpoch},
+		vmcommon.CheckFunctionArgumentFlag: {epoch: enableEpochs.CheckFunctionArgumentEnableEpoch},
+		vmcommon.FixAsyncCallbackCheckFlag: {epoch: 0},
+		vmcommon.FixOldTokenLiquidityFlag:  {epoch: enableEpochs.FixOldTokenLiquidityEnableEpoch},
+		vmcommon.MECTSupplyLedgerFlag:      {epoch: enableEpochs.MECTSupplyLedgerEnableEpoch},
+		vmcommon.MECTAllowanceFlag:         {epoch: enableEpochs.MECTAllowanceEnableEpoch},
+		vmcommon.MECTVestingFlag:           {epoch: enableEpochs.MECTVestingEnableEpoch},
+		vmcommon.MECTRoyaltiesFlag:         {epoch: enableEpochs.MECTRoyaltiesEnableEpoch},
+		vmcommon.MECTNFTCreateBatchFlag:    {epoch: enableEpochs.MECTNFTCreateBatchEnableEpoch},
+		vmcommon.MECTNFTModifyURIsFlag:     {epoch: enableEpochs.MECTNFTModifyURIsEnableEpoch},
+		vmcommon.MECTMetadataFreezeFlag:    {epoch: enableEpochs.MECTMetadataFreezeEnableEpoch},
+		vmcommon.MECTSoulboundFlag:         {epoch: enableEpochs.MECTSoulboundEnableEpoch},
+		vmcommon.MECTTransferFeeFlag:       {epoch: enableEpochs.MECTTransferFeeEnableEpoch},
+		vmcommon.MECTMaxSupplyFlag:         {epoch: enableEpochs.MECTMaxSupplyEnableEpoch},
+		vmcommon.MECTRoleExpiryFlag:        {epoch: enableEpochs.MECTRoleExpiryEnableEpoch},
+		vmcommon.MECTRoleTransferFlag:      {epoch: enableEpochs.MECTRoleTransferEnableEpoch},
+		vmcommon.MECTMintQuotaFlag:         {epoch: enableEpochs.MECTMintQuotaEnableEpoch},
+		vmcommon.MECTScheduledSettingsFlag: {epoch: enableEpochs.MECTScheduledSettingsEnableEpoch},
+	}
+}
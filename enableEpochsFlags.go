@@ -0,0 +1,58 @@
+package vmcommon
+
+const (
+	// GlobalMintBurnFlag is enabled until the global mint and burn functions are disabled
+	GlobalMintBurnFlag = "GlobalMintBurnFlag"
+	// MECTTransferRoleFlag enables the limited transfer functions
+	MECTTransferRoleFlag = "MECTTransferRoleFlag"
+	// TransferToMetaFlag enables MECT transfers towards the metachain
+	TransferToMetaFlag = "TransferToMetaFlag"
+	// MECTNFTImprovementV1Flag enables the multi transfer, add URI and update attributes functions
+	MECTNFTImprovementV1Flag = "MECTNFTImprovementV1Flag"
+	// SaveToSystemAccountFlag enables saving the NFT metadata on the system account
+	SaveToSystemAccountFlag = "SaveToSystemAccountFlag"
+	// CheckFrozenCollectionFlag enables the frozen collection check
+	CheckFrozenCollectionFlag = "CheckFrozenCollectionFlag"
+	// ValueLengthCheckFlag enables the quantity length check on NFT create and add quantity
+	ValueLengthCheckFlag = "ValueLengthCheckFlag"
+	// CheckTransferFlag enables the zero value check on NFT transfers
+	CheckTransferFlag = "CheckTransferFlag"
+	// CheckCorrectTokenIDFlag enables the correct tokenID check for the transfer role
+	CheckCorrectTokenIDFlag = "CheckCorrectTokenIDFlag"
+	// SendAlwaysFlag enables always sending the NFT metadata cross shard
+	SendAlwaysFlag = "SendAlwaysFlag"
+	// CheckFunctionArgumentFlag enables the function argument check on payable verifications
+	CheckFunctionArgumentFlag = "CheckFunctionArgumentFlag"
+	// FixAsyncCallbackCheckFlag enables the fix on the async callback payable check
+	FixAsyncCallbackCheckFlag = "FixAsyncCallbackCheckFlag"
+	// FixOldTokenLiquidityFlag enables the fix for the liquidity of old tokens
+	FixOldTokenLiquidityFlag = "FixOldTokenLiquidityFlag"
+	// MECTSupplyLedgerFlag enables recording the MECT supply changes on the system account
+	MECTSupplyLedgerFlag = "MECTSupplyLedgerFlag"
+	// MECTAllowanceFlag enables the MECTApprove and MECTTransferFrom built-in functions
+	MECTAllowanceFlag = "MECTAllowanceFlag"
+	// MECTVestingFlag enables the MECTVestedTransfer and MECTClaimVested built-in functions
+	MECTVestingFlag = "MECTVestingFlag"
+	// MECTRoyaltiesFlag enables royalty enforcement for MECT NFT collections
+	MECTRoyaltiesFlag = "MECTRoyaltiesFlag"
+	// MECTNFTCreateBatchFlag enables the MECTNFTCreateBatch built-in function
+	MECTNFTCreateBatchFlag = "MECTNFTCreateBatchFlag"
+	// MECTNFTModifyURIsFlag enables the MECTNFTSetURIs and MECTNFTRemoveURI built-in functions
+	MECTNFTModifyURIsFlag = "MECTNFTModifyURIsFlag"
+	// MECTMetadataFreezeFlag enables the MECTFreezeMetadata built-in function
+	MECTMetadataFreezeFlag = "MECTMetadataFreezeFlag"
+	// MECTSoulboundFlag enables the MECTSetSoulbound built-in function
+	MECTSoulboundFlag = "MECTSoulboundFlag"
+	// MECTTransferFeeFlag enables the per token transfer fee and the MECTSetTransferFee and MECTUnSetTransferFee built-in functions
+	MECTTransferFeeFlag = "MECTTransferFeeFlag"
+	// MECTMaxSupplyFlag enables the maximum supply of the MECT tokens and the MECTSetMaxSupply built-in function
+	MECTMaxSupplyFlag = "MECTMaxSupplyFlag"
+	// MECTRoleExpiryFlag enables the MECTSetRoleWithExpiry built-in function
+	MECTRoleExpiryFlag = "MECTRoleExpiryFlag"
+	// MECTRoleTransferFlag enables the MECTRoleTransfer built-in function
+	MECTRoleTransferFlag = "MECTRoleTransferFlag"
+	// MECTMintQuotaFlag enables the MECTSetMintQuota built-in function and the mint quotas checked by MECTLocalMint
+	MECTMintQuotaFlag = "MECTMintQuotaFlag"
+	// MECTScheduledSettingsFlag enables the scheduled pause and limited transfer global settings
+	MECTScheduledSettingsFlag = "MECTScheduledSettingsFlag"
+)
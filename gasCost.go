@@ -28,6 +28,13 @@ type BuiltInCost struct {
 	MECTNFTMultiTransfer     uint64
 	MECTNFTAddURI            uint64
 	MECTNFTUpdateAttributes  uint64
+	MECTApprove              uint64
+	MECTTransferFrom         uint64
+	MECTVestedTransfer       uint64
+	MECTClaimVested          uint64
+	MECTNFTCreateBatch       uint64
+	MECTNFTSetURIs           uint64
+	MECTNFTRemoveURI         uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
@@ -176,6 +176,12 @@ type AccountDataHandler interface {
 	IsInterfaceNil() bool
 }
 
+// AccountDataIterator is the optional extension of an AccountDataHandler which can scan the saved keys.
+// The handler is called for every key starting with the prefix, in key order, until it returns false
+type AccountDataIterator interface {
+	IterateKeysWithPrefix(prefix []byte, handler func(key []byte, value []byte) bool) error
+}
+
 // AccountHandler models a state account, which can journalize and revert
 // It knows about code and data, as data structures not hashes
 type AccountHandler interface {
@@ -204,6 +210,11 @@ type ExtendedMECTGlobalSettingsHandler interface {
 	IsPaused(mectTokenKey []byte) bool
 	IsLimitedTransfer(mectTokenKey []byte) bool
 	IsBurnForAll(mectTokenKey []byte) bool
+	IsRoyaltiesEnforced(mectTokenKey []byte) bool
+	GetRoyaltiesReceiver(tokenID []byte) []byte
+	GetTransferFee(tokenID []byte) (uint32, []byte)
+	IsMetadataFrozen(mectTokenKey []byte) bool
+	IsSoulbound(mectTokenKey []byte) bool
 	IsSenderOrDestinationWithTransferRole(sender, destination, tokenID []byte) bool
 	IsInterfaceNil() bool
 }
@@ -254,6 +265,25 @@ type BuiltinFunction interface {
 	IsInterfaceNil() bool
 }
 
+// GasEstimator defines a built-in function able to compute the gas it would consume without executing
+type GasEstimator interface {
+	EstimateGas(acntSnd, acntDst UserAccountHandler, vmInput *ContractCallInput) (uint64, error)
+}
+
+// BuiltInFunctionInterceptor defines the hooks called around the execution of any built-in function.
+// Returning an error from BeforeProcess vetoes the execution
+type BuiltInFunctionInterceptor interface {
+	BeforeProcess(functionName string, acntSnd, acntDst UserAccountHandler, vmInput *ContractCallInput) error
+	AfterProcess(functionName string, vmInput *ContractCallInput, vmOutput *VMOutput, err error)
+	IsInterfaceNil() bool
+}
+
+// WrappedBuiltinFunction defines a built-in function returned by the container wrapped in the interceptors chain
+type WrappedBuiltinFunction interface {
+	BuiltinFunction
+	Unwrap() BuiltinFunction
+}
+
 // BuiltInFunctionContainer defines the methods for the built-in protocol container
 type BuiltInFunctionContainer interface {
 	Get(key string) (BuiltinFunction, error)
@@ -277,6 +307,29 @@ type EpochNotifier interface {
 	IsInterfaceNil() bool
 }
 
+// EnableEpochsHandler is used to verify which flags are set in the current epoch based on EpochNotifier events
+type EnableEpochsHandler interface {
+	IsGlobalMintBurnFlagEnabled() bool
+	IsMECTTransferRoleFlagEnabled() bool
+	IsTransferToMetaFlagEnabled() bool
+	IsMECTNFTImprovementV1FlagEnabled() bool
+	IsSaveToSystemAccountFlagEnabled() bool
+	IsCheckFrozenCollectionFlagEnabled() bool
+	IsValueLengthCheckFlagEnabled() bool
+	IsCheckTransferFlagEnabled() bool
+	IsCheckCorrectTokenIDEnabled() bool
+	IsSendAlwaysFlagEnabled() bool
+	IsCheckFunctionArgumentFlagEnabled() bool
+	IsFixAsyncCallbackCheckFlagEnabled() bool
+	IsFixOldTokenLiquidityEnabled() bool
+
+	IsFlagEnabled(flag string) bool
+	IsFlagEnabledInEpoch(flag string, epoch uint32) bool
+	GetActivationEpoch(flag string) uint32
+	GetCurrentEpoch() uint32
+	IsInterfaceNil() bool
+}
+
 // MECTTransferParser can parse single and multi MECT / NFT transfers
 type MECTTransferParser interface {
 	ParseMECTTransfers(sndAddr []byte, rcvAddr []byte, function string, args [][]byte) (*ParsedMECTTransfers, error)
@@ -301,6 +354,21 @@ type SimpleMECTNFTStorageHandler interface {
 	IsInterfaceNil() bool
 }
 
+// MECTSupplyHandler provides the MECT supply recorded on the current shard
+type MECTSupplyHandler interface {
+	GetMECTSupply(tokenID []byte, nonce uint64) (*MECTSupply, error)
+	IsInterfaceNil() bool
+}
+
+// MECTSupplyLedgerHandler records the MECT supply changes made by the built-in functions
+type MECTSupplyLedgerHandler interface {
+	GetMECTSupply(tokenID []byte, nonce uint64) (*MECTSupply, error)
+	AddMinted(tokenID []byte, nonce uint64, value *big.Int) error
+	AddBurned(tokenID []byte, nonce uint64, value *big.Int) error
+	AddWiped(tokenID []byte, nonce uint64, value *big.Int) error
+	IsInterfaceNil() bool
+}
+
 // CallArgsParser will handle parsing transaction data to function and arguments
 type CallArgsParser interface {
 	ParseData(data string) (string, [][]byte, error)
@@ -330,3 +398,10 @@ type AcceptPayableChecker interface {
 	SetPayableChecker(payableHandler PayableChecker) error
 	IsInterfaceNil() bool
 }
+
+// MECTPortfolioHandler reads all the MECT tokens and roles held by an account
+type MECTPortfolioHandler interface {
+	GetMECTPortfolio(account UserAccountHandler) (*MECTPortfolio, error)
+	GetRemainingMintQuota(address []byte, tokenID []byte) (*big.Int, error)
+	IsInterfaceNil() bool
+}
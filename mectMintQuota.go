@@ -0,0 +1,27 @@
+package vmcommon
+
+import "math/big"
+
+// MECTMintQuota bounds the amount of a MECT token an account holding the LocalMint role can mint. Minted is the amount
+// minted against the quota. A quota with PerEpoch set is renewed every epoch, Minted being the amount minted in Epoch
+type MECTMintQuota struct {
+	TokenID  []byte
+	Amount   *big.Int
+	Minted   *big.Int
+	Epoch    uint32
+	PerEpoch bool
+}
+
+// RemainingAt returns the amount which can still be minted at the given epoch
+func (q *MECTMintQuota) RemainingAt(epoch uint32) *big.Int {
+	if q.PerEpoch && epoch != q.Epoch {
+		return big.NewInt(0).Set(q.Amount)
+	}
+
+	remaining := big.NewInt(0).Sub(q.Amount, q.Minted)
+	if remaining.Sign() < 0 {
+		return big.NewInt(0)
+	}
+
+	return remaining
+}
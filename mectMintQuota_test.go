@@ -0,0 +1,30 @@
+package vmcommon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMECTMintQuota_RemainingAt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("absolute quota", func(t *testing.T) {
+		quota := &MECTMintQuota{Amount: big.NewInt(100), Minted: big.NewInt(30), Epoch: 5}
+
+		assert.Equal(t, big.NewInt(70), quota.RemainingAt(5))
+		assert.Equal(t, big.NewInt(70), quota.RemainingAt(6))
+	})
+	t.Run("quota per epoch", func(t *testing.T) {
+		quota := &MECTMintQuota{Amount: big.NewInt(100), Minted: big.NewInt(30), Epoch: 5, PerEpoch: true}
+
+		assert.Equal(t, big.NewInt(70), quota.RemainingAt(5))
+		assert.Equal(t, big.NewInt(100), quota.RemainingAt(6))
+	})
+	t.Run("lowered quota", func(t *testing.T) {
+		quota := &MECTMintQuota{Amount: big.NewInt(10), Minted: big.NewInt(30)}
+
+		assert.Equal(t, big.NewInt(0), quota.RemainingAt(0))
+	})
+}
@@ -0,0 +1,41 @@
+package vmcommon
+
+import (
+	"math/big"
+
+	"github.com/ME-MotherEarth/me-core/data/mect"
+)
+
+// MECTFungibleBalance holds the balance of a fungible MECT token
+type MECTFungibleBalance struct {
+	TokenID []byte
+	Value   *big.Int
+	Frozen  bool
+}
+
+// MECTNFTHolding holds the quantity of a non-fungible, semi-fungible or meta MECT token nonce.
+// MetaData is resolved from the system account when it is not saved on the holder account
+type MECTNFTHolding struct {
+	TokenID  []byte
+	Nonce    uint64
+	Type     uint32
+	Value    *big.Int
+	Frozen   bool
+	MetaData *mect.MetaData
+}
+
+// MECTHeldRoles holds the roles an account has for a MECT token
+type MECTHeldRoles struct {
+	TokenID []byte
+	Roles   [][]byte
+}
+
+// MECTPortfolio holds all the MECT tokens, locked balances, roles and mint quotas of an account, sorted by token
+// identifier and nonce
+type MECTPortfolio struct {
+	Fungible   []*MECTFungibleBalance
+	NFTs       []*MECTNFTHolding
+	Locked     []*MECTLockedBalance
+	Roles      []*MECTHeldRoles
+	MintQuotas []*MECTMintQuota
+}
@@ -0,0 +1,12 @@
+package vmcommon
+
+import "math/big"
+
+// MECTSupply holds the amounts of a MECT token created and destroyed by the built-in functions on the current shard.
+// Supply is the net result, Minted - Burned - Wiped, and can be negative on a shard which only destroyed tokens
+type MECTSupply struct {
+	Minted *big.Int
+	Burned *big.Int
+	Wiped  *big.Int
+	Supply *big.Int
+}
@@ -0,0 +1,48 @@
+package vmcommon
+
+import "math/big"
+
+// MECTVestingSchedule describes an amount locked on an account. Nothing is released before the cliff epoch and
+// everything is released at the end epoch. In between, the amount is released in Steps equal tranches, a value of 0
+// meaning one tranche per epoch, which is a linear release
+type MECTVestingSchedule struct {
+	Amount     *big.Int
+	Claimed    *big.Int
+	CliffEpoch uint32
+	EndEpoch   uint32
+	Steps      uint32
+}
+
+// UnlockedAt returns the part of the amount released at the given epoch, the already claimed part included
+func (s *MECTVestingSchedule) UnlockedAt(epoch uint32) *big.Int {
+	if epoch < s.CliffEpoch {
+		return big.NewInt(0)
+	}
+	if epoch >= s.EndEpoch {
+		return big.NewInt(0).Set(s.Amount)
+	}
+
+	duration := uint64(s.EndEpoch - s.CliffEpoch)
+	steps := uint64(s.Steps)
+	if steps == 0 {
+		steps = duration
+	}
+	elapsedSteps := steps * uint64(epoch-s.CliffEpoch) / duration
+
+	unlocked := big.NewInt(0).Mul(s.Amount, big.NewInt(0).SetUint64(elapsedSteps))
+	return unlocked.Div(unlocked, big.NewInt(0).SetUint64(steps))
+}
+
+// ClaimableAt returns the part of the amount released at the given epoch which was not claimed yet
+func (s *MECTVestingSchedule) ClaimableAt(epoch uint32) *big.Int {
+	claimable := s.UnlockedAt(epoch)
+	return claimable.Sub(claimable, s.Claimed)
+}
+
+// MECTLockedBalance holds the vesting schedules of a MECT token nonce. Locked is the amount not claimed yet
+type MECTLockedBalance struct {
+	TokenID   []byte
+	Nonce     uint64
+	Locked    *big.Int
+	Schedules []*MECTVestingSchedule
+}
@@ -0,0 +1,49 @@
+package vmcommon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMECTVestingSchedule_UnlockedAt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("linear release", func(t *testing.T) {
+		schedule := &MECTVestingSchedule{Amount: big.NewInt(100), Claimed: big.NewInt(0), CliffEpoch: 10, EndEpoch: 20}
+
+		assert.Equal(t, big.NewInt(0), schedule.UnlockedAt(9))
+		assert.Equal(t, big.NewInt(0), schedule.UnlockedAt(10))
+		assert.Equal(t, big.NewInt(30), schedule.UnlockedAt(13))
+		assert.Equal(t, big.NewInt(90), schedule.UnlockedAt(19))
+		assert.Equal(t, big.NewInt(100), schedule.UnlockedAt(20))
+		assert.Equal(t, big.NewInt(100), schedule.UnlockedAt(100))
+	})
+	t.Run("stepped release", func(t *testing.T) {
+		schedule := &MECTVestingSchedule{Amount: big.NewInt(100), Claimed: big.NewInt(0), CliffEpoch: 10, EndEpoch: 20, Steps: 4}
+
+		assert.Equal(t, big.NewInt(0), schedule.UnlockedAt(12))
+		assert.Equal(t, big.NewInt(25), schedule.UnlockedAt(13))
+		assert.Equal(t, big.NewInt(50), schedule.UnlockedAt(15))
+		assert.Equal(t, big.NewInt(75), schedule.UnlockedAt(19))
+		assert.Equal(t, big.NewInt(100), schedule.UnlockedAt(20))
+	})
+	t.Run("cliff only release", func(t *testing.T) {
+		schedule := &MECTVestingSchedule{Amount: big.NewInt(100), Claimed: big.NewInt(0), CliffEpoch: 10, EndEpoch: 10}
+
+		assert.Equal(t, big.NewInt(0), schedule.UnlockedAt(9))
+		assert.Equal(t, big.NewInt(100), schedule.UnlockedAt(10))
+	})
+}
+
+func TestMECTVestingSchedule_ClaimableAt(t *testing.T) {
+	t.Parallel()
+
+	schedule := &MECTVestingSchedule{Amount: big.NewInt(100), Claimed: big.NewInt(30), CliffEpoch: 10, EndEpoch: 20}
+
+	assert.Equal(t, big.NewInt(20), schedule.ClaimableAt(15))
+	assert.Equal(t, big.NewInt(70), schedule.ClaimableAt(20))
+	assert.Equal(t, 0, schedule.UnlockedAt(20).Cmp(schedule.Amount))
+	assert.Equal(t, big.NewInt(30), schedule.Claimed)
+}
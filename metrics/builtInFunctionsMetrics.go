@@ -0,0 +1,162 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/builtInFunctions"
+)
+
+// unknownErrorType is used to count the errors that are not built-in errors
+const unknownErrorType = "unknown"
+
+var _ vmcommon.BuiltInFunctionInterceptor = (*builtInFunctionsMetrics)(nil)
+
+// FunctionMetrics holds the metrics recorded for a built-in function
+type FunctionMetrics struct {
+	NumCalls         uint64
+	NumErrors        uint64
+	ErrorsByType     map[string]uint64
+	GasConsumed      uint64
+	NumStorageWrites uint64
+	Latency          HistogramSnapshot
+}
+
+type functionMetrics struct {
+	numCalls         uint64
+	numErrors        uint64
+	errorsByType     map[string]uint64
+	gasConsumed      uint64
+	numStorageWrites uint64
+	latency          *histogram
+}
+
+type builtInFunctionsMetrics struct {
+	mut            sync.RWMutex
+	functions      map[string]*functionMetrics
+	startTimes     map[*vmcommon.ContractCallInput]time.Time
+	latencyBuckets []time.Duration
+	getTimeHandler func() time.Time
+}
+
+// NewBuiltInFunctionsMetrics creates a metrics collector which records every built-in function execution once it is
+// added as interceptor on the built-in functions container
+func NewBuiltInFunctionsMetrics() *builtInFunctionsMetrics {
+	return &builtInFunctionsMetrics{
+		functions:      make(map[string]*functionMetrics),
+		startTimes:     make(map[*vmcommon.ContractCallInput]time.Time),
+		latencyBuckets: defaultLatencyBuckets,
+		getTimeHandler: time.Now,
+	}
+}
+
+// BeforeProcess records the start time of the execution
+func (bfm *builtInFunctionsMetrics) BeforeProcess(_ string, _, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) error {
+	startTime := bfm.getTimeHandler()
+
+	bfm.mut.Lock()
+	bfm.startTimes[vmInput] = startTime
+	bfm.mut.Unlock()
+
+	return nil
+}
+
+// AfterProcess records the outcome of the execution
+func (bfm *builtInFunctionsMetrics) AfterProcess(
+	functionName string,
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	err error,
+) {
+	endTime := bfm.getTimeHandler()
+
+	bfm.mut.Lock()
+	defer bfm.mut.Unlock()
+
+	metrics := bfm.getOrCreateFunctionMetrics(functionName)
+	metrics.numCalls++
+
+	startTime, found := bfm.startTimes[vmInput]
+	if found {
+		delete(bfm.startTimes, vmInput)
+		metrics.latency.observe(endTime.Sub(startTime))
+	}
+
+	if err != nil {
+		metrics.numErrors++
+		metrics.errorsByType[getErrorType(err)]++
+		return
+	}
+	if vmInput == nil || vmOutput == nil {
+		return
+	}
+
+	if vmInput.GasProvided >= vmOutput.GasRemaining {
+		metrics.gasConsumed += vmInput.GasProvided - vmOutput.GasRemaining
+	}
+	for _, outAcc := range vmOutput.OutputAccounts {
+		metrics.numStorageWrites += uint64(len(outAcc.StorageUpdates))
+	}
+}
+
+func (bfm *builtInFunctionsMetrics) getOrCreateFunctionMetrics(functionName string) *functionMetrics {
+	metrics, found := bfm.functions[functionName]
+	if found {
+		return metrics
+	}
+
+	metrics = &functionMetrics{
+		errorsByType: make(map[string]uint64),
+		latency:      newHistogram(bfm.latencyBuckets),
+	}
+	bfm.functions[functionName] = metrics
+
+	return metrics
+}
+
+func getErrorType(err error) string {
+	builtInErr, ok := builtInFunctions.GetBuiltInError(err)
+	if !ok {
+		return unknownErrorType
+	}
+
+	return builtInErr.Error()
+}
+
+// Snapshot returns a copy of the metrics recorded so far, per built-in function name
+func (bfm *builtInFunctionsMetrics) Snapshot() map[string]FunctionMetrics {
+	bfm.mut.RLock()
+	defer bfm.mut.RUnlock()
+
+	snapshot := make(map[string]FunctionMetrics, len(bfm.functions))
+	for functionName, metrics := range bfm.functions {
+		errorsByType := make(map[string]uint64, len(metrics.errorsByType))
+		for errType, numErrors := range metrics.errorsByType {
+			errorsByType[errType] = numErrors
+		}
+
+		snapshot[functionName] = FunctionMetrics{
+			NumCalls:         metrics.numCalls,
+			NumErrors:        metrics.numErrors,
+			ErrorsByType:     errorsByType,
+			GasConsumed:      metrics.gasConsumed,
+			NumStorageWrites: metrics.numStorageWrites,
+			Latency:          metrics.latency.snapshot(),
+		}
+	}
+
+	return snapshot
+}
+
+// Reset clears all the recorded metrics
+func (bfm *builtInFunctionsMetrics) Reset() {
+	bfm.mut.Lock()
+	bfm.functions = make(map[string]*functionMetrics)
+	bfm.mut.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bfm *builtInFunctionsMetrics) IsInterfaceNil() bool {
+	return bfm == nil
+}
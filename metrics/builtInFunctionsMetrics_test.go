@@ -0,0 +1,165 @@
+package metrics
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+	"github.com/ME-MotherEarth/me-vm-common/builtInFunctions"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMetricsWithFixedLatency(latency time.Duration) *builtInFunctionsMetrics {
+	bfm := NewBuiltInFunctionsMetrics()
+	currentTime := time.Unix(0, 0)
+	bfm.getTimeHandler = func() time.Time {
+		currentTime = currentTime.Add(latency)
+		return currentTime
+	}
+
+	return bfm
+}
+
+func process(bfm *builtInFunctionsMetrics, functionName string, vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) {
+	_ = bfm.BeforeProcess(functionName, nil, nil, vmInput)
+	bfm.AfterProcess(functionName, vmInput, vmOutput, err)
+}
+
+func TestNewBuiltInFunctionsMetrics(t *testing.T) {
+	t.Parallel()
+
+	bfm := NewBuiltInFunctionsMetrics()
+	assert.False(t, bfm.IsInterfaceNil())
+	assert.Empty(t, bfm.Snapshot())
+}
+
+func TestBuiltInFunctionsMetrics_ShouldRecordExecutions(t *testing.T) {
+	t.Parallel()
+
+	bfm := createMetricsWithFixedLatency(time.Millisecond)
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining: 60,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			"a": {StorageUpdates: map[string]*vmcommon.StorageUpdate{"k1": {}, "k2": {}}},
+			"b": {StorageUpdates: map[string]*vmcommon.StorageUpdate{"k3": {}}},
+		},
+	}
+	process(bfm, "fn", &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{GasProvided: 100}}, vmOutput, nil)
+	process(bfm, "fn", &vmcommon.ContractCallInput{}, nil, fmt.Errorf("%w in test", builtInFunctions.ErrNotEnoughGas))
+	process(bfm, "fn", &vmcommon.ContractCallInput{}, nil, errors.New("other error"))
+	process(bfm, "other", &vmcommon.ContractCallInput{}, &vmcommon.VMOutput{}, nil)
+
+	snapshot := bfm.Snapshot()
+	require.Equal(t, 2, len(snapshot))
+
+	fnMetrics := snapshot["fn"]
+	assert.Equal(t, uint64(3), fnMetrics.NumCalls)
+	assert.Equal(t, uint64(2), fnMetrics.NumErrors)
+	assert.Equal(t, map[string]uint64{builtInFunctions.ErrNotEnoughGas.Error(): 1, unknownErrorType: 1}, fnMetrics.ErrorsByType)
+	assert.Equal(t, uint64(40), fnMetrics.GasConsumed)
+	assert.Equal(t, uint64(3), fnMetrics.NumStorageWrites)
+	assert.Equal(t, uint64(3), fnMetrics.Latency.Count)
+	assert.Equal(t, 3*time.Millisecond, fnMetrics.Latency.Sum)
+	assert.Equal(t, []uint64{0, 0, 0, 0, 3, 3, 3, 3, 3, 3}, fnMetrics.Latency.Counts)
+
+	assert.Equal(t, uint64(1), snapshot["other"].NumCalls)
+	assert.Empty(t, bfm.startTimes)
+
+	bfm.Reset()
+	assert.Empty(t, bfm.Snapshot())
+}
+
+func TestBuiltInFunctionsMetrics_SnapshotShouldBeACopy(t *testing.T) {
+	t.Parallel()
+
+	bfm := NewBuiltInFunctionsMetrics()
+	process(bfm, "fn", &vmcommon.ContractCallInput{}, nil, errors.New("error"))
+
+	snapshot := bfm.Snapshot()
+	snapshot["fn"].ErrorsByType[unknownErrorType] = 10
+	snapshot["fn"].Latency.Counts[0] = 10
+
+	assert.Equal(t, uint64(1), bfm.Snapshot()["fn"].ErrorsByType[unknownErrorType])
+	assert.Equal(t, uint64(1), bfm.Snapshot()["fn"].Latency.Counts[0])
+}
+
+func TestBuiltInFunctionsMetrics_WithContainer(t *testing.T) {
+	t.Parallel()
+
+	bfm := NewBuiltInFunctionsMetrics()
+	container := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = container.Add("fn", &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - 5}, nil
+		},
+	})
+	err := container.AddInterceptor(bfm)
+	require.Nil(t, err)
+
+	builtInFunc, _ := container.Get("fn")
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			_, _ = builtInFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{GasProvided: 10}})
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	snapshot := bfm.Snapshot()
+	assert.Equal(t, uint64(numCalls), snapshot["fn"].NumCalls)
+	assert.Equal(t, uint64(numCalls*5), snapshot["fn"].GasConsumed)
+	assert.Equal(t, uint64(numCalls), snapshot["fn"].Latency.Count)
+}
+
+func TestBuiltInFunctionsMetrics_WritePrometheus(t *testing.T) {
+	t.Parallel()
+
+	bfm := createMetricsWithFixedLatency(time.Millisecond)
+	process(bfm, "fn", &vmcommon.ContractCallInput{VMInput: vmcommon.VMInput{GasProvided: 10}}, &vmcommon.VMOutput{GasRemaining: 4}, nil)
+	process(bfm, "fn", &vmcommon.ContractCallInput{}, nil, builtInFunctions.ErrMECTTokenIsPaused)
+
+	buff := &bytes.Buffer{}
+	err := bfm.WritePrometheus(buff)
+	require.Nil(t, err)
+
+	output := buff.String()
+	expectedLines := []string{
+		"# TYPE builtin_function_calls_total counter",
+		`builtin_function_calls_total{function="fn"} 2`,
+		`builtin_function_errors_total{function="fn",error="mect token is paused"} 1`,
+		`builtin_function_gas_consumed_total{function="fn"} 6`,
+		`builtin_function_storage_writes_total{function="fn"} 0`,
+		"# TYPE builtin_function_latency_seconds histogram",
+		`builtin_function_latency_seconds_bucket{function="fn",le="0.0005"} 0`,
+		`builtin_function_latency_seconds_bucket{function="fn",le="0.001"} 2`,
+		`builtin_function_latency_seconds_bucket{function="fn",le="+Inf"} 2`,
+		`builtin_function_latency_seconds_sum{function="fn"} 0.002`,
+		`builtin_function_latency_seconds_count{function="fn"} 2`,
+	}
+	for _, line := range expectedLines {
+		assert.True(t, strings.Contains(output, line+"\n"), line)
+	}
+
+	recorder := httptest.NewRecorder()
+	bfm.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, prometheusTextType, recorder.Header().Get("Content-Type"))
+	assert.Equal(t, output, recorder.Body.String())
+}
+
+func TestLabels_ShouldEscapeValues(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `function="a\"b\\c\nd",le="1"`, labels("function", "a\"b\\c\nd", "le", "1"))
+}
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"time"
+)
+
+// defaultLatencyBuckets holds the upper bounds of the latency histogram buckets
+var defaultLatencyBuckets = []time.Duration{
+	50 * time.Microsecond,
+	100 * time.Microsecond,
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+}
+
+// HistogramSnapshot holds the cumulative counts of a latency histogram. Counts[i] is the number of observations
+// lower or equal to Buckets[i], while Count is the total number of observations
+type HistogramSnapshot struct {
+	Buckets []time.Duration
+	Counts  []uint64
+	Sum     time.Duration
+	Count   uint64
+}
+
+// histogram is not concurrent safe, the owner must protect it
+type histogram struct {
+	buckets []time.Duration
+	counts  []uint64
+	sum     time.Duration
+	count   uint64
+}
+
+func newHistogram(buckets []time.Duration) *histogram {
+	return &histogram{
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)),
+	}
+}
+
+func (h *histogram) observe(value time.Duration) {
+	for i, upperBound := range h.buckets {
+		if value <= upperBound {
+			h.counts[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+func (h *histogram) snapshot() HistogramSnapshot {
+	buckets := make([]time.Duration, len(h.buckets))
+	copy(buckets, h.buckets)
+	counts := make([]uint64, len(h.counts))
+	copy(counts, h.counts)
+
+	return HistogramSnapshot{
+		Buckets: buckets,
+		Counts:  counts,
+		Sum:     h.sum,
+		Count:   h.count,
+	}
+}
@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	logger "github.com/ME-MotherEarth/me-logger"
+)
+
+var log = logger.GetOrCreate("vmCommon/metrics")
+
+const (
+	metricCalls         = "builtin_function_calls_total"
+	metricErrors        = "builtin_function_errors_total"
+	metricGasConsumed   = "builtin_function_gas_consumed_total"
+	metricStorageWrites = "builtin_function_storage_writes_total"
+	metricLatency       = "builtin_function_latency_seconds"
+	prometheusTextType  = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// WritePrometheus writes the recorded metrics in the Prometheus text exposition format
+func (bfm *builtInFunctionsMetrics) WritePrometheus(writer io.Writer) error {
+	snapshot := bfm.Snapshot()
+	functionNames := make([]string, 0, len(snapshot))
+	for functionName := range snapshot {
+		functionNames = append(functionNames, functionName)
+	}
+	sort.Strings(functionNames)
+
+	buff := &bytes.Buffer{}
+
+	writeHeader(buff, metricCalls, "Number of built-in function calls.", "counter")
+	for _, functionName := range functionNames {
+		writeSample(buff, metricCalls, labels("function", functionName), formatUint(snapshot[functionName].NumCalls))
+	}
+
+	writeHeader(buff, metricErrors, "Number of failed built-in function calls, by error type.", "counter")
+	for _, functionName := range functionNames {
+		errorsByType := snapshot[functionName].ErrorsByType
+		errTypes := make([]string, 0, len(errorsByType))
+		for errType := range errorsByType {
+			errTypes = append(errTypes, errType)
+		}
+		sort.Strings(errTypes)
+
+		for _, errType := range errTypes {
+			writeSample(buff, metricErrors, labels("function", functionName, "error", errType), formatUint(errorsByType[errType]))
+		}
+	}
+
+	writeHeader(buff, metricGasConsumed, "Gas consumed by the successful built-in function calls.", "counter")
+	for _, functionName := range functionNames {
+		writeSample(buff, metricGasConsumed, labels("function", functionName), formatUint(snapshot[functionName].GasConsumed))
+	}
+
+	writeHeader(buff, metricStorageWrites, "Number of storage keys written by the successful built-in function calls.", "counter")
+	for _, functionName := range functionNames {
+		writeSample(buff, metricStorageWrites, labels("function", functionName), formatUint(snapshot[functionName].NumStorageWrites))
+	}
+
+	writeHeader(buff, metricLatency, "Built-in function execution latency.", "histogram")
+	for _, functionName := range functionNames {
+		latency := snapshot[functionName].Latency
+		for i, upperBound := range latency.Buckets {
+			le := strconv.FormatFloat(upperBound.Seconds(), 'g', -1, 64)
+			writeSample(buff, metricLatency+"_bucket", labels("function", functionName, "le", le), formatUint(latency.Counts[i]))
+		}
+		writeSample(buff, metricLatency+"_bucket", labels("function", functionName, "le", "+Inf"), formatUint(latency.Count))
+		writeSample(buff, metricLatency+"_sum", labels("function", functionName), strconv.FormatFloat(latency.Sum.Seconds(), 'g', -1, 64))
+		writeSample(buff, metricLatency+"_count", labels("function", functionName), formatUint(latency.Count))
+	}
+
+	_, err := writer.Write(buff.Bytes())
+	return err
+}
+
+// ServeHTTP serves the recorded metrics in the Prometheus text exposition format
+func (bfm *builtInFunctionsMetrics) ServeHTTP(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", prometheusTextType)
+	err := bfm.WritePrometheus(writer)
+	if err != nil {
+		log.Debug("builtInFunctionsMetrics.ServeHTTP", "error", err)
+	}
+}
+
+func writeHeader(buff *bytes.Buffer, name string, help string, metricType string) {
+	_, _ = fmt.Fprintf(buff, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+func writeSample(buff *bytes.Buffer, name string, labels string, value string) {
+	_, _ = fmt.Fprintf(buff, "%s{%s} %s\n", name, labels, value)
+}
+
+func labels(keyValues ...string) string {
+	pairs := make([]string, 0, len(keyValues)/2)
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", keyValues[i], escapeLabelValue(keyValues[i+1])))
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func escapeLabelValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return strings.ReplaceAll(value, `"`, `\"`)
+}
+
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
@@ -0,0 +1,31 @@
+package mock
+
+import (
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// BuiltInFunctionInterceptorStub -
+type BuiltInFunctionInterceptorStub struct {
+	BeforeProcessCalled func(functionName string, acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) error
+	AfterProcessCalled  func(functionName string, vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error)
+}
+
+// BeforeProcess -
+func (b *BuiltInFunctionInterceptorStub) BeforeProcess(functionName string, acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) error {
+	if b.BeforeProcessCalled != nil {
+		return b.BeforeProcessCalled(functionName, acntSnd, acntDst, vmInput)
+	}
+	return nil
+}
+
+// AfterProcess -
+func (b *BuiltInFunctionInterceptorStub) AfterProcess(functionName string, vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) {
+	if b.AfterProcessCalled != nil {
+		b.AfterProcessCalled(functionName, vmInput, vmOutput, err)
+	}
+}
+
+// IsInterfaceNil -
+func (b *BuiltInFunctionInterceptorStub) IsInterfaceNil() bool {
+	return b == nil
+}
@@ -2,10 +2,11 @@ package mock
 
 // DataTrieTrackerStub -
 type DataTrieTrackerStub struct {
-	ClearDataCachesCalled func()
-	DirtyDataCalled       func() map[string][]byte
-	RetrieveValueCalled   func(key []byte) ([]byte, error)
-	SaveKeyValueCalled    func(key []byte, value []byte) error
+	ClearDataCachesCalled       func()
+	DirtyDataCalled             func() map[string][]byte
+	RetrieveValueCalled         func(key []byte) ([]byte, error)
+	SaveKeyValueCalled          func(key []byte, value []byte) error
+	IterateKeysWithPrefixCalled func(prefix []byte, handler func(key []byte, value []byte) bool) error
 }
 
 // ClearDataCaches -
@@ -28,6 +29,14 @@ func (dtts *DataTrieTrackerStub) SaveKeyValue(key []byte, value []byte) error {
 	return dtts.SaveKeyValueCalled(key, value)
 }
 
+// IterateKeysWithPrefix -
+func (dtts *DataTrieTrackerStub) IterateKeysWithPrefix(prefix []byte, handler func(key []byte, value []byte) bool) error {
+	if dtts.IterateKeysWithPrefixCalled != nil {
+		return dtts.IterateKeysWithPrefixCalled(prefix, handler)
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dtts *DataTrieTrackerStub) IsInterfaceNil() bool {
 	return dtts == nil
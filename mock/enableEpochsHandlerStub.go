@@ -0,0 +1,192 @@
+package mock
+
+import vmcommon "github.com/ME-MotherEarth/me-vm-common"
+
+// EnableEpochsHandlerStub -
+type EnableEpochsHandlerStub struct {
+	IsGlobalMintBurnFlagEnabledField        bool
+	IsMECTTransferRoleFlagEnabledField      bool
+	IsTransferToMetaFlagEnabledField        bool
+	IsMECTNFTImprovementV1FlagEnabledField  bool
+	IsSaveToSystemAccountFlagEnabledField   bool
+	IsCheckFrozenCollectionFlagEnabledField bool
+	IsValueLengthCheckFlagEnabledField      bool
+	IsCheckTransferFlagEnabledField         bool
+	IsCheckCorrectTokenIDEnabledField       bool
+	IsSendAlwaysFlagEnabledField            bool
+	IsCheckFunctionArgumentFlagEnabledField bool
+	IsFixAsyncCallbackCheckFlagEnabledField bool
+	IsFixOldTokenLiquidityEnabledField      bool
+	IsMECTSupplyLedgerFlagEnabledField      bool
+	IsMECTAllowanceFlagEnabledField         bool
+	IsMECTVestingFlagEnabledField           bool
+	IsMECTRoyaltiesFlagEnabledField         bool
+	IsMECTNFTCreateBatchFlagEnabledField    bool
+	IsMECTNFTModifyURIsFlagEnabledField     bool
+	IsMECTMetadataFreezeFlagEnabledField    bool
+	IsMECTSoulboundFlagEnabledField         bool
+	IsMECTTransferFeeFlagEnabledField       bool
+	IsMECTMaxSupplyFlagEnabledField         bool
+	IsMECTRoleExpiryFlagEnabledField        bool
+	IsMECTRoleTransferFlagEnabledField      bool
+	IsMECTMintQuotaFlagEnabledField         bool
+	IsMECTScheduledSettingsFlagEnabledField bool
+	IsFlagEnabledInEpochCalled              func(flag string, epoch uint32) bool
+	GetActivationEpochCalled                func(flag string) uint32
+	CurrentEpochField                       uint32
+}
+
+// IsGlobalMintBurnFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsGlobalMintBurnFlagEnabled() bool {
+	return stub.IsGlobalMintBurnFlagEnabledField
+}
+
+// IsMECTTransferRoleFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsMECTTransferRoleFlagEnabled() bool {
+	return stub.IsMECTTransferRoleFlagEnabledField
+}
+
+// IsTransferToMetaFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsTransferToMetaFlagEnabled() bool {
+	return stub.IsTransferToMetaFlagEnabledField
+}
+
+// IsMECTNFTImprovementV1FlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsMECTNFTImprovementV1FlagEnabled() bool {
+	return stub.IsMECTNFTImprovementV1FlagEnabledField
+}
+
+// IsSaveToSystemAccountFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsSaveToSystemAccountFlagEnabled() bool {
+	return stub.IsSaveToSystemAccountFlagEnabledField
+}
+
+// IsCheckFrozenCollectionFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsCheckFrozenCollectionFlagEnabled() bool {
+	return stub.IsCheckFrozenCollectionFlagEnabledField
+}
+
+// IsValueLengthCheckFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsValueLengthCheckFlagEnabled() bool {
+	return stub.IsValueLengthCheckFlagEnabledField
+}
+
+// IsCheckTransferFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsCheckTransferFlagEnabled() bool {
+	return stub.IsCheckTransferFlagEnabledField
+}
+
+// IsCheckCorrectTokenIDEnabled -
+func (stub *EnableEpochsHandlerStub) IsCheckCorrectTokenIDEnabled() bool {
+	return stub.IsCheckCorrectTokenIDEnabledField
+}
+
+// IsSendAlwaysFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsSendAlwaysFlagEnabled() bool {
+	return stub.IsSendAlwaysFlagEnabledField
+}
+
+// IsCheckFunctionArgumentFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsCheckFunctionArgumentFlagEnabled() bool {
+	return stub.IsCheckFunctionArgumentFlagEnabledField
+}
+
+// IsFixAsyncCallbackCheckFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsFixAsyncCallbackCheckFlagEnabled() bool {
+	return stub.IsFixAsyncCallbackCheckFlagEnabledField
+}
+
+// IsFixOldTokenLiquidityEnabled -
+func (stub *EnableEpochsHandlerStub) IsFixOldTokenLiquidityEnabled() bool {
+	return stub.IsFixOldTokenLiquidityEnabledField
+}
+
+// IsFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsFlagEnabled(flag string) bool {
+	switch flag {
+	case vmcommon.GlobalMintBurnFlag:
+		return stub.IsGlobalMintBurnFlagEnabledField
+	case vmcommon.MECTTransferRoleFlag:
+		return stub.IsMECTTransferRoleFlagEnabledField
+	case vmcommon.TransferToMetaFlag:
+		return stub.IsTransferToMetaFlagEnabledField
+	case vmcommon.MECTNFTImprovementV1Flag:
+		return stub.IsMECTNFTImprovementV1FlagEnabledField
+	case vmcommon.SaveToSystemAccountFlag:
+		return stub.IsSaveToSystemAccountFlagEnabledField
+	case vmcommon.CheckFrozenCollectionFlag:
+		return stub.IsCheckFrozenCollectionFlagEnabledField
+	case vmcommon.ValueLengthCheckFlag:
+		return stub.IsValueLengthCheckFlagEnabledField
+	case vmcommon.CheckTransferFlag:
+		return stub.IsCheckTransferFlagEnabledField
+	case vmcommon.CheckCorrectTokenIDFlag:
+		return stub.IsCheckCorrectTokenIDEnabledField
+	case vmcommon.SendAlwaysFlag:
+		return stub.IsSendAlwaysFlagEnabledField
+	case vmcommon.CheckFunctionArgumentFlag:
+		return stub.IsCheckFunctionArgumentFlagEnabledField
+	case vmcommon.FixAsyncCallbackCheckFlag:
+		return stub.IsFixAsyncCallbackCheckFlagEnabledField
+	case vmcommon.FixOldTokenLiquidityFlag:
+		return stub.IsFixOldTokenLiquidityEnabledField
+	case vmcommon.MECTSupplyLedgerFlag:
+		return stub.IsMECTSupplyLedgerFlagEnabledField
+	case vmcommon.MECTAllowanceFlag:
+		return stub.IsMECTAllowanceFlagEnabledField
+	case vmcommon.MECTVestingFlag:
+		return stub.IsMECTVestingFlagEnabledField
+	case vmcommon.MECTRoyaltiesFlag:
+		return stub.IsMECTRoyaltiesFlagEnabledField
+	case vmcommon.MECTNFTCreateBatchFlag:
+		return stub.IsMECTNFTCreateBatchFlagEnabledField
+	case vmcommon.MECTNFTModifyURIsFlag:
+		return stub.IsMECTNFTModifyURIsFlagEnabledField
+	case vmcommon.MECTMetadataFreezeFlag:
+		return stub.IsMECTMetadataFreezeFlagEnabledField
+	case vmcommon.MECTSoulboundFlag:
+		return stub.IsMECTSoulboundFlagEnabledField
+	case vmcommon.MECTTransferFeeFlag:
+		return stub.IsMECTTransferFeeFlagEnabledField
+	case vmcommon.MECTMaxSupplyFlag:
+		return stub.IsMECTMaxSupplyFlagEnabledField
+	case vmcommon.MECTRoleExpiryFlag:
+		return stub.IsMECTRoleExpiryFlagEnabledField
+	case vmcommon.MECTRoleTransferFlag:
+		return stub.IsMECTRoleTransferFlagEnabledField
+	case vmcommon.MECTMintQuotaFlag:
+		return stub.IsMECTMintQuotaFlagEnabledField
+	case vmcommon.MECTScheduledSettingsFlag:
+		return stub.IsMECTScheduledSettingsFlagEnabledField
+	default:
+		return false
+	}
+}
+
+// IsFlagEnabledInEpoch -
+func (stub *EnableEpochsHandlerStub) IsFlagEnabledInEpoch(flag string, epoch uint32) bool {
+	if stub.IsFlagEnabledInEpochCalled != nil {
+		return stub.IsFlagEnabledInEpochCalled(flag, epoch)
+	}
+
+	return stub.IsFlagEnabled(flag)
+}
+
+// GetActivationEpoch -
+func (stub *EnableEpochsHandlerStub) GetActivationEpoch(flag string) uint32 {
+	if stub.GetActivationEpochCalled != nil {
+		return stub.GetActivationEpochCalled(flag)
+	}
+
+	return 0
+}
+
+// GetCurrentEpoch -
+func (stub *EnableEpochsHandlerStub) GetCurrentEpoch() uint32 {
+	return stub.CurrentEpochField
+}
+
+// IsInterfaceNil -
+func (stub *EnableEpochsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -5,6 +5,11 @@ type GlobalSettingsHandlerStub struct {
 	IsPausedCalled                              func(token []byte) bool
 	IsLimiterTransferCalled                     func(token []byte) bool
 	IsBurnForAllCalled                          func(token []byte) bool
+	IsRoyaltiesEnforcedCalled                   func(token []byte) bool
+	GetRoyaltiesReceiverCalled                  func(tokenID []byte) []byte
+	GetTransferFeeCalled                        func(tokenID []byte) (uint32, []byte)
+	IsSoulboundCalled                           func(token []byte) bool
+	IsMetadataFrozenCalled                      func(token []byte) bool
 	IsSenderOrDestinationWithTransferRoleCalled func(sender, destionation, tokenID []byte) bool
 }
 
@@ -32,6 +37,46 @@ func (p *GlobalSettingsHandlerStub) IsBurnForAll(token []byte) bool {
 	return false
 }
 
+// IsRoyaltiesEnforced -
+func (p *GlobalSettingsHandlerStub) IsRoyaltiesEnforced(token []byte) bool {
+	if p.IsRoyaltiesEnforcedCalled != nil {
+		return p.IsRoyaltiesEnforcedCalled(token)
+	}
+	return false
+}
+
+// GetRoyaltiesReceiver -
+func (p *GlobalSettingsHandlerStub) GetRoyaltiesReceiver(tokenID []byte) []byte {
+	if p.GetRoyaltiesReceiverCalled != nil {
+		return p.GetRoyaltiesReceiverCalled(tokenID)
+	}
+	return nil
+}
+
+// IsMetadataFrozen -
+func (p *GlobalSettingsHandlerStub) IsMetadataFrozen(token []byte) bool {
+	if p.IsMetadataFrozenCalled != nil {
+		return p.IsMetadataFrozenCalled(token)
+	}
+	return false
+}
+
+// IsSoulbound -
+func (p *GlobalSettingsHandlerStub) IsSoulbound(token []byte) bool {
+	if p.IsSoulboundCalled != nil {
+		return p.IsSoulboundCalled(token)
+	}
+	return false
+}
+
+// GetTransferFee -
+func (p *GlobalSettingsHandlerStub) GetTransferFee(tokenID []byte) (uint32, []byte) {
+	if p.GetTransferFeeCalled != nil {
+		return p.GetTransferFeeCalled(tokenID)
+	}
+	return 0, nil
+}
+
 // IsSenderOrDestinationWithTransferRole -
 func (p *GlobalSettingsHandlerStub) IsSenderOrDestinationWithTransferRole(sender, destination, tokenID []byte) bool {
 	if p.IsSenderOrDestinationWithTransferRoleCalled != nil {
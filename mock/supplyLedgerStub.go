@@ -0,0 +1,52 @@
+package mock
+
+import (
+	"math/big"
+
+	vmcommon "github.com/ME-MotherEarth/me-vm-common"
+)
+
+// SupplyLedgerStub -
+type SupplyLedgerStub struct {
+	GetMECTSupplyCalled func(tokenID []byte, nonce uint64) (*vmcommon.MECTSupply, error)
+	AddMintedCalled     func(tokenID []byte, nonce uint64, value *big.Int) error
+	AddBurnedCalled     func(tokenID []byte, nonce uint64, value *big.Int) error
+	AddWipedCalled      func(tokenID []byte, nonce uint64, value *big.Int) error
+}
+
+// GetMECTSupply -
+func (s *SupplyLedgerStub) GetMECTSupply(tokenID []byte, nonce uint64) (*vmcommon.MECTSupply, error) {
+	if s.GetMECTSupplyCalled != nil {
+		return s.GetMECTSupplyCalled(tokenID, nonce)
+	}
+	return &vmcommon.MECTSupply{}, nil
+}
+
+// AddMinted -
+func (s *SupplyLedgerStub) AddMinted(tokenID []byte, nonce uint64, value *big.Int) error {
+	if s.AddMintedCalled != nil {
+		return s.AddMintedCalled(tokenID, nonce, value)
+	}
+	return nil
+}
+
+// AddBurned -
+func (s *SupplyLedgerStub) AddBurned(tokenID []byte, nonce uint64, value *big.Int) error {
+	if s.AddBurnedCalled != nil {
+		return s.AddBurnedCalled(tokenID, nonce, value)
+	}
+	return nil
+}
+
+// AddWiped -
+func (s *SupplyLedgerStub) AddWiped(tokenID []byte, nonce uint64, value *big.Int) error {
+	if s.AddWipedCalled != nil {
+		return s.AddWipedCalled(tokenID, nonce, value)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (s *SupplyLedgerStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"math/big"
+	"sort"
 
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
@@ -110,7 +111,7 @@ func (a *Account) SetBalance(balance int64) {
 
 // GetDeveloperReward -
 func (a *Account) GetDeveloperReward() *big.Int {
-	return big.NewInt(0)
+	return a.DeveloperReward
 }
 
 // GetOwnerAddress -
@@ -234,6 +235,25 @@ func (a *Account) SaveKeyValue(key []byte, value []byte) error {
 	return nil
 }
 
+// IterateKeysWithPrefix -
+func (a *Account) IterateKeysWithPrefix(prefix []byte, handler func(key []byte, value []byte) bool) error {
+	keys := make([]string, 0, len(a.Storage))
+	for key := range a.Storage {
+		if bytes.HasPrefix([]byte(key), prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !handler([]byte(key), a.Storage[key]) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
 // ClearDataCaches -
 func (a *Account) ClearDataCaches() {
 }
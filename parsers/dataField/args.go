@@ -10,4 +10,6 @@ type ArgsOperationDataFieldParser struct {
 	AddressLength    int
 	Marshalizer      marshal.Marshalizer
 	ShardCoordinator vmcommon.Coordinator
+	// GlobalSettingsHandler is optional, when provided the transfers of soulbound tokens are marked in the response
+	GlobalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
 }
@@ -12,6 +12,8 @@ type ResponseParseData struct {
 	Receivers        [][]byte
 	ReceiversShardID []uint32
 	IsRelayed        bool
+	// IsSoulbound is set when at least one of the transferred tokens is soulbound, such a transfer will be rejected
+	IsSoulbound bool
 }
 
 func NewResponseParseDataAsRelayed() *ResponseParseData {
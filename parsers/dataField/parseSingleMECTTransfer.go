@@ -2,6 +2,7 @@ package datafield
 
 import (
 	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-core/core/check"
 	vmcommon "github.com/ME-MotherEarth/me-vm-common"
 )
 
@@ -36,5 +37,22 @@ func (odp *operationDataFieldParser) extractMECTData(args [][]byte, function str
 		return responseParse, nil, false
 	}
 
+	responseParse.IsSoulbound = odp.hasSoulboundTokens(parsedMECTTransfers.MECTTransfers)
+
 	return responseParse, parsedMECTTransfers, true
 }
+
+func (odp *operationDataFieldParser) hasSoulboundTokens(mectTransfers []*vmcommon.MECTTransfer) bool {
+	if check.IfNil(odp.globalSettingsHandler) {
+		return false
+	}
+
+	for _, mectTransfer := range mectTransfers {
+		mectTokenKey := []byte(core.MotherEarthProtectedKeyPrefix + core.MECTKeyIdentifier + string(mectTransfer.MECTTokenName))
+		if odp.globalSettingsHandler.IsSoulbound(mectTokenKey) {
+			return true
+		}
+	}
+
+	return false
+}
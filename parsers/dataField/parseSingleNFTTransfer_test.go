@@ -1,11 +1,14 @@
 package datafield
 
 import (
+	"bytes"
 	"encoding/hex"
 	"testing"
 
+	"github.com/ME-MotherEarth/me-core/core"
 	"github.com/ME-MotherEarth/me-core/core/pubkeyConverter"
 	logger "github.com/ME-MotherEarth/me-logger"
+	"github.com/ME-MotherEarth/me-vm-common/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -88,3 +91,34 @@ func TestMECTNFTTransfer(t *testing.T) {
 		}, res)
 	})
 }
+
+func TestMECTNFTTransferSoulbound(t *testing.T) {
+	t.Parallel()
+
+	dataField := []byte(`MECTNFTTransfer@4c4b4641524d2d396431656138@1e47f1@018c88873c27e96447@000000000000000005001e2a1428dd1e3a5146b3960d9e0f4a50369904ee5483@636c61696d5265776172647350726f7879@0000000000000000050026751893d6789be9e5a99863ba9eeaa8088dd25f5483`)
+
+	t.Run("WithoutGlobalSettingsHandler", func(t *testing.T) {
+		t.Parallel()
+
+		parser, _ := NewOperationDataFieldParser(createMockArgumentsOperationParser())
+		res := parser.Parse(dataField, sender, sender)
+		require.False(t, res.IsSoulbound)
+	})
+
+	t.Run("SoulboundToken", func(t *testing.T) {
+		t.Parallel()
+
+		soulboundKey := []byte(core.MotherEarthProtectedKeyPrefix + core.MECTKeyIdentifier + "LKFARM-9d1ea8")
+		args := createMockArgumentsOperationParser()
+		args.GlobalSettingsHandler = &mock.GlobalSettingsHandlerStub{
+			IsSoulboundCalled: func(token []byte) bool {
+				return bytes.Equal(token, soulboundKey)
+			},
+		}
+		parser, _ := NewOperationDataFieldParser(args)
+
+		res := parser.Parse(dataField, sender, sender)
+		require.True(t, res.IsSoulbound)
+		require.Equal(t, []string{"LKFARM-9d1ea8-1e47f1"}, res.Tokens)
+	})
+}
@@ -37,6 +37,8 @@ type operationDataFieldParser struct {
 	argsParser         vmcommon.CallArgsParser
 	shardCoordinator   vmcommon.Coordinator
 	mectTransferParser vmcommon.MECTTransferParser
+
+	globalSettingsHandler vmcommon.ExtendedMECTGlobalSettingsHandler
 }
 
 // NewOperationDataFieldParser will return a new instance of operationDataFieldParser
@@ -58,11 +60,12 @@ func NewOperationDataFieldParser(args *ArgsOperationDataFieldParser) (*operation
 	}
 
 	return &operationDataFieldParser{
-		argsParser:           argsParser,
-		shardCoordinator:     args.ShardCoordinator,
-		mectTransferParser:   mectTransferParser,
-		addressLength:        args.AddressLength,
-		builtInFunctionsList: getAllBuiltInFunctions(),
+		argsParser:            argsParser,
+		shardCoordinator:      args.ShardCoordinator,
+		mectTransferParser:    mectTransferParser,
+		addressLength:         args.AddressLength,
+		builtInFunctionsList:  getAllBuiltInFunctions(),
+		globalSettingsHandler: args.GlobalSettingsHandler,
 	}, nil
 }
 
@@ -108,7 +111,7 @@ func (odp *operationDataFieldParser) parse(dataField []byte, sender, receiver []
 	}
 
 	isBuiltInFunc := isBuiltInFunction(odp.builtInFunctionsList, function)
-	if isBuiltInFunc {
+	if isBuiltInFunc && hasValidNumberOfArguments(function, args) {
 		responseParse.Operation = function
 	}
 
@@ -155,6 +158,7 @@ func (odp *operationDataFieldParser) parseRelayed(function string, args [][]byte
 		Receivers:        receivers,
 		ReceiversShardID: receiversShardID,
 		IsRelayed:        true,
+		IsSoulbound:      res.IsSoulbound,
 	}
 }
 
@@ -329,6 +329,48 @@ func TestOperationDataFieldParser_ParseRelayed(t *testing.T) {
 	})
 }
 
+func TestParseBuiltInFunctionsDeclaredInTheRegistry(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	functions := map[string]string{
+		"MECTDeleteMetadata":            "@4d4949552d616263646566@01@01@02",
+		"MECTAddMetadata":               "@4d4949552d616263646566@01@01@02",
+		"MECTSetBurnRoleForAll":         "@4d4949552d616263646566",
+		"MECTUnSetBurnRoleForAll":       "@4d4949552d616263646566",
+		"MECTTransferRoleAddAddress":    "@4d4949552d616263646566@01",
+		"MECTTransferRoleDeleteAddress": "@4d4949552d616263646566@01",
+	}
+	for function, arguments := range functions {
+		dataField := []byte(function + arguments)
+		res := parser.Parse(dataField, sender, receiver)
+		require.Equal(t, &ResponseParseData{
+			Operation: function,
+		}, res, function)
+	}
+}
+
+func TestParseBuiltInFunctionWithInvalidNumberOfArgumentsShouldNotSetOperation(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsOperationParser()
+	parser, _ := NewOperationDataFieldParser(arguments)
+
+	dataField := []byte("MECTSetBurnRoleForAll@4d4949552d616263646566@01")
+	res := parser.Parse(dataField, sender, receiver)
+	require.Equal(t, &ResponseParseData{
+		Operation: operationTransfer,
+	}, res)
+
+	dataField = []byte("MECTDeleteMetadata@4d4949552d616263646566@01")
+	res = parser.Parse(dataField, sender, receiver)
+	require.Equal(t, &ResponseParseData{
+		Operation: operationTransfer,
+	}, res)
+}
+
 func TestParseSCDeploy(t *testing.T) {
 	arguments := createMockArgumentsOperationParser()
 	parser, _ := NewOperationDataFieldParser(arguments)
@@ -8,6 +8,7 @@ import (
 	"unicode"
 
 	"github.com/ME-MotherEarth/me-core/core"
+	"github.com/ME-MotherEarth/me-vm-common/builtInFunctions"
 )
 
 const (
@@ -16,32 +17,7 @@ const (
 )
 
 func getAllBuiltInFunctions() []string {
-	return []string{
-		core.BuiltInFunctionClaimDeveloperRewards,
-		core.BuiltInFunctionChangeOwnerAddress,
-		core.BuiltInFunctionSetUserName,
-		core.BuiltInFunctionSaveKeyValue,
-		core.BuiltInFunctionMECTTransfer,
-		core.BuiltInFunctionMECTBurn,
-		core.BuiltInFunctionMECTFreeze,
-		core.BuiltInFunctionMECTUnFreeze,
-		core.BuiltInFunctionMECTWipe,
-		core.BuiltInFunctionMECTPause,
-		core.BuiltInFunctionMECTUnPause,
-		core.BuiltInFunctionSetMECTRole,
-		core.BuiltInFunctionUnSetMECTRole,
-		core.BuiltInFunctionMECTSetLimitedTransfer,
-		core.BuiltInFunctionMECTUnSetLimitedTransfer,
-		core.BuiltInFunctionMECTLocalMint,
-		core.BuiltInFunctionMECTLocalBurn,
-		core.BuiltInFunctionMECTNFTTransfer,
-		core.BuiltInFunctionMECTNFTCreate,
-		core.BuiltInFunctionMECTNFTAddQuantity,
-		core.BuiltInFunctionMECTNFTCreateRoleTransfer,
-		core.BuiltInFunctionMECTNFTBurn,
-		core.BuiltInFunctionMECTNFTAddURI,
-		core.BuiltInFunctionMECTNFTUpdateAttributes,
-		core.BuiltInFunctionMultiMECTNFTTransfer,
+	return append(builtInFunctions.BuiltInFunctionNames(),
 		core.MECTRoleLocalMint,
 		core.MECTRoleLocalBurn,
 		core.MECTRoleNFTCreate,
@@ -51,7 +27,7 @@ func getAllBuiltInFunctions() []string {
 		core.MECTRoleNFTAddURI,
 		core.MECTRoleNFTUpdateAttributes,
 		core.MECTRoleTransfer,
-	}
+	)
 }
 
 func isBuiltInFunction(builtInFunctionsList []string, function string) bool {
@@ -64,6 +40,11 @@ func isBuiltInFunction(builtInFunctionsList []string, function string) bool {
 	return false
 }
 
+// hasValidNumberOfArguments returns true if the arguments fit the shape declared in the built-in functions registry
+func hasValidNumberOfArguments(function string, args [][]byte) bool {
+	return builtInFunctions.CheckBuiltInFunctionArguments(function, len(args)) == nil
+}
+
 // EncodeBytesSlice will encode the provided bytes slice with a provided function
 func EncodeBytesSlice(encodeFunc func(b []byte) string, rcvs [][]byte) []string {
 	if encodeFunc == nil {